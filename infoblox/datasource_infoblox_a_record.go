@@ -12,7 +12,7 @@ func dataSourceARecord() *schema.Resource {
 	return &schema.Resource{
 		Read: dataSourceARecordRead,
 
-		Schema: map[string]*schema.Schema{
+		Schema: withRecordMetadataSchema(map[string]*schema.Schema{
 			"id": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -53,7 +53,7 @@ func dataSourceARecord() *schema.Resource {
 				Computed:    true,
 				Description: "Extensible attributes of the A-record, as a map in JSON format",
 			},
-		},
+		}),
 	}
 }
 
@@ -100,6 +100,10 @@ func dataSourceARecordRead(d *schema.ResourceData, m interface{}) error {
 		return err
 	}
 
+	if err := setRecordMetadata(d, connector, "record:a", obj.Ref); err != nil {
+		return err
+	}
+
 	d.SetId(obj.Ref)
 
 	return nil
@@ -19,6 +19,9 @@ func TestAccDataSourceARecord(t *testing.T) {
 					resource.TestCheckResourceAttr("data.infoblox_a_record.acctest", "zone", "test.com"),
 					resource.TestCheckResourceAttr("data.infoblox_a_record.acctest", "fqdn", "test-name.test.com"),
 					resource.TestCheckResourceAttr("data.infoblox_a_record.acctest", "ip_addr", "10.0.0.20"),
+					resource.TestCheckResourceAttr("data.infoblox_a_record.acctest", "creator", "STATIC"),
+					resource.TestCheckResourceAttrSet("data.infoblox_a_record.acctest", "creation_time"),
+					resource.TestCheckResourceAttr("data.infoblox_a_record.acctest", "last_queried", ""),
 				),
 			},
 		},
@@ -12,7 +12,7 @@ func dataSourceAAAARecord() *schema.Resource {
 	return &schema.Resource{
 		Read: dataSourceAAAARecordRead,
 
-		Schema: map[string]*schema.Schema{
+		Schema: withRecordMetadataSchema(map[string]*schema.Schema{
 			"dns_view": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -49,7 +49,7 @@ func dataSourceAAAARecord() *schema.Resource {
 				Computed:    true,
 				Description: "The Extensible attributes of the AAAA-record",
 			},
-		},
+		}),
 	}
 }
 
@@ -96,6 +96,10 @@ func dataSourceAAAARecordRead(d *schema.ResourceData, m interface{}) error {
 		return err
 	}
 
+	if err := setRecordMetadata(d, connector, "record:aaaa", obj.Ref); err != nil {
+		return err
+	}
+
 	d.SetId(obj.Ref)
 
 	return nil
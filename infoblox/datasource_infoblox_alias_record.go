@@ -0,0 +1,124 @@
+package infoblox
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+)
+
+func dataSourceAliasRecord() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAliasRecordRead,
+
+		Schema: withRecordMetadataSchema(map[string]*schema.Schema{
+			"dns_view": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     defaultDNSView,
+				Description: "DNS view which the record's zone belongs to.",
+			},
+			"fqdn": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "FQDN for the ALIAS-record.",
+			},
+			"target_type": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The type of the target's records the ALIAS-record resolves to.",
+			},
+			"target_name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The name of the target.",
+			},
+			"zone": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The zone which the record belongs to.",
+			},
+			"ttl": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "TTL value for the ALIAS-record.",
+			},
+			"comment": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Description of the ALIAS-record.",
+			},
+			"ext_attrs": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Extensible attributes of the ALIAS-record, as a map in JSON format.",
+			},
+		}),
+	}
+}
+
+func dataSourceAliasRecordRead(d *schema.ResourceData, m interface{}) error {
+	dnsView := d.Get("dns_view").(string)
+	fqdn := d.Get("fqdn").(string)
+	targetType := d.Get("target_type").(string)
+
+	connector := m.(ibclient.IBConnector)
+
+	var res []recordAlias
+	sf := map[string]string{
+		"view":        dnsView,
+		"name":        fqdn,
+		"target_type": targetType,
+	}
+	err := connector.GetObject(newRecordAlias(recordAlias{}), "", ibclient.NewQueryParams(false, sf), &res)
+	if err != nil {
+		return fmt.Errorf("failed getting ALIAS-record: %w", err)
+	}
+	if len(res) == 0 {
+		return fmt.Errorf("ALIAS-record '%s' not found in DNS view '%s'", fqdn, dnsView)
+	}
+	obj := res[0]
+
+	ttl := int(obj.Ttl)
+	if !obj.UseTtl {
+		ttl = ttlUndef
+	}
+	if err = d.Set("ttl", ttl); err != nil {
+		return err
+	}
+
+	// TODO: temporary scaffold, need to rework marshalling/unmarshalling of EAs
+	//       (avoiding additional layer of keys ("value" key)
+	var eaMap map[string]interface{}
+	if obj.Ea != nil && len(obj.Ea) > 0 {
+		eaMap = (map[string]interface{})(obj.Ea)
+	} else {
+		eaMap = make(map[string]interface{})
+	}
+	ea, err := json.Marshal(eaMap)
+	if err != nil {
+		return err
+	}
+	if err = d.Set("ext_attrs", string(ea)); err != nil {
+		return err
+	}
+
+	if err = d.Set("target_name", obj.TargetName); err != nil {
+		return err
+	}
+	if err = d.Set("zone", obj.Zone); err != nil {
+		return err
+	}
+	if err = d.Set("comment", obj.Comment); err != nil {
+		return err
+	}
+
+	if err := setRecordMetadata(d, connector, "record:alias", obj.Ref); err != nil {
+		return err
+	}
+
+	d.SetId(obj.Ref)
+
+	return nil
+}
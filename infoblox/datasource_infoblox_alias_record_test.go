@@ -0,0 +1,40 @@
+package infoblox
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceAliasRecord(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAliasRecordRead,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.infoblox_alias_record.acctest", "dns_view", "default"),
+					resource.TestCheckResourceAttr("data.infoblox_alias_record.acctest", "target_name", "lb-ds.us-east-1.elb.amazonaws.com"),
+					resource.TestCheckResourceAttr("data.infoblox_alias_record.acctest", "zone", "test.com"),
+					resource.TestCheckResourceAttr("data.infoblox_alias_record.acctest", "comment", "non-empty comment"),
+				),
+			},
+		},
+	})
+}
+
+var testAccDataSourceAliasRecordRead = `
+resource "infoblox_alias_record" "foo" {
+	fqdn = "ds-alias.test.com"
+	target_name = "lb-ds.us-east-1.elb.amazonaws.com"
+	target_type = "A"
+	comment = "non-empty comment"
+}
+
+data "infoblox_alias_record" "acctest" {
+	dns_view = infoblox_alias_record.foo.dns_view
+	fqdn = infoblox_alias_record.foo.fqdn
+	target_type = infoblox_alias_record.foo.target_type
+}
+`
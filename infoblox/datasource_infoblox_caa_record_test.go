@@ -0,0 +1,48 @@
+package infoblox
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceCAARecord(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceCAARecordRead,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.infoblox_caa_record.acctest", "dns_view", "default"),
+					resource.TestCheckResourceAttr("data.infoblox_caa_record.acctest", "fqdn", "ds-caa.test.com"),
+					resource.TestCheckResourceAttr("data.infoblox_caa_record.acctest", "ca_flag", "0"),
+					resource.TestCheckResourceAttr("data.infoblox_caa_record.acctest", "zone", "test.com"),
+					resource.TestCheckResourceAttr("data.infoblox_caa_record.acctest", "ttl", "10"),
+					resource.TestCheckResourceAttr("data.infoblox_caa_record.acctest", "comment", "non-empty comment"),
+					resource.TestCheckResourceAttr("data.infoblox_caa_record.acctest", "ext_attrs", "{\"Site\":\"None\"}"),
+				),
+			},
+		},
+	})
+}
+
+var testAccDataSourceCAARecordRead = `
+resource "infoblox_caa_record" "foo" {
+	fqdn = "ds-caa.test.com"
+	ca_tag = "issue"
+	ca_value = "letsencrypt.org"
+	ttl = 10
+	comment = "non-empty comment"
+	ext_attrs = jsonencode({
+		"Site": "None"
+	})
+}
+
+data "infoblox_caa_record" "acctest" {
+	dns_view = infoblox_caa_record.foo.dns_view
+	fqdn = infoblox_caa_record.foo.fqdn
+	ca_tag = infoblox_caa_record.foo.ca_tag
+	ca_value = infoblox_caa_record.foo.ca_value
+}
+`
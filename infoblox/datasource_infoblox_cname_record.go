@@ -12,7 +12,7 @@ func dataSourceCNameRecord() *schema.Resource {
 	return &schema.Resource{
 		Read: dataSourceCNameRecordRead,
 
-		Schema: map[string]*schema.Schema{
+		Schema: withRecordMetadataSchema(map[string]*schema.Schema{
 			"id": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -53,7 +53,7 @@ func dataSourceCNameRecord() *schema.Resource {
 				Computed:    true,
 				Description: "The Extensible attributes of CNAME record, as a map in JSON format",
 			},
-		},
+		}),
 	}
 }
 
@@ -100,6 +100,10 @@ func dataSourceCNameRecordRead(d *schema.ResourceData, m interface{}) error {
 		return err
 	}
 
+	if err := setRecordMetadata(d, connector, "record:cname", obj.Ref); err != nil {
+		return err
+	}
+
 	d.SetId(obj.Ref)
 
 	return nil
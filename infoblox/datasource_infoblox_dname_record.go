@@ -0,0 +1,117 @@
+package infoblox
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+)
+
+func dataSourceDNAMERecord() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceDNAMERecordRead,
+
+		Schema: withRecordMetadataSchema(map[string]*schema.Schema{
+			"dns_view": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     defaultDNSView,
+				Description: "DNS view which the record's zone belongs to.",
+			},
+			"fqdn": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "FQDN for the DNAME-record.",
+			},
+			"target": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The domain name which the names below 'fqdn' are redirected to.",
+			},
+			"zone": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The zone which the record belongs to.",
+			},
+			"ttl": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "TTL value for the DNAME-record.",
+			},
+			"comment": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Description of the DNAME-record.",
+			},
+			"ext_attrs": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Extensible attributes of the DNAME-record, as a map in JSON format.",
+			},
+		}),
+	}
+}
+
+func dataSourceDNAMERecordRead(d *schema.ResourceData, m interface{}) error {
+	dnsView := d.Get("dns_view").(string)
+	fqdn := d.Get("fqdn").(string)
+
+	connector := m.(ibclient.IBConnector)
+
+	var res []recordDNAME
+	sf := map[string]string{
+		"view": dnsView,
+		"name": fqdn,
+	}
+	err := connector.GetObject(newRecordDNAME(recordDNAME{}), "", ibclient.NewQueryParams(false, sf), &res)
+	if err != nil {
+		return fmt.Errorf("failed getting DNAME-record: %w", err)
+	}
+	if len(res) == 0 {
+		return fmt.Errorf("DNAME-record '%s' not found in DNS view '%s'", fqdn, dnsView)
+	}
+	obj := res[0]
+
+	ttl := int(obj.Ttl)
+	if !obj.UseTtl {
+		ttl = ttlUndef
+	}
+	if err = d.Set("ttl", ttl); err != nil {
+		return err
+	}
+
+	// TODO: temporary scaffold, need to rework marshalling/unmarshalling of EAs
+	//       (avoiding additional layer of keys ("value" key)
+	var eaMap map[string]interface{}
+	if obj.Ea != nil && len(obj.Ea) > 0 {
+		eaMap = (map[string]interface{})(obj.Ea)
+	} else {
+		eaMap = make(map[string]interface{})
+	}
+	ea, err := json.Marshal(eaMap)
+	if err != nil {
+		return err
+	}
+	if err = d.Set("ext_attrs", string(ea)); err != nil {
+		return err
+	}
+
+	if err = d.Set("target", obj.Target); err != nil {
+		return err
+	}
+	if err = d.Set("zone", obj.Zone); err != nil {
+		return err
+	}
+	if err = d.Set("comment", obj.Comment); err != nil {
+		return err
+	}
+
+	if err := setRecordMetadata(d, connector, "record:dname", obj.Ref); err != nil {
+		return err
+	}
+
+	d.SetId(obj.Ref)
+
+	return nil
+}
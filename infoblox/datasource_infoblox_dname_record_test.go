@@ -0,0 +1,38 @@
+package infoblox
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceDNAMERecord(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceDNAMERecordRead,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.infoblox_dname_record.acctest", "dns_view", "default"),
+					resource.TestCheckResourceAttr("data.infoblox_dname_record.acctest", "target", "new-ds.test.com"),
+					resource.TestCheckResourceAttr("data.infoblox_dname_record.acctest", "zone", "test.com"),
+					resource.TestCheckResourceAttr("data.infoblox_dname_record.acctest", "comment", "non-empty comment"),
+				),
+			},
+		},
+	})
+}
+
+var testAccDataSourceDNAMERecordRead = `
+resource "infoblox_dname_record" "foo" {
+	fqdn = "old-ds.test.com"
+	target = "new-ds.test.com"
+	comment = "non-empty comment"
+}
+
+data "infoblox_dname_record" "acctest" {
+	dns_view = infoblox_dname_record.foo.dns_view
+	fqdn = infoblox_dname_record.foo.fqdn
+}
+`
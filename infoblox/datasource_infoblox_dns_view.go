@@ -0,0 +1,133 @@
+package infoblox
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+)
+
+func dataSourceDNSView() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceDNSViewRead,
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the DNS view.",
+			},
+			"network_view": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The network view which the DNS view is associated with.",
+			},
+			"match_clients": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The list of clients (source addresses of queries) which the DNS view serves.",
+				Elem:        aclItemSchemaElem(),
+			},
+			"match_destinations": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The list of destination addresses of queries which the DNS view serves.",
+				Elem:        aclItemSchemaElem(),
+			},
+			"recursion": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Shows whether recursive queries are allowed for the DNS view.",
+			},
+			"forwarders": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The list of IP addresses of name servers which the off-site queries are forwarded to.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"forward_only": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Shows whether the off-site queries are sent to the forwarders only.",
+			},
+			"comment": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Description of the DNS view.",
+			},
+			"ext_attrs": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Extensible attributes of the DNS view, as a map in JSON format.",
+			},
+		},
+	}
+}
+
+func dataSourceDNSViewRead(d *schema.ResourceData, m interface{}) error {
+	name := d.Get("name").(string)
+
+	connector := m.(ibclient.IBConnector)
+
+	var res []dnsView
+	sf := map[string]string{
+		"name": name,
+	}
+	err := connector.GetObject(newDNSView(dnsView{}), "", ibclient.NewQueryParams(false, sf), &res)
+	if err != nil {
+		return fmt.Errorf("getting DNS view '%s' failed: %w", name, err)
+	}
+	if len(res) == 0 {
+		return fmt.Errorf("DNS view '%s' not found", name)
+	}
+	obj := res[0]
+
+	// TODO: temporary scaffold, need to rework marshalling/unmarshalling of EAs
+	//       (avoiding additional layer of keys ("value" key)
+	var eaMap map[string]interface{}
+	if obj.Ea != nil && len(obj.Ea) > 0 {
+		eaMap = (map[string]interface{})(obj.Ea)
+	} else {
+		eaMap = make(map[string]interface{})
+	}
+	ea, err := json.Marshal(eaMap)
+	if err != nil {
+		return err
+	}
+	if err = d.Set("ext_attrs", string(ea)); err != nil {
+		return err
+	}
+
+	forwarders := obj.Forwarders
+	if !obj.UseForwarders {
+		forwarders = nil
+	}
+
+	if err := d.Set("network_view", obj.NetworkView); err != nil {
+		return err
+	}
+	if err := d.Set("match_clients", convertACLItemsToInterface(obj.MatchClients)); err != nil {
+		return err
+	}
+	if err := d.Set("match_destinations", convertACLItemsToInterface(obj.MatchDestinations)); err != nil {
+		return err
+	}
+	if err := d.Set("recursion", obj.Recursion); err != nil {
+		return err
+	}
+	if err := d.Set("forwarders", forwarders); err != nil {
+		return err
+	}
+	if err := d.Set("forward_only", obj.ForwardOnly); err != nil {
+		return err
+	}
+	if err := d.Set("comment", obj.Comment); err != nil {
+		return err
+	}
+
+	d.SetId(obj.Ref)
+
+	return nil
+}
@@ -0,0 +1,42 @@
+package infoblox
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceDNSView(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceDNSViewRead,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.infoblox_dns_view.acctest", "network_view", "default"),
+					resource.TestCheckResourceAttr("data.infoblox_dns_view.acctest", "recursion", "true"),
+					resource.TestCheckResourceAttr("data.infoblox_dns_view.acctest", "match_clients.0.address", "10.0.0.0/8"),
+					resource.TestCheckResourceAttr("data.infoblox_dns_view.acctest", "forwarders.0", "10.0.0.1"),
+					resource.TestCheckResourceAttr("data.infoblox_dns_view.acctest", "comment", "internal clients"),
+				),
+			},
+		},
+	})
+}
+
+var testAccDataSourceDNSViewRead = `
+resource "infoblox_dns_view" "foo"{
+	name = "internal_view_ds1"
+	match_clients {
+		address = "10.0.0.0/8"
+	}
+	recursion = true
+	forwarders = ["10.0.0.1"]
+	comment = "internal clients"
+}
+
+data "infoblox_dns_view" "acctest" {
+	name = infoblox_dns_view.foo.name
+}
+`
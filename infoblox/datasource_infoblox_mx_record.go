@@ -12,7 +12,7 @@ func dataSourceMXRecord() *schema.Resource {
 	return &schema.Resource{
 		Read: dataSourceMXRecordRead,
 
-		Schema: map[string]*schema.Schema{
+		Schema: withRecordMetadataSchema(map[string]*schema.Schema{
 			"id": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -58,7 +58,7 @@ func dataSourceMXRecord() *schema.Resource {
 				Computed:    true,
 				Description: "Extensible attributes of the TXT-record, as a map in JSON format.",
 			},
-		},
+		}),
 	}
 }
 
@@ -114,6 +114,10 @@ func dataSourceMXRecordRead(d *schema.ResourceData, m interface{}) error {
 		return err
 	}
 
+	if err := setRecordMetadata(d, connector, "record:mx", obj.Ref); err != nil {
+		return err
+	}
+
 	d.SetId(obj.Ref)
 
 	return nil
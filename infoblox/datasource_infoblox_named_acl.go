@@ -0,0 +1,84 @@
+package infoblox
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+)
+
+func dataSourceNamedACL() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceNamedACLRead,
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the named ACL.",
+			},
+			"access_list": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The list of IP addresses, networks, TSIG keys and other named ACLs which the ACL consists of.",
+				Elem:        aclItemSchemaElem(),
+			},
+			"comment": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Description of the named ACL.",
+			},
+			"ext_attrs": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Extensible attributes of the named ACL, as a map in JSON format.",
+			},
+		},
+	}
+}
+
+func dataSourceNamedACLRead(d *schema.ResourceData, m interface{}) error {
+	name := d.Get("name").(string)
+
+	connector := m.(ibclient.IBConnector)
+
+	var res []namedACL
+	sf := map[string]string{
+		"name": name,
+	}
+	err := connector.GetObject(newNamedACL(namedACL{}), "", ibclient.NewQueryParams(false, sf), &res)
+	if err != nil {
+		return fmt.Errorf("getting named ACL '%s' failed: %w", name, err)
+	}
+	if len(res) == 0 {
+		return fmt.Errorf("named ACL '%s' not found", name)
+	}
+	obj := res[0]
+
+	// TODO: temporary scaffold, need to rework marshalling/unmarshalling of EAs
+	//       (avoiding additional layer of keys ("value" key)
+	var eaMap map[string]interface{}
+	if obj.Ea != nil && len(obj.Ea) > 0 {
+		eaMap = (map[string]interface{})(obj.Ea)
+	} else {
+		eaMap = make(map[string]interface{})
+	}
+	ea, err := json.Marshal(eaMap)
+	if err != nil {
+		return err
+	}
+	if err = d.Set("ext_attrs", string(ea)); err != nil {
+		return err
+	}
+
+	if err := d.Set("access_list", convertACLItemsToInterface(obj.AccessList)); err != nil {
+		return err
+	}
+	if err := d.Set("comment", obj.Comment); err != nil {
+		return err
+	}
+
+	d.SetId(obj.Ref)
+
+	return nil
+}
@@ -0,0 +1,44 @@
+package infoblox
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceNamedACL(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceNamedACLRead,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.infoblox_named_acl.acctest", "access_list.#", "2"),
+					resource.TestCheckResourceAttr("data.infoblox_named_acl.acctest", "access_list.0.address", "10.5.0.0/16"),
+					resource.TestCheckResourceAttr("data.infoblox_named_acl.acctest", "access_list.1.address", "10.5.5.5"),
+					resource.TestCheckResourceAttr("data.infoblox_named_acl.acctest", "access_list.1.permission", "DENY"),
+					resource.TestCheckResourceAttr("data.infoblox_named_acl.acctest", "comment", "branch office clients"),
+				),
+			},
+		},
+	})
+}
+
+var testAccDataSourceNamedACLRead = `
+resource "infoblox_named_acl" "foo"{
+	name = "acl-ds1"
+	access_list {
+		address = "10.5.0.0/16"
+	}
+	access_list {
+		address = "10.5.5.5"
+		permission = "DENY"
+	}
+	comment = "branch office clients"
+}
+
+data "infoblox_named_acl" "acctest" {
+	name = infoblox_named_acl.foo.name
+}
+`
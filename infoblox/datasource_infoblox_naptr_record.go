@@ -0,0 +1,163 @@
+package infoblox
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+)
+
+func dataSourceNAPTRRecord() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceNAPTRRecordRead,
+
+		Schema: withRecordMetadataSchema(map[string]*schema.Schema{
+			"dns_view": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     defaultDNSView,
+				Description: "DNS view which the record's zone belongs to.",
+			},
+			"fqdn": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "FQDN for the NAPTR-record.",
+			},
+			"order": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				Description: "The order (0-65535) in which the NAPTR-records must be processed.",
+			},
+			"preference": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				Description: "The preference (0-65535) among the NAPTR-records with the same order.",
+			},
+			"flags": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The flags which control the interpretation of the fields.",
+			},
+			"services": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The services and protocols available down the rewrite path.",
+			},
+			"regexp": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The regular expression-based rewrite rule.",
+			},
+			"replacement": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The next domain name to look up.",
+			},
+			"zone": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The zone which the record belongs to.",
+			},
+			"ttl": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "TTL value for the NAPTR-record.",
+			},
+			"comment": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Description of the NAPTR-record.",
+			},
+			"ext_attrs": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Extensible attributes of the NAPTR-record, as a map in JSON format.",
+			},
+		}),
+	}
+}
+
+func dataSourceNAPTRRecordRead(d *schema.ResourceData, m interface{}) error {
+	dnsView := d.Get("dns_view").(string)
+	fqdn := d.Get("fqdn").(string)
+
+	order := d.Get("order").(int)
+	if err := ibclient.CheckIntRange("order", order, 0, 65535); err != nil {
+		return err
+	}
+	preference := d.Get("preference").(int)
+	if err := ibclient.CheckIntRange("preference", preference, 0, 65535); err != nil {
+		return err
+	}
+
+	connector := m.(ibclient.IBConnector)
+
+	var res []recordNAPTR
+	sf := map[string]string{
+		"view":       dnsView,
+		"name":       fqdn,
+		"order":      strconv.Itoa(order),
+		"preference": strconv.Itoa(preference),
+	}
+	err := connector.GetObject(newRecordNAPTR(recordNAPTR{}), "", ibclient.NewQueryParams(false, sf), &res)
+	if err != nil {
+		return fmt.Errorf("failed getting NAPTR-record: %w", err)
+	}
+	if len(res) == 0 {
+		return fmt.Errorf("NAPTR-record '%s' not found in DNS view '%s'", fqdn, dnsView)
+	}
+	obj := res[0]
+
+	ttl := int(obj.Ttl)
+	if !obj.UseTtl {
+		ttl = ttlUndef
+	}
+	if err = d.Set("ttl", ttl); err != nil {
+		return err
+	}
+
+	// TODO: temporary scaffold, need to rework marshalling/unmarshalling of EAs
+	//       (avoiding additional layer of keys ("value" key)
+	var eaMap map[string]interface{}
+	if obj.Ea != nil && len(obj.Ea) > 0 {
+		eaMap = (map[string]interface{})(obj.Ea)
+	} else {
+		eaMap = make(map[string]interface{})
+	}
+	ea, err := json.Marshal(eaMap)
+	if err != nil {
+		return err
+	}
+	if err = d.Set("ext_attrs", string(ea)); err != nil {
+		return err
+	}
+
+	if err = d.Set("flags", obj.Flags); err != nil {
+		return err
+	}
+	if err = d.Set("services", obj.Services); err != nil {
+		return err
+	}
+	if err = d.Set("regexp", obj.Regexp); err != nil {
+		return err
+	}
+	if err = d.Set("replacement", obj.Replacement); err != nil {
+		return err
+	}
+	if err = d.Set("zone", obj.Zone); err != nil {
+		return err
+	}
+	if err = d.Set("comment", obj.Comment); err != nil {
+		return err
+	}
+
+	if err := setRecordMetadata(d, connector, "record:naptr", obj.Ref); err != nil {
+		return err
+	}
+
+	d.SetId(obj.Ref)
+
+	return nil
+}
@@ -0,0 +1,46 @@
+package infoblox
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceNAPTRRecord(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceNAPTRRecordRead,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.infoblox_naptr_record.acctest", "dns_view", "default"),
+					resource.TestCheckResourceAttr("data.infoblox_naptr_record.acctest", "flags", "S"),
+					resource.TestCheckResourceAttr("data.infoblox_naptr_record.acctest", "services", "SIP+D2T"),
+					resource.TestCheckResourceAttr("data.infoblox_naptr_record.acctest", "replacement", "_sip._tcp.test.com"),
+					resource.TestCheckResourceAttr("data.infoblox_naptr_record.acctest", "zone", "test.com"),
+					resource.TestCheckResourceAttr("data.infoblox_naptr_record.acctest", "comment", "non-empty comment"),
+				),
+			},
+		},
+	})
+}
+
+var testAccDataSourceNAPTRRecordRead = `
+resource "infoblox_naptr_record" "foo" {
+	fqdn = "ds-voip.test.com"
+	order = 50
+	preference = 5
+	flags = "S"
+	services = "SIP+D2T"
+	replacement = "_sip._tcp.test.com"
+	comment = "non-empty comment"
+}
+
+data "infoblox_naptr_record" "acctest" {
+	dns_view = infoblox_naptr_record.foo.dns_view
+	fqdn = infoblox_naptr_record.foo.fqdn
+	order = infoblox_naptr_record.foo.order
+	preference = infoblox_naptr_record.foo.preference
+}
+`
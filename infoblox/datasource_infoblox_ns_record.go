@@ -0,0 +1,98 @@
+package infoblox
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+)
+
+func dataSourceNSRecord() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceNSRecordRead,
+
+		Schema: map[string]*schema.Schema{
+			"dns_view": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     defaultDNSView,
+				Description: "DNS view which the zone does exist within.",
+			},
+			"zone": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The zone which the NS-records belong to.",
+			},
+			"records": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The list of NS-records of the zone.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The reference of the NS-record.",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the zone (or the delegated subzone) which the NS-record is for.",
+						},
+						"nameserver": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The FQDN of the name server.",
+						},
+						"addresses": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "The list of IP addresses of the name server.",
+							Elem:        nsRecordAddressesSchemaElem(),
+						},
+						"ms_delegation_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the delegation for a Microsoft DNS server.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceNSRecordRead(d *schema.ResourceData, m interface{}) error {
+	dnsView := d.Get("dns_view").(string)
+	zone := d.Get("zone").(string)
+
+	connector := m.(ibclient.IBConnector)
+
+	var res []recordNS
+	sf := map[string]string{
+		"view": dnsView,
+		"zone": zone,
+	}
+	err := connector.GetObject(newRecordNS(recordNS{}), "", ibclient.NewQueryParams(false, sf), &res)
+	if err != nil {
+		return fmt.Errorf("failed getting NS-records of the zone '%s': %w", zone, err)
+	}
+
+	records := make([]map[string]interface{}, 0, len(res))
+	for _, rec := range res {
+		records = append(records, map[string]interface{}{
+			"id":                 rec.Ref,
+			"name":               rec.Name,
+			"nameserver":         rec.Nameserver,
+			"addresses":          convertZoneNameServersToInterface(rec.Addresses),
+			"ms_delegation_name": rec.MsDelegationName,
+		})
+	}
+	if err = d.Set("records", records); err != nil {
+		return err
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", zone, dnsView))
+
+	return nil
+}
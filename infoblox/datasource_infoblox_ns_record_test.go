@@ -0,0 +1,45 @@
+package infoblox
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceNSRecord(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceNSRecordsRead,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.infoblox_ns_record.acctest", "dns_view", "default"),
+					resource.TestCheckResourceAttr("data.infoblox_ns_record.acctest", "zone", "test.com"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.infoblox_ns_record.acctest", "records.*", map[string]string{
+						"name":                "test.com",
+						"nameserver":          "ns3.test.com",
+						"addresses.0.address": "10.0.0.55",
+					}),
+				),
+			},
+		},
+	})
+}
+
+var testAccDataSourceNSRecordsRead = `
+resource "infoblox_ns_record" "foo"{
+	name = "test.com"
+	nameserver = "ns3.test.com"
+	addresses {
+		address = "10.0.0.55"
+	}
+}
+
+data "infoblox_ns_record" "acctest" {
+	dns_view = "default"
+	zone = "test.com"
+
+	depends_on = [infoblox_ns_record.foo]
+}
+`
@@ -12,7 +12,7 @@ func dataSourcePtrRecord() *schema.Resource {
 	return &schema.Resource{
 		Read: dataSourcePtrRecordRead,
 
-		Schema: map[string]*schema.Schema{
+		Schema: withRecordMetadataSchema(map[string]*schema.Schema{
 			"dns_view": {
 				Type:        schema.TypeString,
 				Default:     defaultDNSView,
@@ -56,7 +56,7 @@ func dataSourcePtrRecord() *schema.Resource {
 				Computed:    true,
 				Description: "The Extensible attributes of the PTR-record.",
 			},
-		},
+		}),
 	}
 }
 
@@ -120,6 +120,10 @@ func dataSourcePtrRecordRead(d *schema.ResourceData, m interface{}) error {
 		return err
 	}
 
+	if err := setRecordMetadata(d, connector, "record:ptr", obj.Ref); err != nil {
+		return err
+	}
+
 	d.SetId(obj.Ref)
 
 	return nil
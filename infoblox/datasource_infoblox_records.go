@@ -0,0 +1,615 @@
+package infoblox
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+)
+
+const (
+	recordsFieldString = iota
+	recordsFieldInt
+	// The text of a TXT-record, which is also exposed as a list of character-strings.
+	recordsFieldText
+)
+
+// recordsField describes a type-specific field of the records which are returned by a plural data source.
+type recordsField struct {
+	// The name of the field within the records' list.
+	name string
+	// The name of the field of the WAPI object.
+	wapiName string
+	// The name of the WAPI field which is used for IPv6 addresses, if it differs from 'wapiName'.
+	ipv6WapiName string
+	kind         int
+	description  string
+}
+
+// recordsKind describes a type of DNS records which a plural data source returns.
+type recordsKind struct {
+	objectType string
+	// The name of the field which contains the name of a record,
+	// the same as for the data source of a single record.
+	nameField   string
+	fields      []recordsField
+	description string
+}
+
+var (
+	recordsKindA = recordsKind{
+		objectType: "record:a",
+		nameField:  "fqdn",
+		fields: []recordsField{
+			{name: "ip_addr", wapiName: "ipv4addr", description: "The IPv4 address of the record."},
+		},
+		description: "A-record",
+	}
+	recordsKindAAAA = recordsKind{
+		objectType: "record:aaaa",
+		nameField:  "fqdn",
+		fields: []recordsField{
+			{name: "ipv6_addr", wapiName: "ipv6addr", description: "The IPv6 address of the record."},
+		},
+		description: "AAAA-record",
+	}
+	recordsKindCName = recordsKind{
+		objectType: "record:cname",
+		nameField:  "alias",
+		fields: []recordsField{
+			{name: "canonical", wapiName: "canonical", description: "The canonical name of the record."},
+		},
+		description: "CNAME-record",
+	}
+	recordsKindPTR = recordsKind{
+		objectType: "record:ptr",
+		nameField:  "record_name",
+		fields: []recordsField{
+			{name: "ptrdname", wapiName: "ptrdname", description: "The domain name which the record points to."},
+			{name: "ip_addr", wapiName: "ipv4addr", ipv6WapiName: "ipv6addr", description: "The IPv4 or IPv6 address of the record."},
+		},
+		description: "PTR-record",
+	}
+	recordsKindMX = recordsKind{
+		objectType: "record:mx",
+		nameField:  "fqdn",
+		fields: []recordsField{
+			{name: "mail_exchanger", wapiName: "mail_exchanger", description: "The FQDN of the mail server."},
+			{name: "preference", wapiName: "preference", kind: recordsFieldInt, description: "The preference of the record."},
+		},
+		description: "MX-record",
+	}
+	recordsKindTXT = recordsKind{
+		objectType: "record:txt",
+		nameField:  "fqdn",
+		fields: []recordsField{
+			{name: "text", wapiName: "text", kind: recordsFieldText, description: "The text of the record."},
+		},
+		description: "TXT-record",
+	}
+	recordsKindSRV = recordsKind{
+		objectType: "record:srv",
+		nameField:  "name",
+		fields: []recordsField{
+			{name: "priority", wapiName: "priority", kind: recordsFieldInt, description: "The priority of the record."},
+			{name: "weight", wapiName: "weight", kind: recordsFieldInt, description: "The weight of the record."},
+			{name: "port", wapiName: "port", kind: recordsFieldInt, description: "The port of the service."},
+			{name: "target", wapiName: "target", description: "The FQDN of the host which provides the service."},
+		},
+		description: "SRV-record",
+	}
+	recordsKindCAA = recordsKind{
+		objectType: "record:caa",
+		nameField:  "fqdn",
+		fields: []recordsField{
+			{name: "ca_flag", wapiName: "ca_flag", kind: recordsFieldInt, description: "The flag of the record."},
+			{name: "ca_tag", wapiName: "ca_tag", description: "The tag of the record."},
+			{name: "ca_value", wapiName: "ca_value", description: "The value of the record."},
+		},
+		description: "CAA-record",
+	}
+	recordsKindNAPTR = recordsKind{
+		objectType: "record:naptr",
+		nameField:  "fqdn",
+		fields: []recordsField{
+			{name: "order", wapiName: "order", kind: recordsFieldInt, description: "The order of the record."},
+			{name: "preference", wapiName: "preference", kind: recordsFieldInt, description: "The preference of the record."},
+			{name: "flags", wapiName: "flags", description: "The flags of the record."},
+			{name: "services", wapiName: "services", description: "The services of the record."},
+			{name: "regexp", wapiName: "regexp", description: "The regular expression of the record."},
+			{name: "replacement", wapiName: "replacement", description: "The replacement of the record."},
+		},
+		description: "NAPTR-record",
+	}
+	recordsKindDNAME = recordsKind{
+		objectType: "record:dname",
+		nameField:  "fqdn",
+		fields: []recordsField{
+			{name: "target", wapiName: "target", description: "The target domain name of the record."},
+		},
+		description: "DNAME-record",
+	}
+	recordsKindAlias = recordsKind{
+		objectType: "record:alias",
+		nameField:  "fqdn",
+		fields: []recordsField{
+			{name: "target_name", wapiName: "target_name", description: "The name of the target of the record."},
+			{name: "target_type", wapiName: "target_type", description: "The type of the target of the record."},
+		},
+		description: "ALIAS-record",
+	}
+)
+
+// The read-only fields of a record, which describe its origin and usage.
+var recordMetadataReturnFields = []string{"creator", "creation_time", "last_queried"}
+
+func recordMetadataSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"creator": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The creator of the record: 'STATIC', 'DYNAMIC' or 'SYSTEM'.",
+		},
+		"creation_time": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The time when the record was created, in RFC 3339 format; empty if it is unknown.",
+		},
+		"last_queried": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The time when the record was queried last time, in RFC 3339 format; empty if it has never been queried.",
+		},
+	}
+}
+
+// Adds the metadata fields to the schema of a record's data source.
+func withRecordMetadataSchema(s map[string]*schema.Schema) map[string]*schema.Schema {
+	for key, value := range recordMetadataSchema() {
+		s[key] = value
+	}
+
+	return s
+}
+
+// Converts a timestamp of WAPI (seconds since the epoch) into RFC 3339 format.
+// Zero or missing value means that the time is unknown.
+func formatRecordTimestamp(value interface{}) string {
+	seconds, _ := value.(float64)
+	if seconds <= 0 {
+		return ""
+	}
+	return time.Unix(int64(seconds), 0).UTC().Format(time.RFC3339)
+}
+
+func convertRecordMetadata(fields map[string]interface{}) map[string]interface{} {
+	creator, _ := fields["creator"].(string)
+	return map[string]interface{}{
+		"creator":       creator,
+		"creation_time": formatRecordTimestamp(fields["creation_time"]),
+		"last_queried":  formatRecordTimestamp(fields["last_queried"]),
+	}
+}
+
+// Sets the metadata fields of a record's data source. The object structures
+// of the client library do not contain the fields, thus they are got separately.
+func setRecordMetadata(d *schema.ResourceData, connector ibclient.IBConnector, objectType string, ref string) error {
+	rec := newGenericRecord(objectType, recordMetadataReturnFields, nil)
+	if err := connector.GetObject(rec, ref, ibclient.NewQueryParams(false, nil), rec); err != nil {
+		return fmt.Errorf("failed getting the metadata of the record: %w", err)
+	}
+	for key, value := range convertRecordMetadata(rec.Fields) {
+		if err := d.Set(key, value); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (kind recordsKind) returnFields() []string {
+	res := []string{"name", "view", "zone", "ttl", "use_ttl", "comment", "extattrs"}
+	res = append(res, recordMetadataReturnFields...)
+	for _, field := range kind.fields {
+		res = append(res, field.wapiName)
+		if field.ipv6WapiName != "" {
+			res = append(res, field.ipv6WapiName)
+		}
+	}
+
+	return res
+}
+
+func dataSourceRecords(kind recordsKind) *schema.Resource {
+	recordSchema := map[string]*schema.Schema{
+		"id": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: fmt.Sprintf("The reference of the %s.", kind.description),
+		},
+		kind.nameField: {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: fmt.Sprintf("The name of the %s.", kind.description),
+		},
+		"dns_view": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "DNS view which the record's zone belongs to.",
+		},
+		"zone": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The zone which the record belongs to.",
+		},
+		"ttl": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: fmt.Sprintf("TTL value of the %s.", kind.description),
+		},
+		"comment": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: fmt.Sprintf("Description of the %s.", kind.description),
+		},
+		"ext_attrs": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: fmt.Sprintf("Extensible attributes of the %s, as a map in JSON format.", kind.description),
+		},
+	}
+	recordSchema = withRecordMetadataSchema(recordSchema)
+
+	res := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"dns_view": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     defaultDNSView,
+				Description: "DNS view which the records' zones belong to.",
+			},
+			"zone": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "The zone which the records belong to.",
+			},
+			"name_regex": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "The regular expression which the names of the records must match.",
+			},
+			"ext_attrs": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "Extensible attributes which the records must have, as a map in JSON format.",
+			},
+			"records": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: fmt.Sprintf("The list of %ss which match the filters.", kind.description),
+				Elem:        &schema.Resource{Schema: recordSchema},
+			},
+		},
+	}
+
+	for _, field := range kind.fields {
+		switch field.kind {
+		case recordsFieldInt:
+			recordSchema[field.name] = &schema.Schema{
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: field.description,
+			}
+		case recordsFieldText:
+			recordSchema[field.name] = &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: field.description + " The character-strings are concatenated.",
+			}
+			recordSchema[field.name+"_strings"] = &schema.Schema{
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: field.description + " As a list of character-strings.",
+			}
+		default:
+			recordSchema[field.name] = &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: field.description,
+			}
+			// The string fields may be used as exact match filters.
+			res.Schema[field.name] = &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "The filter by the field of the records: " + field.description,
+			}
+		}
+	}
+
+	return res
+}
+
+// Builds the search fields of WAPI out of the filters of the data source.
+func buildRecordsSearchFields(d *schema.ResourceData, kind recordsKind) (map[string]string, error) {
+	sf := map[string]string{
+		"view": d.Get("dns_view").(string),
+	}
+	if zone := d.Get("zone").(string); zone != "" {
+		sf["zone"] = zone
+	}
+	if nameRegex := d.Get("name_regex").(string); nameRegex != "" {
+		sf["name~"] = nameRegex
+	}
+
+	if extAttrJSON := d.Get("ext_attrs").(string); extAttrJSON != "" {
+		var extAttrs map[string]interface{}
+		if err := json.Unmarshal([]byte(extAttrJSON), &extAttrs); err != nil {
+			return nil, fmt.Errorf("cannot process 'ext_attrs' field: %w", err)
+		}
+		for name, value := range extAttrs {
+			sf["*"+name] = fmt.Sprint(value)
+		}
+	}
+
+	for _, field := range kind.fields {
+		if field.kind != recordsFieldString {
+			continue
+		}
+		value := d.Get(field.name).(string)
+		if value == "" {
+			continue
+		}
+		wapiName := field.wapiName
+		if ip := net.ParseIP(value); field.ipv6WapiName != "" && ip != nil && ip.To4() == nil {
+			wapiName = field.ipv6WapiName
+		}
+		sf[wapiName] = value
+	}
+
+	return sf, nil
+}
+
+// Converts a record, got from NIOS, into an item of 'records' field.
+func convertRecordToInterface(kind recordsKind, rec genericRecord) (map[string]interface{}, error) {
+	stringField := func(wapiName string) string {
+		res, _ := rec.Fields[wapiName].(string)
+		return res
+	}
+
+	ttl := ttlUndef
+	if useTtl, _ := rec.Fields["use_ttl"].(bool); useTtl {
+		if val, ok := rec.Fields["ttl"].(float64); ok {
+			ttl = int(val)
+		}
+	}
+
+	// TODO: temporary scaffold, need to rework marshalling/unmarshalling of EAs
+	//       (avoiding additional layer of keys ("value" key)
+	eaMap := make(ibclient.EA)
+	if wapiEAs, found := rec.Fields["extattrs"]; found && wapiEAs != nil {
+		eaJSON, err := json.Marshal(wapiEAs)
+		if err != nil {
+			return nil, err
+		}
+		if err = json.Unmarshal(eaJSON, &eaMap); err != nil {
+			return nil, err
+		}
+	}
+	ea, err := json.Marshal((map[string]interface{})(eaMap))
+	if err != nil {
+		return nil, err
+	}
+
+	res := map[string]interface{}{
+		"id":           rec.Ref,
+		kind.nameField: stringField("name"),
+		"dns_view":     stringField("view"),
+		"zone":         stringField("zone"),
+		"ttl":          ttl,
+		"comment":      stringField("comment"),
+		"ext_attrs":    string(ea),
+	}
+	for key, value := range convertRecordMetadata(rec.Fields) {
+		res[key] = value
+	}
+	for _, field := range kind.fields {
+		switch field.kind {
+		case recordsFieldInt:
+			val, _ := rec.Fields[field.wapiName].(float64)
+			res[field.name] = int(val)
+		case recordsFieldText:
+			strs := parseTXTRecordText(stringField(field.wapiName))
+			res[field.name] = strings.Join(strs, "")
+			res[field.name+"_strings"] = strs
+		default:
+			val := stringField(field.wapiName)
+			if val == "" && field.ipv6WapiName != "" {
+				val = stringField(field.ipv6WapiName)
+			}
+			res[field.name] = val
+		}
+	}
+
+	return res, nil
+}
+
+func dataSourceRecordsRead(d *schema.ResourceData, m interface{}, kind recordsKind) error {
+	sf, err := buildRecordsSearchFields(d, kind)
+	if err != nil {
+		return err
+	}
+
+	connector := m.(ibclient.IBConnector)
+
+	var recs []genericRecord
+	err = connector.GetObject(
+		newGenericRecord(kind.objectType, kind.returnFields(), nil), "", ibclient.NewQueryParams(false, sf), &recs)
+	if err != nil && !isNotFoundError(err) {
+		return fmt.Errorf("failed getting %ss: %w", kind.description, err)
+	}
+	sort.SliceStable(recs, func(i, j int) bool {
+		nameI, _ := recs[i].Fields["name"].(string)
+		nameJ, _ := recs[j].Fields["name"].(string)
+		if nameI != nameJ {
+			return nameI < nameJ
+		}
+		return recs[i].Ref < recs[j].Ref
+	})
+
+	records := make([]map[string]interface{}, 0, len(recs))
+	for _, rec := range recs {
+		item, err := convertRecordToInterface(kind, rec)
+		if err != nil {
+			return err
+		}
+		records = append(records, item)
+	}
+	if err = d.Set("records", records); err != nil {
+		return err
+	}
+
+	// The ID is made of the filters, to be stable for the same data source block.
+	keys := make([]string, 0, len(sf))
+	for key := range sf {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	filters := make([]string, 0, len(keys))
+	for _, key := range keys {
+		filters = append(filters, fmt.Sprintf("%s=%s", key, sf[key]))
+	}
+	d.SetId(fmt.Sprintf("%s?%s", kind.objectType, strings.Join(filters, "&")))
+
+	return nil
+}
+
+func dataSourceARecordsRead(d *schema.ResourceData, m interface{}) error {
+	return dataSourceRecordsRead(d, m, recordsKindA)
+}
+
+func dataSourceARecords() *schema.Resource {
+	r := dataSourceRecords(recordsKindA)
+	r.Read = dataSourceARecordsRead
+
+	return r
+}
+
+func dataSourceAAAARecordsRead(d *schema.ResourceData, m interface{}) error {
+	return dataSourceRecordsRead(d, m, recordsKindAAAA)
+}
+
+func dataSourceAAAARecords() *schema.Resource {
+	r := dataSourceRecords(recordsKindAAAA)
+	r.Read = dataSourceAAAARecordsRead
+
+	return r
+}
+
+func dataSourceCNameRecordsRead(d *schema.ResourceData, m interface{}) error {
+	return dataSourceRecordsRead(d, m, recordsKindCName)
+}
+
+func dataSourceCNameRecords() *schema.Resource {
+	r := dataSourceRecords(recordsKindCName)
+	r.Read = dataSourceCNameRecordsRead
+
+	return r
+}
+
+func dataSourcePTRRecordsRead(d *schema.ResourceData, m interface{}) error {
+	return dataSourceRecordsRead(d, m, recordsKindPTR)
+}
+
+func dataSourcePTRRecords() *schema.Resource {
+	r := dataSourceRecords(recordsKindPTR)
+	r.Read = dataSourcePTRRecordsRead
+
+	return r
+}
+
+func dataSourceMXRecordsRead(d *schema.ResourceData, m interface{}) error {
+	return dataSourceRecordsRead(d, m, recordsKindMX)
+}
+
+func dataSourceMXRecords() *schema.Resource {
+	r := dataSourceRecords(recordsKindMX)
+	r.Read = dataSourceMXRecordsRead
+
+	return r
+}
+
+func dataSourceTXTRecordsRead(d *schema.ResourceData, m interface{}) error {
+	return dataSourceRecordsRead(d, m, recordsKindTXT)
+}
+
+func dataSourceTXTRecords() *schema.Resource {
+	r := dataSourceRecords(recordsKindTXT)
+	r.Read = dataSourceTXTRecordsRead
+
+	return r
+}
+
+func dataSourceSRVRecordsRead(d *schema.ResourceData, m interface{}) error {
+	return dataSourceRecordsRead(d, m, recordsKindSRV)
+}
+
+func dataSourceSRVRecords() *schema.Resource {
+	r := dataSourceRecords(recordsKindSRV)
+	r.Read = dataSourceSRVRecordsRead
+
+	return r
+}
+
+func dataSourceCAARecordsRead(d *schema.ResourceData, m interface{}) error {
+	return dataSourceRecordsRead(d, m, recordsKindCAA)
+}
+
+func dataSourceCAARecords() *schema.Resource {
+	r := dataSourceRecords(recordsKindCAA)
+	r.Read = dataSourceCAARecordsRead
+
+	return r
+}
+
+func dataSourceNAPTRRecordsRead(d *schema.ResourceData, m interface{}) error {
+	return dataSourceRecordsRead(d, m, recordsKindNAPTR)
+}
+
+func dataSourceNAPTRRecords() *schema.Resource {
+	r := dataSourceRecords(recordsKindNAPTR)
+	r.Read = dataSourceNAPTRRecordsRead
+
+	return r
+}
+
+func dataSourceDNAMERecordsRead(d *schema.ResourceData, m interface{}) error {
+	return dataSourceRecordsRead(d, m, recordsKindDNAME)
+}
+
+func dataSourceDNAMERecords() *schema.Resource {
+	r := dataSourceRecords(recordsKindDNAME)
+	r.Read = dataSourceDNAMERecordsRead
+
+	return r
+}
+
+func dataSourceAliasRecordsRead(d *schema.ResourceData, m interface{}) error {
+	return dataSourceRecordsRead(d, m, recordsKindAlias)
+}
+
+func dataSourceAliasRecords() *schema.Resource {
+	r := dataSourceRecords(recordsKindAlias)
+	r.Read = dataSourceAliasRecordsRead
+
+	return r
+}
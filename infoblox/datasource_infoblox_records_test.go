@@ -0,0 +1,193 @@
+package infoblox
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceRecords(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceRecordsRead,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.infoblox_a_records.by_zone", "records.#", "3"),
+					resource.TestCheckResourceAttr("data.infoblox_a_records.by_zone", "records.0.fqdn", "app1.records-ds.test.com"),
+					resource.TestCheckResourceAttr("data.infoblox_a_records.by_zone", "records.0.ip_addr", "10.0.0.31"),
+					resource.TestCheckResourceAttr("data.infoblox_a_records.by_zone", "records.0.zone", "records-ds.test.com"),
+					resource.TestCheckResourceAttr("data.infoblox_a_records.by_zone", "records.0.ttl", "300"),
+					resource.TestCheckResourceAttr("data.infoblox_a_records.by_zone", "records.0.ext_attrs", `{"Owner":"team-x"}`),
+
+					resource.TestCheckResourceAttr("data.infoblox_a_records.by_name", "records.#", "2"),
+					resource.TestCheckResourceAttr("data.infoblox_a_records.by_name", "records.1.fqdn", "app2.records-ds.test.com"),
+
+					resource.TestCheckResourceAttr("data.infoblox_a_records.by_ea", "records.#", "2"),
+					resource.TestCheckResourceAttr("data.infoblox_a_records.by_ea", "records.1.fqdn", "db1.records-ds.test.com"),
+					resource.TestCheckResourceAttr("data.infoblox_a_records.by_ea", "records.1.comment", "database"),
+
+					resource.TestCheckResourceAttr("data.infoblox_cname_records.by_canonical", "records.#", "1"),
+					resource.TestCheckResourceAttr("data.infoblox_cname_records.by_canonical", "records.0.alias", "www.records-ds.test.com"),
+					resource.TestCheckResourceAttr("data.infoblox_cname_records.by_canonical", "records.0.canonical", "app1.records-ds.test.com"),
+
+					resource.TestCheckResourceAttr("data.infoblox_txt_records.all", "records.#", "1"),
+					resource.TestCheckResourceAttr("data.infoblox_txt_records.all", "records.0.text", "v=spf1 -all"),
+					resource.TestCheckResourceAttr("data.infoblox_txt_records.all", "records.0.text_strings.#", "1"),
+
+					resource.TestCheckResourceAttr("data.infoblox_mx_records.none", "records.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+var testAccDataSourceRecordsRead = `
+resource "infoblox_zone_auth" "zone" {
+	fqdn = "records-ds.test.com"
+}
+
+resource "infoblox_a_record" "app1" {
+	fqdn = "app1.${infoblox_zone_auth.zone.fqdn}"
+	ip_addr = "10.0.0.31"
+	ttl = 300
+	ext_attrs = jsonencode({
+		"Owner" = "team-x"
+	})
+}
+
+resource "infoblox_a_record" "app2" {
+	fqdn = "app2.${infoblox_zone_auth.zone.fqdn}"
+	ip_addr = "10.0.0.32"
+}
+
+resource "infoblox_a_record" "db1" {
+	fqdn = "db1.${infoblox_zone_auth.zone.fqdn}"
+	ip_addr = "10.0.0.33"
+	comment = "database"
+	ext_attrs = jsonencode({
+		"Owner" = "team-x"
+	})
+}
+
+resource "infoblox_cname_record" "www" {
+	alias = "www.${infoblox_zone_auth.zone.fqdn}"
+	canonical = infoblox_a_record.app1.fqdn
+}
+
+resource "infoblox_cname_record" "ftp" {
+	alias = "ftp.${infoblox_zone_auth.zone.fqdn}"
+	canonical = infoblox_a_record.app2.fqdn
+}
+
+resource "infoblox_txt_record" "spf" {
+	fqdn = infoblox_zone_auth.zone.fqdn
+	text = "v=spf1 -all"
+}
+
+data "infoblox_a_records" "by_zone" {
+	zone = infoblox_zone_auth.zone.fqdn
+
+	depends_on = [infoblox_a_record.app1, infoblox_a_record.app2, infoblox_a_record.db1]
+}
+
+data "infoblox_a_records" "by_name" {
+	name_regex = "^app[0-9]+\\.records-ds\\.test\\.com$"
+
+	depends_on = [infoblox_a_record.app1, infoblox_a_record.app2, infoblox_a_record.db1]
+}
+
+data "infoblox_a_records" "by_ea" {
+	zone = infoblox_zone_auth.zone.fqdn
+	ext_attrs = jsonencode({
+		"Owner" = "team-x"
+	})
+
+	depends_on = [infoblox_a_record.app1, infoblox_a_record.app2, infoblox_a_record.db1]
+}
+
+data "infoblox_cname_records" "by_canonical" {
+	canonical = infoblox_a_record.app1.fqdn
+
+	depends_on = [infoblox_cname_record.www, infoblox_cname_record.ftp]
+}
+
+data "infoblox_txt_records" "all" {
+	zone = infoblox_zone_auth.zone.fqdn
+
+	depends_on = [infoblox_txt_record.spf]
+}
+
+data "infoblox_mx_records" "none" {
+	zone = infoblox_zone_auth.zone.fqdn
+}
+`
+
+func TestConvertRecordToInterface(t *testing.T) {
+	rec := genericRecord{
+		Ref: "record:ptr/ZG5zLmJpbmRfcHRy:1.0.0.10.in-addr.arpa/default",
+		Fields: map[string]interface{}{
+			"name":          "1.0.0.10.in-addr.arpa",
+			"view":          "default",
+			"zone":          "0.0.10.in-addr.arpa",
+			"ptrdname":      "host1.test.com",
+			"ipv4addr":      "",
+			"ipv6addr":      "2001:db8::1",
+			"ttl":           float64(300),
+			"use_ttl":       false,
+			"comment":       "reverse",
+			"creator":       "STATIC",
+			"creation_time": float64(1700000000),
+			"extattrs": map[string]interface{}{
+				"Owner": map[string]interface{}{"value": "team-x"},
+			},
+		},
+	}
+
+	res, err := convertRecordToInterface(recordsKindPTR, rec)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	expected := map[string]interface{}{
+		"id":            rec.Ref,
+		"record_name":   "1.0.0.10.in-addr.arpa",
+		"dns_view":      "default",
+		"zone":          "0.0.10.in-addr.arpa",
+		"ptrdname":      "host1.test.com",
+		"ip_addr":       "2001:db8::1",
+		"ttl":           ttlUndef,
+		"comment":       "reverse",
+		"ext_attrs":     `{"Owner":"team-x"}`,
+		"creator":       "STATIC",
+		"creation_time": "2023-11-14T22:13:20Z",
+		"last_queried":  "",
+	}
+	if len(res) != len(expected) {
+		t.Errorf("unexpected fields: %v", res)
+	}
+	for key, value := range expected {
+		if res[key] != value {
+			t.Errorf("'%s' does not match: got '%v', expected '%v'", key, res[key], value)
+		}
+	}
+
+	rec = genericRecord{
+		Fields: map[string]interface{}{
+			"name":    "test.com",
+			"text":    `"v=DKIM1; " "p=MIIB"`,
+			"use_ttl": true,
+			"ttl":     float64(0),
+		},
+	}
+	res, err = convertRecordToInterface(recordsKindTXT, rec)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if res["text"] != "v=DKIM1; p=MIIB" || len(res["text_strings"].([]string)) != 2 || res["ttl"] != 0 {
+		t.Errorf("unexpected TXT-record: %v", res)
+	}
+	if res["ext_attrs"] != "{}" {
+		t.Errorf("empty extensible attributes are expected, got '%v'", res["ext_attrs"])
+	}
+}
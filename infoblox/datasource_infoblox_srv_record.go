@@ -13,7 +13,7 @@ func dataSourceSRVRecord() *schema.Resource {
 	return &schema.Resource{
 		Read: dataSourceSRVRecordRead,
 
-		Schema: map[string]*schema.Schema{
+		Schema: withRecordMetadataSchema(map[string]*schema.Schema{
 			"id": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -69,7 +69,7 @@ func dataSourceSRVRecord() *schema.Resource {
 				Computed:    true,
 				Description: "Extensible attributes of the SRV-record to be added/updated, as a map in JSON format.",
 			},
-		},
+		}),
 	}
 }
 
@@ -127,6 +127,10 @@ func dataSourceSRVRecordRead(d *schema.ResourceData, m interface{}) error {
 		return err
 	}
 
+	if err := setRecordMetadata(d, connector, "record:srv", obj.Ref); err != nil {
+		return err
+	}
+
 	d.SetId(obj.Ref)
 
 	return nil
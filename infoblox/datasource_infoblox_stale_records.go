@@ -0,0 +1,223 @@
+package infoblox
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+)
+
+// The types of the records which are checked for being stale.
+var staleRecordsKinds = []recordsKind{
+	recordsKindA,
+	recordsKindAAAA,
+	recordsKindCName,
+	recordsKindPTR,
+	recordsKindMX,
+	recordsKindTXT,
+	recordsKindSRV,
+	recordsKindCAA,
+	recordsKindNAPTR,
+	recordsKindDNAME,
+	recordsKindAlias,
+}
+
+func staleRecordsSupportedTypes() []string {
+	res := make([]string, 0, len(staleRecordsKinds))
+	for _, kind := range staleRecordsKinds {
+		res = append(res, kind.objectType)
+	}
+
+	return res
+}
+
+func dataSourceStaleRecords() *schema.Resource {
+	recordSchema := map[string]*schema.Schema{
+		"id": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The reference of the record.",
+		},
+		"type": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The WAPI type of the record, ex. 'record:a'.",
+		},
+		"name": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The name of the record.",
+		},
+		"dns_view": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "DNS view which the record's zone belongs to.",
+		},
+		"zone": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The zone which the record belongs to.",
+		},
+		"comment": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Description of the record.",
+		},
+	}
+
+	return &schema.Resource{
+		Read: dataSourceStaleRecordsRead,
+
+		Schema: map[string]*schema.Schema{
+			"dns_view": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     defaultDNSView,
+				Description: "DNS view which the records' zones belong to.",
+			},
+			"zone": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "The zone which the records belong to; all the zones of the DNS view are checked by default.",
+			},
+			"not_queried_within": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The duration, ex. '720h', which the records have not been queried within.",
+			},
+			"record_types": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The WAPI types of the records to check, ex. 'record:a'; all the supported types are checked by default.",
+			},
+			"records": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The list of the records which have not been queried within the duration.",
+				Elem:        &schema.Resource{Schema: withRecordMetadataSchema(recordSchema)},
+			},
+		},
+	}
+}
+
+// A record is stale if it has not been queried since the threshold time.
+// A record which has never been queried is stale unless it has been created after the threshold time.
+func isRecordStale(fields map[string]interface{}, threshold time.Time) bool {
+	if lastQueried, _ := fields["last_queried"].(float64); lastQueried > 0 {
+		return time.Unix(int64(lastQueried), 0).Before(threshold)
+	}
+	if creationTime, _ := fields["creation_time"].(float64); creationTime > 0 {
+		return time.Unix(int64(creationTime), 0).Before(threshold)
+	}
+
+	return true
+}
+
+func convertStaleRecordToInterface(objectType string, rec genericRecord) map[string]interface{} {
+	stringField := func(wapiName string) string {
+		res, _ := rec.Fields[wapiName].(string)
+		return res
+	}
+
+	res := map[string]interface{}{
+		"id":       rec.Ref,
+		"type":     objectType,
+		"name":     stringField("name"),
+		"dns_view": stringField("view"),
+		"zone":     stringField("zone"),
+		"comment":  stringField("comment"),
+	}
+	for key, value := range convertRecordMetadata(rec.Fields) {
+		res[key] = value
+	}
+
+	return res
+}
+
+func dataSourceStaleRecordsRead(d *schema.ResourceData, m interface{}) error {
+	dnsView := d.Get("dns_view").(string)
+	zone := strings.TrimSuffix(d.Get("zone").(string), ".")
+
+	notQueriedWithin := d.Get("not_queried_within").(string)
+	duration, err := time.ParseDuration(notQueriedWithin)
+	if err != nil {
+		return fmt.Errorf("cannot process 'not_queried_within' field: %w", err)
+	}
+	if duration <= 0 {
+		return fmt.Errorf("'not_queried_within' field must be a positive duration")
+	}
+	threshold := time.Now().Add(-duration)
+
+	kinds := staleRecordsKinds
+	if recTypes := d.Get("record_types").([]interface{}); len(recTypes) > 0 {
+		kinds = make([]recordsKind, 0, len(recTypes))
+		for _, item := range recTypes {
+			recType := strings.ToLower(item.(string))
+			found := false
+			for _, kind := range staleRecordsKinds {
+				if kind.objectType == recType {
+					kinds = append(kinds, kind)
+					found = true
+					break
+				}
+			}
+			if !found {
+				return fmt.Errorf(
+					"unsupported record type '%s', the supported types are: %s",
+					item.(string), strings.Join(staleRecordsSupportedTypes(), ", "))
+			}
+		}
+	}
+
+	sf := map[string]string{
+		"view": dnsView,
+	}
+	if zone != "" {
+		sf["zone"] = zone
+	}
+	returnFields := append([]string{"name", "view", "zone", "comment"}, recordMetadataReturnFields...)
+
+	connector := m.(ibclient.IBConnector)
+
+	records := make([]map[string]interface{}, 0)
+	for _, kind := range kinds {
+		var recs []genericRecord
+		err = connector.GetObject(
+			newGenericRecord(kind.objectType, returnFields, nil), "", ibclient.NewQueryParams(false, sf), &recs)
+		if err != nil && !isNotFoundError(err) {
+			return fmt.Errorf("failed getting %ss: %w", kind.description, err)
+		}
+		for _, rec := range recs {
+			if isRecordStale(rec.Fields, threshold) {
+				records = append(records, convertStaleRecordToInterface(kind.objectType, rec))
+			}
+		}
+	}
+	sort.SliceStable(records, func(i, j int) bool {
+		ki := zoneExportNameSortKey(records[i]["name"].(string))
+		kj := zoneExportNameSortKey(records[j]["name"].(string))
+		if ki != kj {
+			return ki < kj
+		}
+		return records[i]["type"].(string) < records[j]["type"].(string)
+	})
+
+	if err = d.Set("records", records); err != nil {
+		return err
+	}
+
+	types := make([]string, 0, len(kinds))
+	for _, kind := range kinds {
+		types = append(types, kind.objectType)
+	}
+	d.SetId(fmt.Sprintf(
+		"stale-records?view=%s&zone=%s&not_queried_within=%s&type=%s",
+		dnsView, zone, notQueriedWithin, strings.Join(types, ",")))
+
+	return nil
+}
@@ -0,0 +1,123 @@
+package infoblox
+
+import (
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceStaleRecords(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceStaleRecordsRead,
+				Check: resource.ComposeTestCheckFunc(
+					// the records have just been created and have never been queried
+					resource.TestCheckResourceAttr("data.infoblox_stale_records.month", "records.#", "0"),
+
+					resource.TestCheckResourceAttr("data.infoblox_stale_records.now", "records.#", "2"),
+					resource.TestCheckResourceAttr("data.infoblox_stale_records.now", "records.0.type", "record:a"),
+					resource.TestCheckResourceAttr("data.infoblox_stale_records.now", "records.0.name", "app1.stale-records.test.com"),
+					resource.TestCheckResourceAttr("data.infoblox_stale_records.now", "records.0.creator", "STATIC"),
+					resource.TestCheckResourceAttrSet("data.infoblox_stale_records.now", "records.0.creation_time"),
+					resource.TestCheckResourceAttr("data.infoblox_stale_records.now", "records.0.last_queried", ""),
+					resource.TestCheckResourceAttr("data.infoblox_stale_records.now", "records.1.type", "record:cname"),
+
+					resource.TestCheckResourceAttr("data.infoblox_stale_records.cname", "records.#", "1"),
+					resource.TestCheckResourceAttr("data.infoblox_stale_records.cname", "records.0.name", "www.stale-records.test.com"),
+				),
+			},
+			{
+				Config: `
+					data "infoblox_stale_records" "bad" {
+						not_queried_within = "1 month"
+					}`,
+				ExpectError: regexp.MustCompile("cannot process 'not_queried_within' field"),
+			},
+			{
+				Config: `
+					data "infoblox_stale_records" "bad" {
+						not_queried_within = "720h"
+						record_types = ["record:host"]
+					}`,
+				ExpectError: regexp.MustCompile("unsupported record type 'record:host'"),
+			},
+		},
+	})
+}
+
+var testAccDataSourceStaleRecordsRead = `
+resource "infoblox_zone_auth" "zone" {
+	fqdn = "stale-records.test.com"
+}
+
+resource "infoblox_a_record" "app1" {
+	fqdn = "app1.${infoblox_zone_auth.zone.fqdn}"
+	ip_addr = "10.0.0.51"
+}
+
+resource "infoblox_cname_record" "www" {
+	alias = "www.${infoblox_zone_auth.zone.fqdn}"
+	canonical = infoblox_a_record.app1.fqdn
+}
+
+data "infoblox_stale_records" "month" {
+	zone = infoblox_zone_auth.zone.fqdn
+	not_queried_within = "720h"
+
+	depends_on = [infoblox_a_record.app1, infoblox_cname_record.www]
+}
+
+data "infoblox_stale_records" "now" {
+	zone = infoblox_zone_auth.zone.fqdn
+	not_queried_within = "1ns"
+
+	depends_on = [infoblox_a_record.app1, infoblox_cname_record.www]
+}
+
+data "infoblox_stale_records" "cname" {
+	zone = infoblox_zone_auth.zone.fqdn
+	not_queried_within = "1ns"
+	record_types = ["record:cname"]
+
+	depends_on = [infoblox_a_record.app1, infoblox_cname_record.www]
+}
+`
+
+func TestIsRecordStale(t *testing.T) {
+	threshold := time.Unix(1700000000, 0)
+
+	testCases := []struct {
+		fields   map[string]interface{}
+		expected bool
+	}{
+		{map[string]interface{}{"last_queried": float64(1600000000), "creation_time": float64(1500000000)}, true},
+		{map[string]interface{}{"last_queried": float64(1700000001), "creation_time": float64(1500000000)}, false},
+		// never queried
+		{map[string]interface{}{"creation_time": float64(1500000000)}, true},
+		{map[string]interface{}{"last_queried": float64(0), "creation_time": float64(1700000001)}, false},
+		// neither the creation time is known
+		{map[string]interface{}{}, true},
+	}
+
+	for i, tc := range testCases {
+		if res := isRecordStale(tc.fields, threshold); res != tc.expected {
+			t.Errorf("case %d: got '%t', expected '%t'", i, res, tc.expected)
+		}
+	}
+}
+
+func TestFormatRecordTimestamp(t *testing.T) {
+	if res := formatRecordTimestamp(float64(1700000000)); res != "2023-11-14T22:13:20Z" {
+		t.Errorf("unexpected timestamp: '%s'", res)
+	}
+	for _, value := range []interface{}{nil, float64(0), "1700000000"} {
+		if res := formatRecordTimestamp(value); res != "" {
+			t.Errorf("empty value is expected for '%v', got '%s'", value, res)
+		}
+	}
+}
@@ -3,6 +3,7 @@ package infoblox
 import (
 	"encoding/json"
 	"fmt"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
@@ -12,7 +13,7 @@ func dataSourceTXTRecord() *schema.Resource {
 	return &schema.Resource{
 		Read: dataSourceTXTRecordRead,
 
-		Schema: map[string]*schema.Schema{
+		Schema: withRecordMetadataSchema(map[string]*schema.Schema{
 			"id": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -31,7 +32,13 @@ func dataSourceTXTRecord() *schema.Resource {
 			"text": {
 				Type:        schema.TypeString,
 				Computed:    true,
-				Description: "Data of the TXT-Record.",
+				Description: "Data of the TXT-Record, the character-strings are concatenated.",
+			},
+			"text_strings": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Data of the TXT-Record, as a list of character-strings.",
 			},
 			"zone": {
 				Type:        schema.TypeString,
@@ -53,7 +60,7 @@ func dataSourceTXTRecord() *schema.Resource {
 				Computed:    true,
 				Description: "Extensible attributes of the TXT-record, as a map in JSON format.",
 			},
-		},
+		}),
 	}
 }
 
@@ -92,7 +99,11 @@ func dataSourceTXTRecordRead(d *schema.ResourceData, m interface{}) error {
 		return err
 	}
 
-	if err = d.Set("text", obj.Text); err != nil {
+	strs := parseTXTRecordText(obj.Text)
+	if err = d.Set("text", strings.Join(strs, "")); err != nil {
+		return err
+	}
+	if err = d.Set("text_strings", strs); err != nil {
 		return err
 	}
 	if err = d.Set("zone", obj.Zone); err != nil {
@@ -102,6 +113,10 @@ func dataSourceTXTRecordRead(d *schema.ResourceData, m interface{}) error {
 		return err
 	}
 
+	if err := setRecordMetadata(d, connector, "record:txt", obj.Ref); err != nil {
+		return err
+	}
+
 	d.SetId(obj.Ref)
 
 	return nil
@@ -29,6 +29,11 @@ func TestAccDataSourceTXTRecord(t *testing.T) {
 					resource.TestCheckResourceAttr("data.infoblox_txt_record.ds2", "text", "some text for a TXT-record 2"),
 					resource.TestCheckResourceAttr("data.infoblox_txt_record.ds2", "ttl", fmt.Sprintf("%d", ttlUndef)),
 					resource.TestCheckResourceAttr("data.infoblox_txt_record.ds2", "comment", ""),
+
+					resource.TestCheckResourceAttr("data.infoblox_txt_record.ds3", "text", "v=DKIM1; k=rsa; p=MIIBIjANBgkqhkiG9w0BAQEFAAOCAQ8A"),
+					resource.TestCheckResourceAttr("data.infoblox_txt_record.ds3", "text_strings.#", "2"),
+					resource.TestCheckResourceAttr("data.infoblox_txt_record.ds3", "text_strings.0", "v=DKIM1; k=rsa; "),
+					resource.TestCheckResourceAttr("data.infoblox_txt_record.ds3", "text_strings.1", "p=MIIBIjANBgkqhkiG9w0BAQEFAAOCAQ8A"),
 				),
 			},
 		},
@@ -62,4 +67,13 @@ data "infoblox_txt_record" "ds2"{
 	dns_view="nondefault_view"
 	fqdn=infoblox_txt_record.rec2.fqdn
 }
+
+resource "infoblox_txt_record" "rec3"{
+	fqdn = "test-name3.test.com"
+	text_strings = ["v=DKIM1; k=rsa; ", "p=MIIBIjANBgkqhkiG9w0BAQEFAAOCAQ8A"]
+}
+
+data "infoblox_txt_record" "ds3"{
+	fqdn=infoblox_txt_record.rec3.fqdn
+}
 `)
@@ -0,0 +1,441 @@
+package infoblox
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+)
+
+// The kinds of the fields of a record's data, which define how the fields are rendered.
+const (
+	zoneExportFieldRaw = iota
+	zoneExportFieldName
+	zoneExportFieldQuoted
+	zoneExportFieldText
+)
+
+type zoneExportField struct {
+	name string
+	kind int
+}
+
+type zoneExportRecordType struct {
+	objectType string
+	fields     []zoneExportField
+}
+
+// The record types which are exported, with the WAPI fields of their data, in the zone file's order.
+// A, AAAA and CNAME-records also include host records' addresses and aliases.
+var zoneExportRecordTypes = map[string]zoneExportRecordType{
+	"A":     {"record:a", []zoneExportField{{"ipv4addr", zoneExportFieldRaw}}},
+	"AAAA":  {"record:aaaa", []zoneExportField{{"ipv6addr", zoneExportFieldRaw}}},
+	"CNAME": {"record:cname", []zoneExportField{{"canonical", zoneExportFieldName}}},
+	"DNAME": {"record:dname", []zoneExportField{{"target", zoneExportFieldName}}},
+	"PTR":   {"record:ptr", []zoneExportField{{"ptrdname", zoneExportFieldName}}},
+	"NS":    {"record:ns", []zoneExportField{{"nameserver", zoneExportFieldName}}},
+	"MX": {"record:mx", []zoneExportField{
+		{"preference", zoneExportFieldRaw},
+		{"mail_exchanger", zoneExportFieldName}}},
+	"SRV": {"record:srv", []zoneExportField{
+		{"priority", zoneExportFieldRaw},
+		{"weight", zoneExportFieldRaw},
+		{"port", zoneExportFieldRaw},
+		{"target", zoneExportFieldName}}},
+	"TXT": {"record:txt", []zoneExportField{{"text", zoneExportFieldText}}},
+	"CAA": {"record:caa", []zoneExportField{
+		{"ca_flag", zoneExportFieldRaw},
+		{"ca_tag", zoneExportFieldRaw},
+		{"ca_value", zoneExportFieldQuoted}}},
+	"NAPTR": {"record:naptr", []zoneExportField{
+		{"order", zoneExportFieldRaw},
+		{"preference", zoneExportFieldRaw},
+		{"flags", zoneExportFieldQuoted},
+		{"services", zoneExportFieldQuoted},
+		{"regexp", zoneExportFieldQuoted},
+		{"replacement", zoneExportFieldName}}},
+}
+
+func zoneExportSupportedTypes() []string {
+	res := make([]string, 0, len(zoneExportRecordTypes))
+	for recType := range zoneExportRecordTypes {
+		res = append(res, recType)
+	}
+	sort.Strings(res)
+
+	return res
+}
+
+// zoneExportLine is a record rendered in the zone file format.
+type zoneExportLine struct {
+	name    string
+	recType string
+	ttl     int
+	data    string
+}
+
+func (l zoneExportLine) String() string {
+	ttl := ""
+	if l.ttl != ttlUndef {
+		ttl = fmt.Sprintf("%d", l.ttl)
+	}
+	return fmt.Sprintf("%s\t%s\tIN\t%s\t%s", l.name, ttl, l.recType, l.data)
+}
+
+// Returns the absolute form of the name, with the trailing dot.
+func absoluteZoneExportName(name string) string {
+	if name == "" || name == "." {
+		return "."
+	}
+	return strings.TrimSuffix(name, ".") + "."
+}
+
+func renderZoneExportValue(value interface{}, kind int) string {
+	var str string
+	switch v := value.(type) {
+	case nil:
+		str = ""
+	case float64:
+		str = fmt.Sprintf("%d", int64(v))
+	default:
+		str = fmt.Sprint(v)
+	}
+
+	switch kind {
+	case zoneExportFieldName:
+		return absoluteZoneExportName(str)
+	case zoneExportFieldQuoted:
+		return quoteZoneFileString(str)
+	case zoneExportFieldText:
+		// A text consisting of several quoted strings is kept as is.
+		if strings.HasPrefix(str, `"`) && strings.HasSuffix(str, `"`) && len(str) > 1 {
+			return str
+		}
+		return quoteZoneFileString(str)
+	}
+
+	return str
+}
+
+func zoneExportRecordTTL(rec genericRecord) int {
+	if useTtl, _ := rec.Fields["use_ttl"].(bool); useTtl {
+		if ttl, ok := rec.Fields["ttl"].(float64); ok {
+			return int(ttl)
+		}
+	}
+	return ttlUndef
+}
+
+// Sorting key of a name: the labels in reversed order, so that
+// the zone's apex goes first and the subdomains follow their parents.
+func zoneExportNameSortKey(name string) string {
+	labels := strings.Split(strings.ToLower(strings.TrimSuffix(name, ".")), ".")
+	for i, j := 0, len(labels)-1; i < j; i, j = i+1, j-1 {
+		labels[i], labels[j] = labels[j], labels[i]
+	}
+	return strings.Join(labels, "\x00")
+}
+
+func sortZoneExportLines(lines []zoneExportLine) {
+	sort.SliceStable(lines, func(i, j int) bool {
+		ki, kj := zoneExportNameSortKey(lines[i].name), zoneExportNameSortKey(lines[j].name)
+		if ki != kj {
+			return ki < kj
+		}
+		if lines[i].recType != lines[j].recType {
+			return lines[i].recType < lines[j].recType
+		}
+		return lines[i].data < lines[j].data
+	})
+}
+
+func getZoneExportRecords(
+	connector ibclient.IBConnector, objectType string, returnFields []string, sf map[string]string) ([]genericRecord, error) {
+
+	var res []genericRecord
+	err := connector.GetObject(
+		newGenericRecord(objectType, returnFields, nil), "", ibclient.NewQueryParams(false, sf), &res)
+	if err != nil {
+		if isNotFoundError(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// Returns the records of the given type, rendered in the zone file format.
+func getZoneExportLines(
+	connector ibclient.IBConnector, recType string, sf map[string]string) ([]zoneExportLine, error) {
+
+	spec := zoneExportRecordTypes[recType]
+	returnFields := []string{"name", "ttl", "use_ttl"}
+	for _, f := range spec.fields {
+		returnFields = append(returnFields, f.name)
+	}
+
+	records, err := getZoneExportRecords(connector, spec.objectType, returnFields, sf)
+	if err != nil {
+		return nil, fmt.Errorf("failed getting %s-records: %w", recType, err)
+	}
+
+	lines := make([]zoneExportLine, 0, len(records))
+	for _, rec := range records {
+		values := make([]string, 0, len(spec.fields))
+		for _, f := range spec.fields {
+			values = append(values, renderZoneExportValue(rec.Fields[f.name], f.kind))
+		}
+		lines = append(lines, zoneExportLine{
+			name:    absoluteZoneExportName(fmt.Sprint(rec.Fields["name"])),
+			recType: recType,
+			ttl:     zoneExportRecordTTL(rec),
+			data:    strings.Join(values, " "),
+		})
+	}
+
+	return lines, nil
+}
+
+// Returns A, AAAA and CNAME-records, which are defined by the host records of the zone.
+func getZoneExportHostLines(
+	connector ibclient.IBConnector, recTypes map[string]bool, sf map[string]string) ([]zoneExportLine, error) {
+
+	returnFields := []string{"name", "ipv4addrs", "ipv6addrs", "aliases", "configure_for_dns", "ttl", "use_ttl"}
+	records, err := getZoneExportRecords(connector, "record:host", returnFields, sf)
+	if err != nil {
+		return nil, fmt.Errorf("failed getting host records: %w", err)
+	}
+
+	var lines []zoneExportLine
+	for _, rec := range records {
+		if configureForDNS, found := rec.Fields["configure_for_dns"].(bool); found && !configureForDNS {
+			continue
+		}
+		name := absoluteZoneExportName(fmt.Sprint(rec.Fields["name"]))
+		ttl := zoneExportRecordTTL(rec)
+
+		addrTypes := []struct {
+			recType, listField, addrField string
+		}{
+			{"A", "ipv4addrs", "ipv4addr"},
+			{"AAAA", "ipv6addrs", "ipv6addr"},
+		}
+		for _, at := range addrTypes {
+			if !recTypes[at.recType] {
+				continue
+			}
+			addrs, _ := rec.Fields[at.listField].([]interface{})
+			for _, item := range addrs {
+				addr, _ := item.(map[string]interface{})
+				if addr == nil || addr[at.addrField] == nil {
+					continue
+				}
+				lines = append(lines, zoneExportLine{
+					name:    name,
+					recType: at.recType,
+					ttl:     ttl,
+					data:    fmt.Sprint(addr[at.addrField]),
+				})
+			}
+		}
+
+		if recTypes["CNAME"] {
+			aliases, _ := rec.Fields["aliases"].([]interface{})
+			for _, alias := range aliases {
+				lines = append(lines, zoneExportLine{
+					name:    absoluteZoneExportName(fmt.Sprint(alias)),
+					recType: "CNAME",
+					ttl:     ttl,
+					data:    name,
+				})
+			}
+		}
+	}
+
+	return lines, nil
+}
+
+// Returns the $TTL directive and the SOA-record of the zone.
+func getZoneExportSOA(zone genericRecord, origin string) string {
+	var primary string
+	for _, listField := range []string{"grid_primary", "external_primaries"} {
+		servers, _ := zone.Fields[listField].([]interface{})
+		if len(servers) == 0 {
+			continue
+		}
+		if server, ok := servers[0].(map[string]interface{}); ok {
+			primary = fmt.Sprint(server["name"])
+			break
+		}
+	}
+	if primary == "" {
+		primary = origin
+	}
+
+	email := strings.Replace(fmt.Sprint(zone.Fields["soa_email"]), "@", ".", 1)
+	if zone.Fields["soa_email"] == nil {
+		email = "hostmaster." + origin
+	}
+
+	number := func(fieldName string) string {
+		return renderZoneExportValue(zone.Fields[fieldName], zoneExportFieldRaw)
+	}
+
+	return fmt.Sprintf("$TTL %s\n%s\tIN\tSOA\t%s %s %s %s %s %s %s\n",
+		number("soa_default_ttl"),
+		absoluteZoneExportName(origin),
+		absoluteZoneExportName(primary),
+		absoluteZoneExportName(email),
+		number("soa_serial_number"),
+		number("soa_refresh"),
+		number("soa_retry"),
+		number("soa_expire"),
+		number("soa_negative_ttl"))
+}
+
+func dataSourceZoneExport() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceZoneExportRead,
+
+		Schema: map[string]*schema.Schema{
+			"zone": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The FQDN of the authoritative zone to export.",
+			},
+			"dns_view": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     defaultDNSView,
+				Description: "DNS view which the zone does exist within.",
+			},
+			"record_types": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The types of the records to export, ex. 'A', 'MX'; all the supported types are exported by default.",
+			},
+			"ext_attrs": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "Extensible attributes, as a map in JSON format, which the exported records must have.",
+			},
+			"include_soa": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "If set to true, $TTL directive and SOA-record of the zone are included.",
+			},
+			"content": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The records of the zone, in RFC 1035 zone file format.",
+			},
+		},
+	}
+}
+
+func dataSourceZoneExportRead(d *schema.ResourceData, m interface{}) error {
+	dnsView := d.Get("dns_view").(string)
+	zoneName := strings.TrimSuffix(d.Get("zone").(string), ".")
+
+	recTypes := make(map[string]bool)
+	for _, item := range d.Get("record_types").([]interface{}) {
+		recType := strings.ToUpper(item.(string))
+		if _, found := zoneExportRecordTypes[recType]; !found {
+			return fmt.Errorf(
+				"unsupported record type '%s', the supported types are: %s",
+				item.(string), strings.Join(zoneExportSupportedTypes(), ", "))
+		}
+		recTypes[recType] = true
+	}
+	if len(recTypes) == 0 {
+		for recType := range zoneExportRecordTypes {
+			recTypes[recType] = true
+		}
+	}
+
+	var extAttrs map[string]interface{}
+	if extAttrJSON := d.Get("ext_attrs").(string); extAttrJSON != "" {
+		if err := json.Unmarshal([]byte(extAttrJSON), &extAttrs); err != nil {
+			return fmt.Errorf("cannot process 'ext_attrs' field: %w", err)
+		}
+	}
+
+	connector := m.(ibclient.IBConnector)
+
+	var zones []genericRecord
+	zoneFields := []string{
+		"fqdn", "display_domain", "grid_primary", "external_primaries", "soa_email",
+		"soa_serial_number", "soa_default_ttl", "soa_refresh", "soa_retry", "soa_expire", "soa_negative_ttl"}
+	zoneSf := map[string]string{
+		"fqdn": zoneName,
+		"view": dnsView,
+	}
+	err := connector.GetObject(
+		newGenericRecord("zone_auth", zoneFields, nil), "", ibclient.NewQueryParams(false, zoneSf), &zones)
+	if err != nil {
+		return fmt.Errorf("failed getting the zone '%s' under DNS view '%s': %w", zoneName, dnsView, err)
+	}
+	if len(zones) == 0 {
+		return fmt.Errorf("zone '%s' under DNS view '%s' not found", zoneName, dnsView)
+	}
+	zone := zones[0]
+
+	// The names of the records of a reverse zone are in 'in-addr.arpa' and 'ip6.arpa' domains.
+	origin := zoneName
+	if displayDomain, _ := zone.Fields["display_domain"].(string); displayDomain != "" {
+		origin = displayDomain
+	}
+
+	sf := map[string]string{
+		"zone": zoneName,
+		"view": dnsView,
+	}
+	for name, value := range extAttrs {
+		sf["*"+name] = fmt.Sprint(value)
+	}
+
+	var lines []zoneExportLine
+	for _, recType := range zoneExportSupportedTypes() {
+		if !recTypes[recType] {
+			continue
+		}
+		typeLines, err := getZoneExportLines(connector, recType, sf)
+		if err != nil {
+			return err
+		}
+		lines = append(lines, typeLines...)
+	}
+	if recTypes["A"] || recTypes["AAAA"] || recTypes["CNAME"] {
+		hostLines, err := getZoneExportHostLines(connector, recTypes, sf)
+		if err != nil {
+			return err
+		}
+		lines = append(lines, hostLines...)
+	}
+	sortZoneExportLines(lines)
+
+	var content strings.Builder
+	content.WriteString(fmt.Sprintf("$ORIGIN %s\n", absoluteZoneExportName(origin)))
+	if d.Get("include_soa").(bool) {
+		content.WriteString(getZoneExportSOA(zone, origin))
+	}
+	for _, line := range lines {
+		content.WriteString(line.String())
+		content.WriteString("\n")
+	}
+
+	if err = d.Set("content", content.String()); err != nil {
+		return err
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", zoneName, dnsView))
+
+	return nil
+}
@@ -0,0 +1,96 @@
+package infoblox
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceZoneExport(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceZoneExportRead,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.infoblox_zone_export.acctest", "content",
+						"$ORIGIN zone-export.test.com.\n"+
+							"zone-export.test.com.\t\tIN\tMX\t10 mail.test.com.\n"+
+							"info.zone-export.test.com.\t\tIN\tTXT\t\"some text\"\n"+
+							"www.zone-export.test.com.\t300\tIN\tA\t10.0.0.1\n"+
+							"www2.zone-export.test.com.\t\tIN\tCNAME\twww.zone-export.test.com.\n"),
+					resource.TestCheckResourceAttr("data.infoblox_zone_export.filtered", "content",
+						"$ORIGIN zone-export.test.com.\n"+
+							"www.zone-export.test.com.\t300\tIN\tA\t10.0.0.1\n"),
+					resource.TestMatchResourceAttr("data.infoblox_zone_export.with_soa", "content",
+						regexp.MustCompile(`(?s)^\$ORIGIN zone-export\.test\.com\.\n\$TTL \d+\n`+
+							`zone-export\.test\.com\.\tIN\tSOA\t\S+ \S+ \d+ \d+ \d+ \d+ \d+\n.*`)),
+				),
+			},
+			{
+				Config: `
+					data "infoblox_zone_export" "acctest" {
+						zone = "test.com"
+						record_types = ["HINFO"]
+					}`,
+				ExpectError: regexp.MustCompile("unsupported record type 'HINFO'"),
+			},
+		},
+	})
+}
+
+var testAccDataSourceZoneExportRead = `
+resource "infoblox_zone_auth" "zone" {
+	fqdn = "zone-export.test.com"
+}
+
+resource "infoblox_a_record" "a" {
+	fqdn = "www.${infoblox_zone_auth.zone.fqdn}"
+	ip_addr = "10.0.0.1"
+	ttl = 300
+	ext_attrs = jsonencode({
+		"Site" = "Export"
+	})
+}
+
+resource "infoblox_cname_record" "cname" {
+	alias = "www2.${infoblox_zone_auth.zone.fqdn}"
+	canonical = infoblox_a_record.a.fqdn
+}
+
+resource "infoblox_mx_record" "mx" {
+	fqdn = infoblox_zone_auth.zone.fqdn
+	mail_exchanger = "mail.test.com"
+	preference = 10
+}
+
+resource "infoblox_txt_record" "txt" {
+	fqdn = "info.${infoblox_zone_auth.zone.fqdn}"
+	text = "some text"
+}
+
+data "infoblox_zone_export" "acctest" {
+	zone = infoblox_zone_auth.zone.fqdn
+
+	depends_on = [infoblox_a_record.a, infoblox_cname_record.cname, infoblox_mx_record.mx, infoblox_txt_record.txt]
+}
+
+data "infoblox_zone_export" "filtered" {
+	zone = infoblox_zone_auth.zone.fqdn
+	record_types = ["A", "MX"]
+	ext_attrs = jsonencode({
+		"Site" = "Export"
+	})
+
+	depends_on = [infoblox_a_record.a, infoblox_cname_record.cname, infoblox_mx_record.mx, infoblox_txt_record.txt]
+}
+
+data "infoblox_zone_export" "with_soa" {
+	zone = infoblox_zone_auth.zone.fqdn
+	include_soa = true
+
+	depends_on = [infoblox_a_record.a, infoblox_cname_record.cname, infoblox_mx_record.mx, infoblox_txt_record.txt]
+}
+`
@@ -0,0 +1,206 @@
+package infoblox
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+)
+
+var zoneRecordsReturnFields = []string{
+	"name", "view", "zone", "type", "address", "ttl", "comment", "creator", "disabled", "record"}
+
+func dataSourceZoneRecords() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceZoneRecordsRead,
+
+		Schema: map[string]*schema.Schema{
+			"zone": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The FQDN of the zone to list the records of.",
+			},
+			"dns_view": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     defaultDNSView,
+				Description: "DNS view which the zone does exist within.",
+			},
+			"record_types": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The WAPI types of the records to return, ex. 'record:a', 'record:mx'; all the records are returned by default.",
+			},
+			"name_regex": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "The regular expression which the names of the records, relative to the zone, must match.",
+			},
+			"records": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The list of the records of the zone which match the filters.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The reference of the record.",
+						},
+						"type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The WAPI type of the record, ex. 'record:a'.",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the record, relative to the zone; empty for the zone's apex.",
+						},
+						"fqdn": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The fully qualified domain name of the record.",
+						},
+						"address": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The address or the target of the record, depending on its type.",
+						},
+						"ttl": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "TTL value of the record.",
+						},
+						"comment": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Description of the record.",
+						},
+						"creator": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The creator of the record: 'STATIC', 'DYNAMIC' or 'SYSTEM'.",
+						},
+						"disabled": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Is set to true if the record is disabled.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Converts an item of 'allrecords', got from NIOS, into an item of 'records' field.
+func convertZoneRecordToInterface(rec genericRecord) map[string]interface{} {
+	stringField := func(wapiName string) string {
+		res, _ := rec.Fields[wapiName].(string)
+		return res
+	}
+
+	zone := strings.TrimSuffix(stringField("zone"), ".")
+	name := stringField("name")
+	fqdn := zone
+	if name != "" && name != "@" {
+		fqdn = name + "." + zone
+	}
+
+	ttl := ttlUndef
+	if val, ok := rec.Fields["ttl"].(float64); ok {
+		ttl = int(val)
+	}
+	disabled, _ := rec.Fields["disabled"].(bool)
+
+	// 'allrecords' objects are not usable on their own, the reference of the record itself is returned.
+	ref := stringField("record")
+	if ref == "" {
+		ref = rec.Ref
+	}
+
+	return map[string]interface{}{
+		"id":       ref,
+		"type":     stringField("type"),
+		"name":     name,
+		"fqdn":     fqdn,
+		"address":  stringField("address"),
+		"ttl":      ttl,
+		"comment":  stringField("comment"),
+		"creator":  stringField("creator"),
+		"disabled": disabled,
+	}
+}
+
+func dataSourceZoneRecordsRead(d *schema.ResourceData, m interface{}) error {
+	dnsView := d.Get("dns_view").(string)
+	zoneName := strings.TrimSuffix(d.Get("zone").(string), ".")
+
+	recTypes := make(map[string]bool)
+	for _, item := range d.Get("record_types").([]interface{}) {
+		recTypes[strings.ToLower(item.(string))] = true
+	}
+
+	sf := map[string]string{
+		"zone": zoneName,
+		"view": dnsView,
+	}
+	if nameRegex := d.Get("name_regex").(string); nameRegex != "" {
+		sf["name~"] = nameRegex
+	}
+	// A single type is searched for by NIOS, several ones are filtered out below.
+	if len(recTypes) == 1 {
+		for recType := range recTypes {
+			sf["type"] = recType
+		}
+	}
+
+	connector := m.(ibclient.IBConnector)
+
+	var recs []genericRecord
+	err := connector.GetObject(
+		newGenericRecord("allrecords", zoneRecordsReturnFields, nil), "", ibclient.NewQueryParams(false, sf), &recs)
+	if err != nil && !isNotFoundError(err) {
+		return fmt.Errorf("failed getting the records of the zone '%s' under DNS view '%s': %w", zoneName, dnsView, err)
+	}
+
+	records := make([]map[string]interface{}, 0, len(recs))
+	for _, rec := range recs {
+		item := convertZoneRecordToInterface(rec)
+		if len(recTypes) > 0 && !recTypes[item["type"].(string)] {
+			continue
+		}
+		records = append(records, item)
+	}
+	sort.SliceStable(records, func(i, j int) bool {
+		ki := zoneExportNameSortKey(records[i]["fqdn"].(string))
+		kj := zoneExportNameSortKey(records[j]["fqdn"].(string))
+		if ki != kj {
+			return ki < kj
+		}
+		if records[i]["type"] != records[j]["type"] {
+			return records[i]["type"].(string) < records[j]["type"].(string)
+		}
+		return records[i]["address"].(string) < records[j]["address"].(string)
+	})
+
+	if err = d.Set("records", records); err != nil {
+		return err
+	}
+
+	types := make([]string, 0, len(recTypes))
+	for recType := range recTypes {
+		types = append(types, recType)
+	}
+	sort.Strings(types)
+	d.SetId(fmt.Sprintf(
+		"allrecords?view=%s&zone=%s&name~=%s&type=%s",
+		dnsView, zoneName, d.Get("name_regex").(string), strings.Join(types, ",")))
+
+	return nil
+}
@@ -0,0 +1,134 @@
+package infoblox
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceZoneRecords(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceZoneRecordsRead,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.infoblox_zone_records.a", "records.#", "2"),
+					resource.TestCheckResourceAttr("data.infoblox_zone_records.a", "records.0.type", "record:a"),
+					resource.TestCheckResourceAttr("data.infoblox_zone_records.a", "records.0.name", "app1"),
+					resource.TestCheckResourceAttr("data.infoblox_zone_records.a", "records.0.fqdn", "app1.zone-records.test.com"),
+					resource.TestCheckResourceAttr("data.infoblox_zone_records.a", "records.0.address", "10.0.0.41"),
+					resource.TestCheckResourceAttr("data.infoblox_zone_records.a", "records.0.ttl", "300"),
+					resource.TestCheckResourceAttr("data.infoblox_zone_records.a", "records.0.comment", "first app"),
+					resource.TestCheckResourceAttr("data.infoblox_zone_records.a", "records.0.creator", "STATIC"),
+					resource.TestCheckResourceAttrPair(
+						"data.infoblox_zone_records.a", "records.0.id", "infoblox_a_record.app1", "id"),
+
+					resource.TestCheckResourceAttr("data.infoblox_zone_records.several", "records.#", "2"),
+					resource.TestCheckResourceAttr("data.infoblox_zone_records.several", "records.0.type", "record:a"),
+					resource.TestCheckResourceAttr("data.infoblox_zone_records.several", "records.1.type", "record:cname"),
+
+					resource.TestCheckResourceAttr("data.infoblox_zone_records.by_name", "records.#", "1"),
+					resource.TestCheckResourceAttr("data.infoblox_zone_records.by_name", "records.0.fqdn", "www.zone-records.test.com"),
+					resource.TestCheckResourceAttr("data.infoblox_zone_records.by_name", "records.0.address", "app1.zone-records.test.com"),
+				),
+			},
+		},
+	})
+}
+
+var testAccDataSourceZoneRecordsRead = `
+resource "infoblox_zone_auth" "zone" {
+	fqdn = "zone-records.test.com"
+}
+
+resource "infoblox_a_record" "app1" {
+	fqdn = "app1.${infoblox_zone_auth.zone.fqdn}"
+	ip_addr = "10.0.0.41"
+	ttl = 300
+	comment = "first app"
+}
+
+resource "infoblox_a_record" "app2" {
+	fqdn = "app2.${infoblox_zone_auth.zone.fqdn}"
+	ip_addr = "10.0.0.42"
+}
+
+resource "infoblox_cname_record" "www" {
+	alias = "www.${infoblox_zone_auth.zone.fqdn}"
+	canonical = infoblox_a_record.app1.fqdn
+}
+
+data "infoblox_zone_records" "a" {
+	zone = infoblox_zone_auth.zone.fqdn
+	record_types = ["record:a"]
+
+	depends_on = [infoblox_a_record.app1, infoblox_a_record.app2, infoblox_cname_record.www]
+}
+
+data "infoblox_zone_records" "several" {
+	zone = infoblox_zone_auth.zone.fqdn
+	record_types = ["record:a", "record:cname"]
+	name_regex = "^(app1|www)$"
+
+	depends_on = [infoblox_a_record.app1, infoblox_a_record.app2, infoblox_cname_record.www]
+}
+
+data "infoblox_zone_records" "by_name" {
+	zone = infoblox_zone_auth.zone.fqdn
+	name_regex = "^www$"
+
+	depends_on = [infoblox_a_record.app1, infoblox_a_record.app2, infoblox_cname_record.www]
+}
+`
+
+func TestConvertZoneRecordToInterface(t *testing.T) {
+	rec := genericRecord{
+		Ref: "allrecords/ZG5zLnpvbmVfc2VhcmNo:app1",
+		Fields: map[string]interface{}{
+			"name":     "app1",
+			"view":     "default",
+			"zone":     "test.com",
+			"type":     "record:a",
+			"address":  "10.0.0.1",
+			"ttl":      float64(300),
+			"comment":  "web",
+			"creator":  "STATIC",
+			"disabled": false,
+			"record":   "record:a/ZG5zLmJpbmRfYSQ:app1.test.com/default/10.0.0.1",
+		},
+	}
+	expected := map[string]interface{}{
+		"id":       "record:a/ZG5zLmJpbmRfYSQ:app1.test.com/default/10.0.0.1",
+		"type":     "record:a",
+		"name":     "app1",
+		"fqdn":     "app1.test.com",
+		"address":  "10.0.0.1",
+		"ttl":      300,
+		"comment":  "web",
+		"creator":  "STATIC",
+		"disabled": false,
+	}
+	res := convertZoneRecordToInterface(rec)
+	for key, value := range expected {
+		if res[key] != value {
+			t.Errorf("'%s' does not match: got '%v', expected '%v'", key, res[key], value)
+		}
+	}
+
+	// the zone's apex, without TTL defined
+	rec = genericRecord{
+		Ref: "allrecords/ZG5zLnpvbmVfc2VhcmNo:",
+		Fields: map[string]interface{}{
+			"name":    "",
+			"zone":    "test.com",
+			"type":    "record:mx",
+			"creator": "STATIC",
+		},
+	}
+	res = convertZoneRecordToInterface(rec)
+	if res["fqdn"] != "test.com" || res["ttl"] != ttlUndef || res["id"] != rec.Ref {
+		t.Errorf("unexpected record: %v", res)
+	}
+}
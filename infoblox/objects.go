@@ -0,0 +1,866 @@
+package infoblox
+
+import (
+	"bytes"
+	"encoding/json"
+
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+)
+
+// WAPI objects which are not modelled by infoblox-go-client (or are modelled
+// there with an insufficient set of fields) are defined here.
+// They implement ibclient.IBObject interface and thus may be passed
+// to the connector's methods directly.
+
+type ibBase struct {
+	objectType   string
+	returnFields []string
+	eaSearch     ibclient.EASearch
+}
+
+func (obj *ibBase) ObjectType() string {
+	return obj.objectType
+}
+
+func (obj *ibBase) ReturnFields() []string {
+	return obj.returnFields
+}
+
+func (obj *ibBase) EaSearch() ibclient.EASearch {
+	return obj.eaSearch
+}
+
+// memberServer represents 'memberserver' WAPI struct,
+// which is used to assign grid members to a zone.
+type memberServer struct {
+	Name          string `json:"name"`
+	Stealth       bool   `json:"stealth"`
+	GridReplicate bool   `json:"grid_replicate"`
+	Lead          bool   `json:"lead"`
+}
+
+type zoneAuth struct {
+	ibBase           `json:"-"`
+	Ref              string         `json:"_ref,omitempty"`
+	Fqdn             string         `json:"fqdn,omitempty"`
+	View             string         `json:"view,omitempty"`
+	ZoneFormat       string         `json:"zone_format,omitempty"`
+	NsGroup          *string        `json:"ns_group,omitempty"`
+	GridPrimary      []memberServer `json:"grid_primary"`
+	GridSecondaries  []memberServer `json:"grid_secondaries"`
+	UseGridZoneTimer *bool          `json:"use_grid_zone_timer,omitempty"`
+	SoaDefaultTtl    *uint32        `json:"soa_default_ttl,omitempty"`
+	SoaExpire        *uint32        `json:"soa_expire,omitempty"`
+	SoaNegativeTtl   *uint32        `json:"soa_negative_ttl,omitempty"`
+	SoaRefresh       *uint32        `json:"soa_refresh,omitempty"`
+	SoaRetry         *uint32        `json:"soa_retry,omitempty"`
+	AllowTransfer    []aclItem      `json:"allow_transfer"`
+	UseAllowTransfer bool           `json:"use_allow_transfer"`
+	AllowUpdate      []aclItem      `json:"allow_update"`
+	Comment          string         `json:"comment"`
+	Ea               ibclient.EA    `json:"extattrs"`
+}
+
+var zoneAuthReturnFieldsList = []string{
+	"fqdn", "view", "zone_format", "ns_group", "grid_primary", "grid_secondaries",
+	"use_grid_zone_timer", "soa_default_ttl", "soa_expire", "soa_negative_ttl",
+	"soa_refresh", "soa_retry", "allow_transfer", "use_allow_transfer", "allow_update",
+	"comment", "extattrs"}
+
+func newZoneAuth(za zoneAuth) *zoneAuth {
+	res := za
+	res.objectType = "zone_auth"
+	res.returnFields = zoneAuthReturnFieldsList
+
+	return &res
+}
+
+type zoneDelegated struct {
+	ibBase          `json:"-"`
+	Ref             string                `json:"_ref,omitempty"`
+	Fqdn            string                `json:"fqdn,omitempty"`
+	View            string                `json:"view,omitempty"`
+	DelegateTo      []ibclient.NameServer `json:"delegate_to"`
+	DelegatedTtl    uint32                `json:"delegated_ttl"`
+	UseDelegatedTtl bool                  `json:"use_delegated_ttl"`
+	Locked          bool                  `json:"locked"`
+	Comment         string                `json:"comment"`
+	Ea              ibclient.EA           `json:"extattrs"`
+}
+
+var zoneDelegatedReturnFieldsList = []string{
+	"fqdn", "view", "delegate_to", "delegated_ttl", "use_delegated_ttl",
+	"locked", "comment", "extattrs"}
+
+func newZoneDelegated(zd zoneDelegated) *zoneDelegated {
+	res := zd
+	res.objectType = "zone_delegated"
+	res.returnFields = zoneDelegatedReturnFieldsList
+
+	return &res
+}
+
+// forwardingMemberServer represents 'forwardingmemberserver' WAPI struct.
+type forwardingMemberServer struct {
+	Name                  string                `json:"name"`
+	ForwardersOnly        bool                  `json:"forwarders_only"`
+	UseOverrideForwarders bool                  `json:"use_override_forwarders"`
+	ForwardTo             []ibclient.NameServer `json:"forward_to,omitempty"`
+}
+
+type zoneForward struct {
+	ibBase            `json:"-"`
+	Ref               string                   `json:"_ref,omitempty"`
+	Fqdn              string                   `json:"fqdn,omitempty"`
+	View              string                   `json:"view,omitempty"`
+	ZoneFormat        string                   `json:"zone_format,omitempty"`
+	ForwardTo         []ibclient.NameServer    `json:"forward_to"`
+	ForwardingServers []forwardingMemberServer `json:"forwarding_servers"`
+	ForwardOnly       bool                     `json:"forward_only"`
+	Comment           string                   `json:"comment"`
+	Ea                ibclient.EA              `json:"extattrs"`
+}
+
+var zoneForwardReturnFieldsList = []string{
+	"fqdn", "view", "zone_format", "forward_to", "forwarding_servers",
+	"forward_only", "comment", "extattrs"}
+
+func newZoneForward(zf zoneForward) *zoneForward {
+	res := zf
+	res.objectType = "zone_forward"
+	res.returnFields = zoneForwardReturnFieldsList
+
+	return &res
+}
+
+type zoneStub struct {
+	ibBase      `json:"-"`
+	Ref         string                `json:"_ref,omitempty"`
+	Fqdn        string                `json:"fqdn,omitempty"`
+	View        string                `json:"view,omitempty"`
+	ZoneFormat  string                `json:"zone_format,omitempty"`
+	StubFrom    []ibclient.NameServer `json:"stub_from"`
+	StubMembers []memberServer        `json:"stub_members"`
+	Comment     string                `json:"comment"`
+	Ea          ibclient.EA           `json:"extattrs"`
+}
+
+var zoneStubReturnFieldsList = []string{
+	"fqdn", "view", "zone_format", "stub_from", "stub_members", "comment", "extattrs"}
+
+func newZoneStub(zs zoneStub) *zoneStub {
+	res := zs
+	res.objectType = "zone_stub"
+	res.returnFields = zoneStubReturnFieldsList
+
+	return &res
+}
+
+// addressAC represents 'addressac' WAPI struct: an IP address, a network
+// or a range of addresses with the permission to access something.
+type addressAC struct {
+	Address    string `json:"address"`
+	Permission string `json:"permission"`
+}
+
+// tsigAC represents 'tsigac' WAPI struct: a TSIG key which is allowed
+// to access something.
+type tsigAC struct {
+	TsigKey        string `json:"tsig_key"`
+	TsigKeyAlg     string `json:"tsig_key_alg"`
+	TsigKeyName    string `json:"tsig_key_name"`
+	UseTsigKeyName bool   `json:"use_tsig_key_name"`
+}
+
+// aclItem is an item of a WAPI access control list, which is either
+// an 'addressac' struct, a 'tsigac' struct or a reference to a named ACL object.
+type aclItem struct {
+	AddressAC   *addressAC
+	TsigAC      *tsigAC
+	NamedACLRef string
+}
+
+func (item aclItem) MarshalJSON() ([]byte, error) {
+	if item.AddressAC != nil {
+		return json.Marshal(item.AddressAC)
+	}
+	if item.TsigAC != nil {
+		return json.Marshal(item.TsigAC)
+	}
+	return json.Marshal(item.NamedACLRef)
+}
+
+func (item *aclItem) UnmarshalJSON(data []byte) error {
+	if bytes.HasPrefix(bytes.TrimSpace(data), []byte(`"`)) {
+		return json.Unmarshal(data, &item.NamedACLRef)
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	if _, found := fields["address"]; found {
+		item.AddressAC = &addressAC{}
+		return json.Unmarshal(data, item.AddressAC)
+	}
+	item.TsigAC = &tsigAC{}
+	return json.Unmarshal(data, item.TsigAC)
+}
+
+type dnsView struct {
+	ibBase            `json:"-"`
+	Ref               string      `json:"_ref,omitempty"`
+	Name              string      `json:"name,omitempty"`
+	NetworkView       string      `json:"network_view,omitempty"`
+	MatchClients      []aclItem   `json:"match_clients"`
+	MatchDestinations []aclItem   `json:"match_destinations"`
+	Recursion         bool        `json:"recursion"`
+	Forwarders        []string    `json:"forwarders"`
+	UseForwarders     bool        `json:"use_forwarders"`
+	ForwardOnly       bool        `json:"forward_only"`
+	Comment           string      `json:"comment"`
+	Ea                ibclient.EA `json:"extattrs"`
+}
+
+var dnsViewReturnFieldsList = []string{
+	"name", "network_view", "match_clients", "match_destinations", "recursion",
+	"forwarders", "use_forwarders", "forward_only", "comment", "extattrs"}
+
+func newDNSView(dv dnsView) *dnsView {
+	res := dv
+	res.objectType = "view"
+	res.returnFields = dnsViewReturnFieldsList
+
+	return &res
+}
+
+type namedACL struct {
+	ibBase     `json:"-"`
+	Ref        string      `json:"_ref,omitempty"`
+	Name       string      `json:"name,omitempty"`
+	AccessList []aclItem   `json:"access_list"`
+	Comment    string      `json:"comment"`
+	Ea         ibclient.EA `json:"extattrs"`
+}
+
+var namedACLReturnFieldsList = []string{"name", "access_list", "comment", "extattrs"}
+
+func newNamedACL(acl namedACL) *namedACL {
+	res := acl
+	res.objectType = "namedacl"
+	res.returnFields = namedACLReturnFieldsList
+
+	return &res
+}
+
+// zoneNameServer represents 'zone_nameserver' WAPI struct.
+type zoneNameServer struct {
+	Address       string `json:"address"`
+	AutoCreatePtr bool   `json:"auto_create_ptr"`
+}
+
+// recordNS extends ibclient.RecordNS with the fields
+// which are not supported by the client.
+type recordNS struct {
+	ibBase           `json:"-"`
+	Ref              string           `json:"_ref,omitempty"`
+	Name             string           `json:"name,omitempty"`
+	Nameserver       string           `json:"nameserver,omitempty"`
+	Addresses        []zoneNameServer `json:"addresses"`
+	MsDelegationName string           `json:"ms_delegation_name"`
+	View             string           `json:"view,omitempty"`
+	Zone             string           `json:"zone,omitempty"`
+}
+
+var recordNSReturnFieldsList = []string{
+	"name", "nameserver", "addresses", "ms_delegation_name", "view", "zone"}
+
+func newRecordNS(rec recordNS) *recordNS {
+	res := rec
+	res.objectType = "record:ns"
+	res.returnFields = recordNSReturnFieldsList
+
+	return &res
+}
+
+type zoneRP struct {
+	ibBase          `json:"-"`
+	Ref             string         `json:"_ref,omitempty"`
+	Fqdn            string         `json:"fqdn,omitempty"`
+	View            string         `json:"view,omitempty"`
+	RpzPolicy       string         `json:"rpz_policy,omitempty"`
+	RpzSeverity     string         `json:"rpz_severity,omitempty"`
+	SubstituteName  string         `json:"substitute_name,omitempty"`
+	NsGroup         *string        `json:"ns_group,omitempty"`
+	GridPrimary     []memberServer `json:"grid_primary"`
+	GridSecondaries []memberServer `json:"grid_secondaries"`
+	Comment         string         `json:"comment"`
+	Ea              ibclient.EA    `json:"extattrs"`
+}
+
+var zoneRPReturnFieldsList = []string{
+	"fqdn", "view", "rpz_policy", "rpz_severity", "substitute_name", "ns_group",
+	"grid_primary", "grid_secondaries", "comment", "extattrs"}
+
+func newZoneRP(zr zoneRP) *zoneRP {
+	res := zr
+	res.objectType = "zone_rp"
+	res.returnFields = zoneRPReturnFieldsList
+
+	return &res
+}
+
+// recordRPZCName represents the rules of a response policy zone
+// which are CNAME-records by their nature: 'record:rpz:cname' and
+// its IP address-based variations.
+type recordRPZCName struct {
+	ibBase    `json:"-"`
+	Ref       string      `json:"_ref,omitempty"`
+	Name      string      `json:"name,omitempty"`
+	Canonical string      `json:"canonical"`
+	RpZone    string      `json:"rp_zone,omitempty"`
+	View      string      `json:"view,omitempty"`
+	Ttl       uint32      `json:"ttl"`
+	UseTtl    bool        `json:"use_ttl"`
+	Comment   string      `json:"comment"`
+	Ea        ibclient.EA `json:"extattrs"`
+}
+
+var recordRPZCNameReturnFieldsList = []string{
+	"name", "canonical", "rp_zone", "view", "ttl", "use_ttl", "comment", "extattrs"}
+
+func newRecordRPZCName(objectType string, rec recordRPZCName) *recordRPZCName {
+	res := rec
+	res.objectType = objectType
+	res.returnFields = recordRPZCNameReturnFieldsList
+
+	return &res
+}
+
+// recordRPZAddress represents 'record:rpz:a' and 'record:rpz:aaaa' objects,
+// which substitute the answer to a query with an IP address.
+type recordRPZAddress struct {
+	ibBase   `json:"-"`
+	Ref      string      `json:"_ref,omitempty"`
+	Name     string      `json:"name,omitempty"`
+	Ipv4Addr string      `json:"ipv4addr,omitempty"`
+	Ipv6Addr string      `json:"ipv6addr,omitempty"`
+	RpZone   string      `json:"rp_zone,omitempty"`
+	View     string      `json:"view,omitempty"`
+	Ttl      uint32      `json:"ttl"`
+	UseTtl   bool        `json:"use_ttl"`
+	Comment  string      `json:"comment"`
+	Ea       ibclient.EA `json:"extattrs"`
+}
+
+func newRecordRPZAddress(rec recordRPZAddress, isIPv6 bool) *recordRPZAddress {
+	res := rec
+	if isIPv6 {
+		res.objectType = "record:rpz:aaaa"
+		res.returnFields = []string{
+			"name", "ipv6addr", "rp_zone", "view", "ttl", "use_ttl", "comment", "extattrs"}
+	} else {
+		res.objectType = "record:rpz:a"
+		res.returnFields = []string{
+			"name", "ipv4addr", "rp_zone", "view", "ttl", "use_ttl", "comment", "extattrs"}
+	}
+
+	return &res
+}
+
+type recordCAA struct {
+	ibBase  `json:"-"`
+	Ref     string      `json:"_ref,omitempty"`
+	Name    string      `json:"name,omitempty"`
+	CaFlag  uint32      `json:"ca_flag"`
+	CaTag   string      `json:"ca_tag,omitempty"`
+	CaValue string      `json:"ca_value,omitempty"`
+	View    string      `json:"view,omitempty"`
+	Zone    string      `json:"zone,omitempty"`
+	Ttl     uint32      `json:"ttl"`
+	UseTtl  bool        `json:"use_ttl"`
+	Comment string      `json:"comment"`
+	Ea      ibclient.EA `json:"extattrs"`
+}
+
+var recordCAAReturnFieldsList = []string{
+	"name", "ca_flag", "ca_tag", "ca_value", "view", "zone", "ttl", "use_ttl", "comment", "extattrs"}
+
+func newRecordCAA(rec recordCAA) *recordCAA {
+	res := rec
+	res.objectType = "record:caa"
+	res.returnFields = recordCAAReturnFieldsList
+
+	return &res
+}
+
+type recordNAPTR struct {
+	ibBase      `json:"-"`
+	Ref         string      `json:"_ref,omitempty"`
+	Name        string      `json:"name,omitempty"`
+	Order       uint32      `json:"order"`
+	Preference  uint32      `json:"preference"`
+	Flags       string      `json:"flags"`
+	Services    string      `json:"services"`
+	Regexp      string      `json:"regexp"`
+	Replacement string      `json:"replacement,omitempty"`
+	View        string      `json:"view,omitempty"`
+	Zone        string      `json:"zone,omitempty"`
+	Ttl         uint32      `json:"ttl"`
+	UseTtl      bool        `json:"use_ttl"`
+	Comment     string      `json:"comment"`
+	Ea          ibclient.EA `json:"extattrs"`
+}
+
+var recordNAPTRReturnFieldsList = []string{
+	"name", "order", "preference", "flags", "services", "regexp", "replacement",
+	"view", "zone", "ttl", "use_ttl", "comment", "extattrs"}
+
+func newRecordNAPTR(rec recordNAPTR) *recordNAPTR {
+	res := rec
+	res.objectType = "record:naptr"
+	res.returnFields = recordNAPTRReturnFieldsList
+
+	return &res
+}
+
+type recordDNAME struct {
+	ibBase  `json:"-"`
+	Ref     string      `json:"_ref,omitempty"`
+	Name    string      `json:"name,omitempty"`
+	Target  string      `json:"target,omitempty"`
+	View    string      `json:"view,omitempty"`
+	Zone    string      `json:"zone,omitempty"`
+	Ttl     uint32      `json:"ttl"`
+	UseTtl  bool        `json:"use_ttl"`
+	Comment string      `json:"comment"`
+	Ea      ibclient.EA `json:"extattrs"`
+}
+
+var recordDNAMEReturnFieldsList = []string{
+	"name", "target", "view", "zone", "ttl", "use_ttl", "comment", "extattrs"}
+
+func newRecordDNAME(rec recordDNAME) *recordDNAME {
+	res := rec
+	res.objectType = "record:dname"
+	res.returnFields = recordDNAMEReturnFieldsList
+
+	return &res
+}
+
+type recordAlias struct {
+	ibBase     `json:"-"`
+	Ref        string      `json:"_ref,omitempty"`
+	Name       string      `json:"name,omitempty"`
+	TargetName string      `json:"target_name,omitempty"`
+	TargetType string      `json:"target_type,omitempty"`
+	View       string      `json:"view,omitempty"`
+	Zone       string      `json:"zone,omitempty"`
+	Ttl        uint32      `json:"ttl"`
+	UseTtl     bool        `json:"use_ttl"`
+	Comment    string      `json:"comment"`
+	Ea         ibclient.EA `json:"extattrs"`
+}
+
+var recordAliasReturnFieldsList = []string{
+	"name", "target_name", "target_type", "view", "zone", "ttl", "use_ttl", "comment", "extattrs"}
+
+func newRecordAlias(rec recordAlias) *recordAlias {
+	res := rec
+	res.objectType = "record:alias"
+	res.returnFields = recordAliasReturnFieldsList
+
+	return &res
+}
+
+type zoneAssociation struct {
+	Fqdn string `json:"fqdn"`
+	View string `json:"view"`
+}
+
+type sharedRecordGroup struct {
+	ibBase           `json:"-"`
+	Ref              string            `json:"_ref,omitempty"`
+	Name             string            `json:"name,omitempty"`
+	ZoneAssociations []zoneAssociation `json:"zone_associations"`
+	Comment          string            `json:"comment"`
+	Ea               ibclient.EA       `json:"extattrs"`
+}
+
+var sharedRecordGroupReturnFieldsList = []string{
+	"name", "zone_associations", "comment", "extattrs"}
+
+func newSharedRecordGroup(group sharedRecordGroup) *sharedRecordGroup {
+	res := group
+	res.objectType = "sharedrecordgroup"
+	res.returnFields = sharedRecordGroupReturnFieldsList
+
+	return &res
+}
+
+// sharedRecord represents all the types of shared records: 'sharedrecord:a',
+// 'sharedrecord:aaaa', 'sharedrecord:cname', 'sharedrecord:mx', 'sharedrecord:srv'
+// and 'sharedrecord:txt'. Only the fields of the particular type are set.
+type sharedRecord struct {
+	ibBase            `json:"-"`
+	Ref               string      `json:"_ref,omitempty"`
+	Name              string      `json:"name"`
+	SharedRecordGroup string      `json:"shared_record_group,omitempty"`
+	Ipv4Addr          string      `json:"ipv4addr,omitempty"`
+	Ipv6Addr          string      `json:"ipv6addr,omitempty"`
+	Canonical         string      `json:"canonical,omitempty"`
+	MailExchanger     string      `json:"mail_exchanger,omitempty"`
+	Preference        *uint32     `json:"preference,omitempty"`
+	Priority          *uint32     `json:"priority,omitempty"`
+	Weight            *uint32     `json:"weight,omitempty"`
+	Port              *uint32     `json:"port,omitempty"`
+	Target            string      `json:"target,omitempty"`
+	Text              string      `json:"text,omitempty"`
+	Ttl               uint32      `json:"ttl"`
+	UseTtl            bool        `json:"use_ttl"`
+	Comment           string      `json:"comment"`
+	Ea                ibclient.EA `json:"extattrs"`
+}
+
+func newSharedRecord(objectType string, returnFields []string, rec sharedRecord) *sharedRecord {
+	res := rec
+	res.objectType = objectType
+	res.returnFields = returnFields
+
+	return &res
+}
+
+// genericRecord is a DNS record of an arbitrary WAPI type
+// ('record:a', 'record:mx', etc.), which fields are defined by a map.
+// It is used when the type of a record is known at run time only.
+type genericRecord struct {
+	ibBase
+	Ref    string
+	Fields map[string]interface{}
+}
+
+func (rec genericRecord) MarshalJSON() ([]byte, error) {
+	return json.Marshal(rec.Fields)
+}
+
+func (rec *genericRecord) UnmarshalJSON(data []byte) error {
+	if err := json.Unmarshal(data, &rec.Fields); err != nil {
+		return err
+	}
+	if ref, ok := rec.Fields["_ref"].(string); ok {
+		rec.Ref = ref
+	}
+	delete(rec.Fields, "_ref")
+
+	return nil
+}
+
+func newGenericRecord(objectType string, returnFields []string, fields map[string]interface{}) *genericRecord {
+	res := genericRecord{Fields: fields}
+	res.objectType = objectType
+	res.returnFields = returnFields
+
+	return &res
+}
+
+// hostRecordIpv4Addr represents 'record:host_ipv4addr' struct
+// as it is used within a host record.
+type hostRecordIpv4Addr struct {
+	Ipv4Addr         string `json:"ipv4addr"`
+	Mac              string `json:"mac,omitempty"`
+	ConfigureForDhcp bool   `json:"configure_for_dhcp"`
+}
+
+// hostRecordIpv6Addr represents 'record:host_ipv6addr' struct
+// as it is used within a host record.
+type hostRecordIpv6Addr struct {
+	Ipv6Addr         string `json:"ipv6addr"`
+	Duid             string `json:"duid,omitempty"`
+	ConfigureForDhcp bool   `json:"configure_for_dhcp"`
+}
+
+type hostRecord struct {
+	ibBase          `json:"-"`
+	Ref             string               `json:"_ref,omitempty"`
+	Name            string               `json:"name,omitempty"`
+	View            string               `json:"view,omitempty"`
+	Zone            string               `json:"zone,omitempty"`
+	NetworkView     string               `json:"network_view,omitempty"`
+	ConfigureForDns bool                 `json:"configure_for_dns"`
+	Ipv4Addrs       []hostRecordIpv4Addr `json:"ipv4addrs"`
+	Ipv6Addrs       []hostRecordIpv6Addr `json:"ipv6addrs"`
+	Aliases         []string             `json:"aliases"`
+	Ttl             uint32               `json:"ttl"`
+	UseTtl          bool                 `json:"use_ttl"`
+	Comment         string               `json:"comment"`
+	Ea              ibclient.EA          `json:"extattrs"`
+}
+
+func newHostRecord(rec hostRecord) *hostRecord {
+	res := rec
+	res.objectType = "record:host"
+	res.returnFields = []string{
+		"name", "view", "zone", "network_view", "configure_for_dns", "ipv4addrs", "ipv6addrs",
+		"aliases", "ttl", "use_ttl", "comment", "extattrs"}
+
+	return &res
+}
+
+// addressRecord represents both 'record:a' and 'record:aaaa' objects,
+// only the address field of the particular type is set.
+type addressRecord struct {
+	ibBase   `json:"-"`
+	Ref      string      `json:"_ref,omitempty"`
+	Name     string      `json:"name,omitempty"`
+	View     string      `json:"view,omitempty"`
+	Ipv4Addr string      `json:"ipv4addr,omitempty"`
+	Ipv6Addr string      `json:"ipv6addr,omitempty"`
+	Ttl      uint32      `json:"ttl"`
+	UseTtl   bool        `json:"use_ttl"`
+	Comment  string      `json:"comment"`
+	Ea       ibclient.EA `json:"extattrs"`
+}
+
+func newAddressRecord(rec addressRecord, isIPv6 bool) *addressRecord {
+	res := rec
+	if isIPv6 {
+		res.objectType = "record:aaaa"
+		res.returnFields = []string{"name", "view", "ipv6addr", "ttl", "use_ttl", "comment", "extattrs"}
+	} else {
+		res.objectType = "record:a"
+		res.returnFields = []string{"name", "view", "ipv4addr", "ttl", "use_ttl", "comment", "extattrs"}
+	}
+
+	return &res
+}
+
+// dhcpOption represents 'dhcpoption' WAPI struct.
+type dhcpOption struct {
+	Name        string `json:"name,omitempty"`
+	Num         uint32 `json:"num,omitempty"`
+	Value       string `json:"value"`
+	VendorClass string `json:"vendor_class,omitempty"`
+	UseOption   bool   `json:"use_option"`
+}
+
+// fixedAddress represents both 'fixedaddress' and 'ipv6fixedaddress' objects,
+// only the fields of the particular type are set.
+type fixedAddress struct {
+	ibBase           `json:"-"`
+	Ref              string       `json:"_ref,omitempty"`
+	NetworkView      string       `json:"network_view,omitempty"`
+	Network          string       `json:"network,omitempty"`
+	Ipv4Addr         string       `json:"ipv4addr,omitempty"`
+	Mac              string       `json:"mac,omitempty"`
+	MatchClient      string       `json:"match_client,omitempty"`
+	ClientIdentifier string       `json:"dhcp_client_identifier,omitempty"`
+	AgentCircuitId   string       `json:"agent_circuit_id,omitempty"`
+	AgentRemoteId    string       `json:"agent_remote_id,omitempty"`
+	Ipv6Addr         string       `json:"ipv6addr,omitempty"`
+	Duid             string       `json:"duid,omitempty"`
+	AddressType      string       `json:"address_type,omitempty"`
+	Ipv6Prefix       string       `json:"ipv6prefix,omitempty"`
+	Ipv6PrefixBits   uint32       `json:"ipv6prefix_bits,omitempty"`
+	Name             string       `json:"name"`
+	Comment          string       `json:"comment"`
+	Options          []dhcpOption `json:"options"`
+	UseOptions       bool         `json:"use_options"`
+	Ea               ibclient.EA  `json:"extattrs"`
+}
+
+func newFixedAddress(fa fixedAddress, isIPv6 bool) *fixedAddress {
+	res := fa
+	if isIPv6 {
+		res.objectType = "ipv6fixedaddress"
+		res.returnFields = []string{
+			"network_view", "network", "ipv6addr", "duid", "address_type", "ipv6prefix", "ipv6prefix_bits",
+			"name", "comment", "options", "use_options", "extattrs"}
+	} else {
+		res.objectType = "fixedaddress"
+		res.returnFields = []string{
+			"network_view", "network", "ipv4addr", "mac", "match_client", "dhcp_client_identifier",
+			"agent_circuit_id", "agent_remote_id", "name", "comment", "options", "use_options", "extattrs"}
+	}
+
+	return &res
+}
+
+// dhcpMember represents 'dhcpmember' WAPI struct,
+// which is used to assign a grid member to serve a DHCP object.
+type dhcpMember struct {
+	Struct string `json:"_struct"`
+	Name   string `json:"name"`
+}
+
+// exclusionRange represents 'exclusionrange' WAPI struct.
+type exclusionRange struct {
+	StartAddress string `json:"start_address"`
+	EndAddress   string `json:"end_address"`
+	Comment      string `json:"comment,omitempty"`
+}
+
+// dhcpRange represents both 'range' and 'ipv6range' objects.
+// The fields which are not supported by 'ipv6range' are defined as pointers
+// and are set for IPv4 ranges only.
+type dhcpRange struct {
+	ibBase                `json:"-"`
+	Ref                   string           `json:"_ref,omitempty"`
+	NetworkView           string           `json:"network_view,omitempty"`
+	Network               string           `json:"network,omitempty"`
+	StartAddr             string           `json:"start_addr,omitempty"`
+	EndAddr               string           `json:"end_addr,omitempty"`
+	Name                  string           `json:"name"`
+	ServerAssociationType string           `json:"server_association_type,omitempty"`
+	Member                *dhcpMember      `json:"member,omitempty"`
+	FailoverAssociation   *string          `json:"failover_association,omitempty"`
+	Exclude               []exclusionRange `json:"exclude"`
+	Options               *[]dhcpOption    `json:"options,omitempty"`
+	UseOptions            *bool            `json:"use_options,omitempty"`
+	Disable               bool             `json:"disable"`
+	Comment               string           `json:"comment"`
+	Ea                    ibclient.EA      `json:"extattrs"`
+}
+
+func newDHCPRange(r dhcpRange, isIPv6 bool) *dhcpRange {
+	res := r
+	if isIPv6 {
+		res.objectType = "ipv6range"
+		res.returnFields = []string{
+			"network_view", "network", "start_addr", "end_addr", "name", "server_association_type", "member",
+			"exclude", "disable", "comment", "extattrs"}
+	} else {
+		res.objectType = "range"
+		res.returnFields = []string{
+			"network_view", "network", "start_addr", "end_addr", "name", "server_association_type", "member",
+			"failover_association", "exclude", "options", "use_options", "disable", "comment", "extattrs"}
+	}
+
+	return &res
+}
+
+// networkDHCP represents the DHCP-related fields of both 'network' and 'ipv6network' objects,
+// which are not modelled by ibclient.Network.
+type networkDHCP struct {
+	ibBase     `json:"-"`
+	Ref        string       `json:"_ref,omitempty"`
+	Members    []dhcpMember `json:"members"`
+	Options    []dhcpOption `json:"options"`
+	UseOptions bool         `json:"use_options"`
+}
+
+func newNetworkDHCP(n networkDHCP, isIPv6 bool) *networkDHCP {
+	res := n
+	if isIPv6 {
+		res.objectType = "ipv6network"
+	} else {
+		res.objectType = "network"
+	}
+	res.returnFields = []string{"members", "options", "use_options"}
+
+	return &res
+}
+
+// networkRef represents a reference to a network within a list of networks.
+type networkRef struct {
+	Ref string `json:"_ref"`
+}
+
+// sharedNetwork represents both 'sharednetwork' and 'ipv6sharednetwork' objects.
+type sharedNetwork struct {
+	ibBase      `json:"-"`
+	Ref         string       `json:"_ref,omitempty"`
+	Name        string       `json:"name"`
+	NetworkView string       `json:"network_view,omitempty"`
+	Networks    []networkRef `json:"networks"`
+	Options     []dhcpOption `json:"options"`
+	UseOptions  bool         `json:"use_options"`
+	Comment     string       `json:"comment"`
+	Ea          ibclient.EA  `json:"extattrs"`
+}
+
+func newSharedNetwork(sn sharedNetwork, isIPv6 bool) *sharedNetwork {
+	res := sn
+	if isIPv6 {
+		res.objectType = "ipv6sharednetwork"
+	} else {
+		res.objectType = "sharednetwork"
+	}
+	res.returnFields = []string{"name", "network_view", "networks", "options", "use_options", "comment", "extattrs"}
+
+	return &res
+}
+
+// rangeTemplate represents 'rangetemplate' object.
+type rangeTemplate struct {
+	ibBase                `json:"-"`
+	Ref                   string       `json:"_ref,omitempty"`
+	Name                  string       `json:"name"`
+	NumberOfAddresses     uint32       `json:"number_of_addresses"`
+	Offset                uint32       `json:"offset"`
+	ServerAssociationType string       `json:"server_association_type,omitempty"`
+	Member                *dhcpMember  `json:"member,omitempty"`
+	FailoverAssociation   *string      `json:"failover_association,omitempty"`
+	Options               []dhcpOption `json:"options"`
+	UseOptions            bool         `json:"use_options"`
+	Comment               string       `json:"comment"`
+	Ea                    ibclient.EA  `json:"extattrs"`
+}
+
+func newRangeTemplate(rt rangeTemplate) *rangeTemplate {
+	res := rt
+	res.objectType = "rangetemplate"
+	res.returnFields = []string{
+		"name", "number_of_addresses", "offset", "server_association_type", "member", "failover_association",
+		"options", "use_options", "comment", "extattrs"}
+
+	return &res
+}
+
+// networkTemplate represents 'networktemplate' object.
+type networkTemplate struct {
+	ibBase          `json:"-"`
+	Ref             string       `json:"_ref,omitempty"`
+	Name            string       `json:"name"`
+	Netmask         uint32       `json:"netmask,omitempty"`
+	AllowAnyNetmask bool         `json:"allow_any_netmask"`
+	RangeTemplates  []string     `json:"range_templates"`
+	Members         []dhcpMember `json:"members"`
+	Options         []dhcpOption `json:"options"`
+	UseOptions      bool         `json:"use_options"`
+	Comment         string       `json:"comment"`
+	Ea              ibclient.EA  `json:"extattrs"`
+}
+
+func newNetworkTemplate(nt networkTemplate) *networkTemplate {
+	res := nt
+	res.objectType = "networktemplate"
+	res.returnFields = []string{
+		"name", "netmask", "allow_any_netmask", "range_templates", "members", "options", "use_options",
+		"comment", "extattrs"}
+
+	return &res
+}
+
+// templatedNetwork is used to create both 'network' and 'ipv6network' objects
+// out of a network template, which is not supported by ibclient.Network.
+type templatedNetwork struct {
+	ibBase      `json:"-"`
+	Ref         string      `json:"_ref,omitempty"`
+	NetworkView string      `json:"network_view,omitempty"`
+	Network     string      `json:"network"`
+	Template    string      `json:"template"`
+	Comment     string      `json:"comment"`
+	Ea          ibclient.EA `json:"extattrs"`
+}
+
+func newTemplatedNetwork(n templatedNetwork, isIPv6 bool) *templatedNetwork {
+	res := n
+	if isIPv6 {
+		res.objectType = "ipv6network"
+	} else {
+		res.objectType = "network"
+	}
+	res.returnFields = []string{"network_view", "network", "comment", "extattrs"}
+
+	return &res
+}
@@ -212,6 +212,43 @@ func Provider() *schema.Provider {
 			"infoblox_txt_record":             resourceTXTRecord(),
 			"infoblox_mx_record":              resourceMXRecord(),
 			"infoblox_srv_record":             resourceSRVRecord(),
+			"infoblox_caa_record":             resourceCAARecord(),
+			"infoblox_naptr_record":           resourceNAPTRRecord(),
+			"infoblox_dname_record":           resourceDNAMERecord(),
+			"infoblox_alias_record":           resourceAliasRecord(),
+			"infoblox_host_record":            resourceHostRecord(),
+			"infoblox_zone_auth":              resourceZoneAuth(),
+			"infoblox_zone_delegated":         resourceZoneDelegated(),
+			"infoblox_zone_forward":           resourceZoneForward(),
+			"infoblox_zone_stub":              resourceZoneStub(),
+			"infoblox_dns_view":               resourceDNSView(),
+			"infoblox_ns_record":              resourceNSRecord(),
+			"infoblox_zone_rp":                resourceZoneRP(),
+			"infoblox_rpz_rule_cname":         resourceRPZRuleCName(),
+			"infoblox_rpz_rule_a":             resourceRPZRuleA(),
+			"infoblox_rpz_rule_aaaa":          resourceRPZRuleAAAA(),
+			"infoblox_rpz_rule_client_ip":     resourceRPZRuleClientIP(),
+			"infoblox_rpz_rule_nsdname":       resourceRPZRuleNSDName(),
+			"infoblox_rpz_rule_nsip":          resourceRPZRuleNSIP(),
+			"infoblox_named_acl":              resourceNamedACL(),
+			"infoblox_shared_record_group":    resourceSharedRecordGroup(),
+			"infoblox_shared_record_a":        resourceSharedRecordA(),
+			"infoblox_shared_record_aaaa":     resourceSharedRecordAAAA(),
+			"infoblox_shared_record_cname":    resourceSharedRecordCName(),
+			"infoblox_shared_record_mx":       resourceSharedRecordMX(),
+			"infoblox_shared_record_srv":      resourceSharedRecordSRV(),
+			"infoblox_shared_record_txt":      resourceSharedRecordTXT(),
+			"infoblox_zone_file_import":       resourceZoneFileImport(),
+			"infoblox_a_record_set":           resourceARecordSet(),
+			"infoblox_aaaa_record_set":        resourceAAAARecordSet(),
+			"infoblox_ipv4_fixed_address":     resourceIPv4FixedAddress(),
+			"infoblox_ipv6_fixed_address":     resourceIPv6FixedAddress(),
+			"infoblox_ipv4_range":             resourceIPv4Range(),
+			"infoblox_ipv6_range":             resourceIPv6Range(),
+			"infoblox_ipv4_shared_network":    resourceIPv4SharedNetwork(),
+			"infoblox_ipv6_shared_network":    resourceIPv6SharedNetwork(),
+			"infoblox_range_template":         resourceRangeTemplate(),
+			"infoblox_network_template":       resourceNetworkTemplate(),
 		},
 		DataSourcesMap: map[string]*schema.Resource{
 			"infoblox_ipv4_network":           dataSourceIPv4Network(),
@@ -224,6 +261,27 @@ func Provider() *schema.Provider {
 			"infoblox_txt_record":             dataSourceTXTRecord(),
 			"infoblox_mx_record":              dataSourceMXRecord(),
 			"infoblox_srv_record":             dataSourceSRVRecord(),
+			"infoblox_caa_record":             dataSourceCAARecord(),
+			"infoblox_naptr_record":           dataSourceNAPTRRecord(),
+			"infoblox_dname_record":           dataSourceDNAMERecord(),
+			"infoblox_alias_record":           dataSourceAliasRecord(),
+			"infoblox_dns_view":               dataSourceDNSView(),
+			"infoblox_ns_record":              dataSourceNSRecord(),
+			"infoblox_named_acl":              dataSourceNamedACL(),
+			"infoblox_zone_export":            dataSourceZoneExport(),
+			"infoblox_a_records":              dataSourceARecords(),
+			"infoblox_aaaa_records":           dataSourceAAAARecords(),
+			"infoblox_cname_records":          dataSourceCNameRecords(),
+			"infoblox_ptr_records":            dataSourcePTRRecords(),
+			"infoblox_txt_records":            dataSourceTXTRecords(),
+			"infoblox_mx_records":             dataSourceMXRecords(),
+			"infoblox_srv_records":            dataSourceSRVRecords(),
+			"infoblox_caa_records":            dataSourceCAARecords(),
+			"infoblox_naptr_records":          dataSourceNAPTRRecords(),
+			"infoblox_dname_records":          dataSourceDNAMERecords(),
+			"infoblox_alias_records":          dataSourceAliasRecords(),
+			"infoblox_zone_records":           dataSourceZoneRecords(),
+			"infoblox_stale_records":          dataSourceStaleRecords(),
 		},
 		ConfigureContextFunc: providerConfigure,
 	}
@@ -1,6 +1,7 @@
 package infoblox
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 
@@ -11,11 +12,12 @@ import (
 
 func resourceARecord() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceARecordCreate,
-		Read:     resourceARecordGet,
-		Update:   resourceARecordUpdate,
-		Delete:   resourceARecordDelete,
-		Importer: &schema.ResourceImporter{},
+		Create:        resourceARecordCreate,
+		Read:          resourceARecordGet,
+		Update:        resourceARecordUpdate,
+		Delete:        resourceARecordDelete,
+		Importer:      &schema.ResourceImporter{},
+		CustomizeDiff: customizeDiffRecordPTR("ip_addr"),
 
 		Schema: map[string]*schema.Schema{
 			"dns_view": {
@@ -64,6 +66,17 @@ func resourceARecord() *schema.Resource {
 				Default:     "",
 				Description: "Extensible attributes of the A-record to be added/updated, as a map in JSON format",
 			},
+			"create_ptr": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "The flag which defines if a PTR-record is managed for the address of the A-record, in the corresponding reverse zone.",
+			},
+			"ptr_ref": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "NIOS object's reference of the PTR-record which is managed for the A-record.",
+			},
 		},
 	}
 }
@@ -132,6 +145,9 @@ func resourceARecordCreate(d *schema.ResourceData, m interface{}) error {
 	if err = d.Set("ip_addr", newRecord.Ipv4Addr); err != nil {
 		return err
 	}
+	if err = syncRecordPTR(d, objMgr, dnsViewName, fqdn, newRecord.Ipv4Addr, useTtl, ttl, comment, extAttrs); err != nil {
+		return err
+	}
 	if val, ok := d.GetOk("network_view"); !ok || val.(string) == "" {
 		dnsViewObj, err := objMgr.GetDNSView(dnsViewName)
 		if err != nil {
@@ -217,6 +233,10 @@ func resourceARecordGet(d *schema.ResourceData, m interface{}) error {
 		return err
 	}
 
+	if err = readRecordPTR(d, objMgr); err != nil {
+		return err
+	}
+
 	d.SetId(obj.Ref)
 
 	return nil
@@ -237,6 +257,7 @@ func resourceARecordUpdate(d *schema.ResourceData, m interface{}) error {
 			prevTTL, _ := d.GetChange("ttl")
 			prevComment, _ := d.GetChange("comment")
 			prevEa, _ := d.GetChange("ext_attrs")
+			prevCreatePTR, _ := d.GetChange("create_ptr")
 
 			// TODO: move to the new Terraform plugin framework and
 			// process all the errors instead of ignoring them here.
@@ -248,6 +269,7 @@ func resourceARecordUpdate(d *schema.ResourceData, m interface{}) error {
 			_ = d.Set("ttl", prevTTL.(int))
 			_ = d.Set("comment", prevComment.(string))
 			_ = d.Set("ext_attrs", prevEa.(string))
+			_ = d.Set("create_ptr", prevCreatePTR.(bool))
 		}
 	}()
 
@@ -333,6 +355,13 @@ func resourceARecordUpdate(d *schema.ResourceData, m interface{}) error {
 		return err
 	}
 
+	// The PTR-record follows the address of the A-record, including the one allocated from a network.
+	if err = syncRecordPTR(
+		d, objMgr, d.Get("dns_view").(string), fqdn, rec.Ipv4Addr, useTtl, ttl, comment, extAttrs); err != nil {
+		revertRecordPTRFields(d)
+		return err
+	}
+
 	return nil
 }
 
@@ -353,6 +382,10 @@ func resourceARecordDelete(d *schema.ResourceData, m interface{}) error {
 	connector := m.(ibclient.IBConnector)
 	objMgr := ibclient.NewObjectManager(connector, "Terraform", tenantID)
 
+	if err := deleteRecordPTR(d, objMgr); err != nil {
+		return err
+	}
+
 	_, err := objMgr.DeleteARecord(d.Id())
 	if err != nil {
 		return fmt.Errorf("deletion of A-record failed: %w", err)
@@ -361,3 +394,119 @@ func resourceARecordDelete(d *schema.ResourceData, m interface{}) error {
 
 	return nil
 }
+
+// Creates, updates or deletes the PTR-record which corresponds to the address of an A- or AAAA-record,
+// depending on the value of 'create_ptr' field. The PTR-record gets the same TTL, comment and extensible attributes.
+func syncRecordPTR(
+	d *schema.ResourceData, objMgr ibclient.IBObjectManager,
+	dnsView, fqdn, ipAddr string, useTtl bool, ttl uint32, comment string, extAttrs map[string]interface{}) error {
+
+	if !d.Get("create_ptr").(bool) {
+		return deleteRecordPTR(d, objMgr)
+	}
+
+	if ptrRef := getStatePTRRef(d); ptrRef != "" {
+		rec, err := objMgr.UpdatePTRRecord(ptrRef, "", fqdn, "", "", ipAddr, useTtl, ttl, comment, extAttrs)
+		if err == nil {
+			return d.Set("ptr_ref", rec.Ref)
+		}
+		if !isNotFoundError(err) {
+			return fmt.Errorf("error updating PTR-record for address '%s': %w", ipAddr, err)
+		}
+	}
+
+	rec, err := objMgr.CreatePTRRecord("", dnsView, fqdn, "", "", ipAddr, useTtl, ttl, comment, extAttrs)
+	if err != nil {
+		return fmt.Errorf(
+			"creation of PTR-record for address '%s' under DNS view '%s' failed: %w", ipAddr, dnsView, err)
+	}
+
+	return d.Set("ptr_ref", rec.Ref)
+}
+
+// The reference of the PTR-record as it is in the state:
+// the value of 'ptr_ref' is unknown during an update which is planned to change it.
+func getStatePTRRef(d *schema.ResourceData) string {
+	ptrRef, _ := d.GetChange("ptr_ref")
+	return ptrRef.(string)
+}
+
+// Checks if the PTR-record corresponds to the name and the address of the record.
+func isRecordPTRInSync(rec *ibclient.RecordPTR, fqdn, ipAddr string) bool {
+	ptrAddr := rec.Ipv4Addr
+	if ptrAddr == "" {
+		ptrAddr = rec.Ipv6Addr
+	}
+
+	return rec.PtrdName == fqdn && sameIPAddrs(ptrAddr, ipAddr)
+}
+
+// Clears 'ptr_ref' if the managed PTR-record is deleted outside of Terraform,
+// the next update creates it anew.
+func readRecordPTR(d *schema.ResourceData, objMgr ibclient.IBObjectManager) error {
+	ptrRef := d.Get("ptr_ref").(string)
+	if ptrRef == "" {
+		return nil
+	}
+
+	if _, err := objMgr.GetPTRRecordByRef(ptrRef); err != nil {
+		if !isNotFoundError(err) {
+			return fmt.Errorf("failed getting PTR-record: %w", err)
+		}
+		return d.Set("ptr_ref", "")
+	}
+
+	return nil
+}
+
+// Returns the function which plans an update of the record when the managed PTR-record
+// is missing or does not correspond to the name and the address of the record anymore,
+// so that syncRecordPTR creates it or updates it in place.
+func customizeDiffRecordPTR(ipAddrField string) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+		if d.HasChange("create_ptr") || d.HasChange("fqdn") || d.HasChange(ipAddrField) {
+			return d.SetNewComputed("ptr_ref")
+		}
+		if d.Id() == "" || !d.Get("create_ptr").(bool) {
+			return nil
+		}
+		ptrRef := d.Get("ptr_ref").(string)
+		if ptrRef == "" {
+			return d.SetNewComputed("ptr_ref")
+		}
+
+		objMgr := ibclient.NewObjectManager(m.(ibclient.IBConnector), "Terraform", "")
+		rec, err := objMgr.GetPTRRecordByRef(ptrRef)
+		if err != nil {
+			if !isNotFoundError(err) {
+				return fmt.Errorf("failed getting PTR-record: %w", err)
+			}
+			return d.SetNewComputed("ptr_ref")
+		}
+		if !isRecordPTRInSync(rec, d.Get("fqdn").(string), d.Get(ipAddrField).(string)) {
+			return d.SetNewComputed("ptr_ref")
+		}
+
+		return nil
+	}
+}
+
+// Reverts the PTR-related fields only, when the record itself is updated already
+// but the PTR-record failed to be synchronized.
+func revertRecordPTRFields(d *schema.ResourceData) {
+	prevCreatePTR, _ := d.GetChange("create_ptr")
+	_ = d.Set("create_ptr", prevCreatePTR.(bool))
+	_ = d.Set("ptr_ref", getStatePTRRef(d))
+}
+
+func deleteRecordPTR(d *schema.ResourceData, objMgr ibclient.IBObjectManager) error {
+	ptrRef := getStatePTRRef(d)
+	if ptrRef == "" {
+		return nil
+	}
+	if _, err := objMgr.DeletePTRRecord(ptrRef); err != nil && !isNotFoundError(err) {
+		return fmt.Errorf("deletion of PTR-record failed: %w", err)
+	}
+
+	return d.Set("ptr_ref", "")
+}
@@ -306,3 +306,186 @@ func TestAccResourceARecord(t *testing.T) {
 		},
 	})
 }
+
+// Checks that the PTR-record managed by an A- or AAAA-record corresponds to its name and address.
+func testAccRecordPTRCompare(t *testing.T, resPath string, addrAttr string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		res, found := s.RootModule().Resources[resPath]
+		if !found {
+			return fmt.Errorf("not found: %s", resPath)
+		}
+		ptrRef := res.Primary.Attributes["ptr_ref"]
+		if ptrRef == "" {
+			return fmt.Errorf("'ptr_ref' is not set")
+		}
+		meta := testAccProvider.Meta()
+		connector := meta.(ibclient.IBConnector)
+		objMgr := ibclient.NewObjectManager(connector, "terraform_test", "test")
+
+		rec, err := objMgr.GetPTRRecordByRef(ptrRef)
+		if err != nil {
+			return fmt.Errorf("PTR-record not found: %s", err)
+		}
+		if rec.PtrdName != res.Primary.Attributes["fqdn"] {
+			return fmt.Errorf(
+				"'ptrdname' of the PTR-record does not match: got '%s', expected '%s'",
+				rec.PtrdName, res.Primary.Attributes["fqdn"])
+		}
+		ptrAddr := rec.Ipv4Addr
+		if ptrAddr == "" {
+			ptrAddr = rec.Ipv6Addr
+		}
+		if !sameIPAddrs(ptrAddr, res.Primary.Attributes[addrAttr]) {
+			return fmt.Errorf(
+				"the address of the PTR-record does not match: got '%s', expected '%s'",
+				ptrAddr, res.Primary.Attributes[addrAttr])
+		}
+
+		return nil
+	}
+}
+
+func TestAccResourceARecordCreatePTR(t *testing.T) {
+	var ptrRef string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckARecordDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "infoblox_zone_auth" "rev4" {
+						fqdn = "10.40.0.0/16"
+						zone_format = "IPV4"
+					}
+					resource "infoblox_ipv4_network" "net" {
+						cidr = "10.40.1.0/24"
+					}
+					resource "infoblox_a_record" "foo" {
+						fqdn = "ptr-a.test.com"
+						ip_addr = "10.40.0.5"
+						ttl = 300
+						create_ptr = true
+						depends_on = [infoblox_zone_auth.rev4]
+					}`,
+				Check: resource.ComposeTestCheckFunc(
+					testAccRecordPTRCompare(t, "infoblox_a_record.foo", "ip_addr"),
+				),
+			},
+			{
+				// the PTR-record follows the address allocated from the network
+				Config: `
+					resource "infoblox_zone_auth" "rev4" {
+						fqdn = "10.40.0.0/16"
+						zone_format = "IPV4"
+					}
+					resource "infoblox_ipv4_network" "net" {
+						cidr = "10.40.1.0/24"
+					}
+					resource "infoblox_a_record" "foo" {
+						fqdn = "ptr-a.test.com"
+						cidr = infoblox_ipv4_network.net.cidr
+						ttl = 300
+						create_ptr = true
+						depends_on = [infoblox_zone_auth.rev4]
+					}`,
+				Check: resource.ComposeTestCheckFunc(
+					testAccARecordCompare(t, "infoblox_a_record.foo", &ibclient.RecordA{
+						Name:   "ptr-a.test.com",
+						View:   "default",
+						Ttl:    300,
+						UseTtl: true,
+					}, "10.40.0.5", "10.40.1.0/24"),
+					testAccRecordPTRCompare(t, "infoblox_a_record.foo", "ip_addr"),
+					func(s *terraform.State) error {
+						ptrRef = s.RootModule().Resources["infoblox_a_record.foo"].Primary.Attributes["ptr_ref"]
+						return nil
+					},
+				),
+			},
+			{
+				// the PTR-record changed outside of Terraform is updated back in place
+				PreConfig: func() {
+					connector := testAccProvider.Meta().(ibclient.IBConnector)
+					rec := newGenericRecord("record:ptr", nil, map[string]interface{}{"ptrdname": "other.test.com"})
+					if _, err := connector.UpdateObject(rec, ptrRef); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: `
+					resource "infoblox_zone_auth" "rev4" {
+						fqdn = "10.40.0.0/16"
+						zone_format = "IPV4"
+					}
+					resource "infoblox_ipv4_network" "net" {
+						cidr = "10.40.1.0/24"
+					}
+					resource "infoblox_a_record" "foo" {
+						fqdn = "ptr-a.test.com"
+						cidr = infoblox_ipv4_network.net.cidr
+						ttl = 300
+						create_ptr = true
+						depends_on = [infoblox_zone_auth.rev4]
+					}`,
+				Check: resource.ComposeTestCheckFunc(
+					testAccRecordPTRCompare(t, "infoblox_a_record.foo", "ip_addr"),
+					func(s *terraform.State) error {
+						ref := s.RootModule().Resources["infoblox_a_record.foo"].Primary.Attributes["ptr_ref"]
+						if ref != ptrRef {
+							return fmt.Errorf("the PTR-record is expected to be kept: got '%s', expected '%s'", ref, ptrRef)
+						}
+						return nil
+					},
+				),
+			},
+			{
+				// the PTR-record deleted outside of Terraform is created anew
+				PreConfig: func() {
+					connector := testAccProvider.Meta().(ibclient.IBConnector)
+					if _, err := connector.DeleteObject(ptrRef); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: `
+					resource "infoblox_zone_auth" "rev4" {
+						fqdn = "10.40.0.0/16"
+						zone_format = "IPV4"
+					}
+					resource "infoblox_ipv4_network" "net" {
+						cidr = "10.40.1.0/24"
+					}
+					resource "infoblox_a_record" "foo" {
+						fqdn = "ptr-a.test.com"
+						cidr = infoblox_ipv4_network.net.cidr
+						ttl = 300
+						create_ptr = true
+						depends_on = [infoblox_zone_auth.rev4]
+					}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("infoblox_a_record.foo", "create_ptr", "true"),
+					testAccRecordPTRCompare(t, "infoblox_a_record.foo", "ip_addr"),
+				),
+			},
+			{
+				Config: `
+					resource "infoblox_zone_auth" "rev4" {
+						fqdn = "10.40.0.0/16"
+						zone_format = "IPV4"
+					}
+					resource "infoblox_ipv4_network" "net" {
+						cidr = "10.40.1.0/24"
+					}
+					resource "infoblox_a_record" "foo" {
+						fqdn = "ptr-a.test.com"
+						cidr = infoblox_ipv4_network.net.cidr
+						ttl = 300
+						depends_on = [infoblox_zone_auth.rev4]
+					}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("infoblox_a_record.foo", "ptr_ref", ""),
+				),
+			},
+		},
+	})
+}
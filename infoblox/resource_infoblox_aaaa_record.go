@@ -11,11 +11,12 @@ import (
 
 func resourceAAAARecord() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceAAAARecordCreate,
-		Read:     resourceAAAARecordGet,
-		Update:   resourceAAAARecordUpdate,
-		Delete:   resourceAAAARecordDelete,
-		Importer: &schema.ResourceImporter{},
+		Create:        resourceAAAARecordCreate,
+		Read:          resourceAAAARecordGet,
+		Update:        resourceAAAARecordUpdate,
+		Delete:        resourceAAAARecordDelete,
+		Importer:      &schema.ResourceImporter{},
+		CustomizeDiff: customizeDiffRecordPTR("ipv6_addr"),
 
 		Schema: map[string]*schema.Schema{
 			"dns_view": {
@@ -64,6 +65,17 @@ func resourceAAAARecord() *schema.Resource {
 				Default:     "",
 				Description: "Extensible attributes of the AAAA-record to be added/updated, as a map in JSON format",
 			},
+			"create_ptr": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "The flag which defines if a PTR-record is managed for the address of the AAAA-record, in the corresponding reverse zone.",
+			},
+			"ptr_ref": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "NIOS object's reference of the PTR-record which is managed for the AAAA-record.",
+			},
 		},
 	}
 }
@@ -132,6 +144,9 @@ func resourceAAAARecordCreate(d *schema.ResourceData, m interface{}) error {
 	if err = d.Set("ipv6_addr", recordAAAA.Ipv6Addr); err != nil {
 		return err
 	}
+	if err = syncRecordPTR(d, objMgr, dnsViewName, fqdn, recordAAAA.Ipv6Addr, useTtl, ttl, comment, extAttrs); err != nil {
+		return err
+	}
 	if val, ok := d.GetOk("network_view"); !ok || val.(string) == "" {
 		dnsViewObj, err := objMgr.GetDNSView(dnsViewName)
 		if err != nil {
@@ -215,6 +230,10 @@ func resourceAAAARecordGet(d *schema.ResourceData, m interface{}) error {
 		return err
 	}
 
+	if err = readRecordPTR(d, objMgr); err != nil {
+		return err
+	}
+
 	d.SetId(obj.Ref)
 
 	return nil
@@ -235,6 +254,7 @@ func resourceAAAARecordUpdate(d *schema.ResourceData, m interface{}) error {
 			prevTTL, _ := d.GetChange("ttl")
 			prevComment, _ := d.GetChange("comment")
 			prevEa, _ := d.GetChange("ext_attrs")
+			prevCreatePTR, _ := d.GetChange("create_ptr")
 
 			_ = d.Set("network_view", prevNetView.(string))
 			_ = d.Set("dns_view", prevDNSView.(string))
@@ -244,7 +264,7 @@ func resourceAAAARecordUpdate(d *schema.ResourceData, m interface{}) error {
 			_ = d.Set("ttl", prevTTL.(int))
 			_ = d.Set("comment", prevComment.(string))
 			_ = d.Set("ext_attrs", prevEa.(string))
-
+			_ = d.Set("create_ptr", prevCreatePTR.(bool))
 		}
 	}()
 
@@ -330,6 +350,13 @@ func resourceAAAARecordUpdate(d *schema.ResourceData, m interface{}) error {
 		return err
 	}
 
+	// The PTR-record follows the address of the AAAA-record, including the one allocated from a network.
+	if err = syncRecordPTR(
+		d, objMgr, d.Get("dns_view").(string), fqdn, recordAAAA.Ipv6Addr, useTtl, ttl, comment, extAttrs); err != nil {
+		revertRecordPTRFields(d)
+		return err
+	}
+
 	return nil
 }
 
@@ -352,6 +379,10 @@ func resourceAAAARecordDelete(d *schema.ResourceData, m interface{}) error {
 	connector := m.(ibclient.IBConnector)
 	objMgr := ibclient.NewObjectManager(connector, "Terraform", tenantID)
 
+	if err := deleteRecordPTR(d, objMgr); err != nil {
+		return err
+	}
+
 	_, err := objMgr.DeleteAAAARecord(d.Id())
 	if err != nil {
 		return fmt.Errorf("deletion of AAAA Record from dns view %s failed: %w", dnsView, err)
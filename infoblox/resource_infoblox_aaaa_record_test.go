@@ -295,3 +295,45 @@ func TestAccResourceAAAARecord(t *testing.T) {
 		},
 	})
 }
+
+func TestAccResourceAAAARecordCreatePTR(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAAAARecordDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "infoblox_zone_auth" "rev6" {
+						fqdn = "2001:db8:40::/64"
+						zone_format = "IPV6"
+					}
+					resource "infoblox_aaaa_record" "foo" {
+						fqdn = "ptr-aaaa.test.com"
+						ipv6_addr = "2001:db8:40::5"
+						create_ptr = true
+						depends_on = [infoblox_zone_auth.rev6]
+					}`,
+				Check: resource.ComposeTestCheckFunc(
+					testAccRecordPTRCompare(t, "infoblox_aaaa_record.foo", "ipv6_addr"),
+				),
+			},
+			{
+				Config: `
+					resource "infoblox_zone_auth" "rev6" {
+						fqdn = "2001:db8:40::/64"
+						zone_format = "IPV6"
+					}
+					resource "infoblox_aaaa_record" "foo" {
+						fqdn = "ptr-aaaa2.test.com"
+						ipv6_addr = "2001:db8:40::6"
+						create_ptr = true
+						depends_on = [infoblox_zone_auth.rev6]
+					}`,
+				Check: resource.ComposeTestCheckFunc(
+					testAccRecordPTRCompare(t, "infoblox_aaaa_record.foo", "ipv6_addr"),
+				),
+			},
+		},
+	})
+}
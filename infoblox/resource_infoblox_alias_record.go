@@ -0,0 +1,235 @@
+package infoblox
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+)
+
+func resourceAliasRecord() *schema.Resource {
+	return &schema.Resource{
+		Create:   resourceAliasRecordCreate,
+		Read:     resourceAliasRecordGet,
+		Update:   resourceAliasRecordUpdate,
+		Delete:   resourceAliasRecordDelete,
+		Importer: &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			"dns_view": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     defaultDNSView,
+				Description: "DNS view which the zone does exist within.",
+			},
+			"fqdn": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "FQDN for the ALIAS-record.",
+			},
+			"target_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the target, ex. the hostname of a cloud load balancer.",
+			},
+			"target_type": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The type of the target's records the ALIAS-record resolves to: 'A', 'AAAA', 'MX', 'NAPTR', 'PTR', 'SPF', 'SRV' or 'TXT'.",
+			},
+			"ttl": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     ttlUndef,
+				Description: "TTL value for the ALIAS-record.",
+			},
+			"comment": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "Description of the ALIAS-record.",
+			},
+			"ext_attrs": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "Extensible attributes of the ALIAS-record to be added/updated, as a map in JSON format.",
+			},
+		},
+	}
+}
+
+// Builds a ALIAS-record object out of the resource's fields,
+// except 'dns_view' which cannot be changed once the record is created.
+func buildAliasRecord(d *schema.ResourceData) (*recordAlias, error) {
+	fqdn := d.Get("fqdn").(string)
+	if fqdn == "" {
+		return nil, fmt.Errorf("'fqdn' must not be empty")
+	}
+	targetName := d.Get("target_name").(string)
+	if targetName == "" {
+		return nil, fmt.Errorf("'target_name' must not be empty")
+	}
+	targetType := d.Get("target_type").(string)
+	switch targetType {
+	case "A", "AAAA", "MX", "NAPTR", "PTR", "SPF", "SRV", "TXT":
+	default:
+		return nil, fmt.Errorf(
+			"'target_type' must be one of 'A', 'AAAA', 'MX', 'NAPTR', 'PTR', 'SPF', 'SRV' or 'TXT'")
+	}
+
+	var ttl uint32
+	useTtl := false
+	tempTTL := d.Get("ttl").(int)
+	if tempTTL >= 0 {
+		useTtl = true
+		ttl = uint32(tempTTL)
+	} else if tempTTL != ttlUndef {
+		return nil, fmt.Errorf("TTL value must be 0 or higher")
+	}
+
+	comment := d.Get("comment").(string)
+
+	extAttrJSON := d.Get("ext_attrs").(string)
+	extAttrs := make(map[string]interface{})
+	if extAttrJSON != "" {
+		if err := json.Unmarshal([]byte(extAttrJSON), &extAttrs); err != nil {
+			return nil, fmt.Errorf("cannot process 'ext_attrs' field: %w", err)
+		}
+	}
+
+	return newRecordAlias(recordAlias{
+		Name:       fqdn,
+		TargetName: targetName,
+		TargetType: targetType,
+		Ttl:        ttl,
+		UseTtl:     useTtl,
+		Comment:    comment,
+		Ea:         extAttrs,
+	}), nil
+}
+
+func resourceAliasRecordCreate(d *schema.ResourceData, m interface{}) error {
+	rec, err := buildAliasRecord(d)
+	if err != nil {
+		return err
+	}
+	rec.View = d.Get("dns_view").(string)
+
+	connector := m.(ibclient.IBConnector)
+	ref, err := connector.CreateObject(rec)
+	if err != nil {
+		return fmt.Errorf("error creating ALIAS-record: %w", err)
+	}
+	d.SetId(ref)
+
+	return nil
+}
+
+func resourceAliasRecordGet(d *schema.ResourceData, m interface{}) error {
+	connector := m.(ibclient.IBConnector)
+
+	obj := newRecordAlias(recordAlias{})
+	if err := connector.GetObject(obj, d.Id(), ibclient.NewQueryParams(false, nil), obj); err != nil {
+		return fmt.Errorf("failed getting ALIAS-record: %w", err)
+	}
+
+	ttl := int(obj.Ttl)
+	if !obj.UseTtl {
+		ttl = ttlUndef
+	}
+	if err := d.Set("ttl", ttl); err != nil {
+		return err
+	}
+
+	if obj.Ea != nil && len(obj.Ea) > 0 {
+		// TODO: temporary scaffold, need to rework marshalling/unmarshalling of EAs
+		//       (avoiding additional layer of keys ("value" key)
+		eaMap := (map[string]interface{})(obj.Ea)
+		ea, err := json.Marshal(eaMap)
+		if err != nil {
+			return err
+		}
+		if err = d.Set("ext_attrs", string(ea)); err != nil {
+			return err
+		}
+	}
+
+	if err := d.Set("comment", obj.Comment); err != nil {
+		return err
+	}
+	if err := d.Set("dns_view", obj.View); err != nil {
+		return err
+	}
+	if err := d.Set("fqdn", obj.Name); err != nil {
+		return err
+	}
+	if err := d.Set("target_name", obj.TargetName); err != nil {
+		return err
+	}
+	if err := d.Set("target_type", obj.TargetType); err != nil {
+		return err
+	}
+
+	d.SetId(obj.Ref)
+
+	return nil
+}
+
+func resourceAliasRecordUpdate(d *schema.ResourceData, m interface{}) error {
+	var updateSuccessful bool
+	defer func() {
+		// Reverting the state back, in case of a failure,
+		// otherwise Terraform will keep the values, which leaded to the failure,
+		// in the state file.
+		if !updateSuccessful {
+			prevDNSView, _ := d.GetChange("dns_view")
+			prevFQDN, _ := d.GetChange("fqdn")
+			prevTargetName, _ := d.GetChange("target_name")
+			prevTargetType, _ := d.GetChange("target_type")
+			prevTTL, _ := d.GetChange("ttl")
+			prevComment, _ := d.GetChange("comment")
+			prevEa, _ := d.GetChange("ext_attrs")
+
+			_ = d.Set("dns_view", prevDNSView.(string))
+			_ = d.Set("fqdn", prevFQDN.(string))
+			_ = d.Set("target_name", prevTargetName.(string))
+			_ = d.Set("target_type", prevTargetType.(string))
+			_ = d.Set("ttl", prevTTL.(int))
+			_ = d.Set("comment", prevComment.(string))
+			_ = d.Set("ext_attrs", prevEa.(string))
+		}
+	}()
+
+	if d.HasChange("dns_view") {
+		return fmt.Errorf("changing the value of 'dns_view' field is not allowed")
+	}
+
+	rec, err := buildAliasRecord(d)
+	if err != nil {
+		return err
+	}
+
+	connector := m.(ibclient.IBConnector)
+	ref, err := connector.UpdateObject(rec, d.Id())
+	if err != nil {
+		return fmt.Errorf("error updating ALIAS-record: %w", err)
+	}
+	updateSuccessful = true
+	d.SetId(ref)
+
+	return nil
+}
+
+func resourceAliasRecordDelete(d *schema.ResourceData, m interface{}) error {
+	connector := m.(ibclient.IBConnector)
+
+	if _, err := connector.DeleteObject(d.Id()); err != nil {
+		return fmt.Errorf("deletion of ALIAS-record failed: %w", err)
+	}
+	d.SetId("")
+
+	return nil
+}
@@ -0,0 +1,164 @@
+package infoblox
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+)
+
+func testAccCheckAliasRecordDestroy(s *terraform.State) error {
+	meta := testAccProvider.Meta()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "infoblox_alias_record" {
+			continue
+		}
+		connector := meta.(ibclient.IBConnector)
+		rec := newRecordAlias(recordAlias{})
+		err := connector.GetObject(rec, rs.Primary.ID, ibclient.NewQueryParams(false, nil), rec)
+		if err == nil {
+			return fmt.Errorf("ALIAS-record still exists")
+		}
+	}
+	return nil
+}
+
+func testAccAliasRecordCompare(t *testing.T, resPath string, expectedRec *recordAlias) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		res, found := s.RootModule().Resources[resPath]
+		if !found {
+			return fmt.Errorf("not found: %s", resPath)
+		}
+		if res.Primary.ID == "" {
+			return fmt.Errorf("ID is not set")
+		}
+		meta := testAccProvider.Meta()
+		connector := meta.(ibclient.IBConnector)
+
+		rec := newRecordAlias(recordAlias{})
+		if err := connector.GetObject(rec, res.Primary.ID, ibclient.NewQueryParams(false, nil), rec); err != nil {
+			return fmt.Errorf("ALIAS-record not found: %s", err)
+		}
+
+		if rec.Name != expectedRec.Name {
+			return fmt.Errorf(
+				"'fqdn' does not match: got '%s', expected '%s'",
+				rec.Name, expectedRec.Name)
+		}
+		if rec.View != expectedRec.View {
+			return fmt.Errorf(
+				"'dns_view' does not match: got '%s', expected '%s'",
+				rec.View, expectedRec.View)
+		}
+		if rec.TargetName != expectedRec.TargetName {
+			return fmt.Errorf(
+				"'target_name' does not match: got '%s', expected '%s'",
+				rec.TargetName, expectedRec.TargetName)
+		}
+		if rec.TargetType != expectedRec.TargetType {
+			return fmt.Errorf(
+				"'target_type' does not match: got '%s', expected '%s'",
+				rec.TargetType, expectedRec.TargetType)
+		}
+		if rec.UseTtl != expectedRec.UseTtl {
+			return fmt.Errorf(
+				"TTL usage does not match: got '%t', expected '%t'",
+				rec.UseTtl, expectedRec.UseTtl)
+		}
+		if rec.UseTtl && rec.Ttl != expectedRec.Ttl {
+			return fmt.Errorf(
+				"'ttl' does not match: got '%d', expected '%d'",
+				rec.Ttl, expectedRec.Ttl)
+		}
+		if rec.Comment != expectedRec.Comment {
+			return fmt.Errorf(
+				"'comment' does not match: got '%s', expected '%s'",
+				rec.Comment, expectedRec.Comment)
+		}
+		return validateEAs(rec.Ea, expectedRec.Ea)
+	}
+}
+
+func TestAccResourceAliasRecord(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAliasRecordDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "infoblox_alias_record" "foo"{
+						fqdn = "test.com"
+						target_name = "lb-1234.us-east-1.elb.amazonaws.com"
+						target_type = "A"
+					}`,
+				Check: resource.ComposeTestCheckFunc(
+					testAccAliasRecordCompare(t, "infoblox_alias_record.foo", &recordAlias{
+						Name:       "test.com",
+						View:       "default",
+						TargetName: "lb-1234.us-east-1.elb.amazonaws.com",
+						TargetType: "A",
+					}),
+				),
+			},
+			{
+				Config: `
+					resource "infoblox_alias_record" "foo"{
+						fqdn = "test.com"
+						target_name = "lb-5678.us-east-1.elb.amazonaws.com"
+						target_type = "AAAA"
+						ttl = 300
+						comment = "test comment 1"
+						ext_attrs = jsonencode({
+							"Location" = "Los Angeles"
+						})
+					}`,
+				Check: resource.ComposeTestCheckFunc(
+					testAccAliasRecordCompare(t, "infoblox_alias_record.foo", &recordAlias{
+						Name:       "test.com",
+						View:       "default",
+						TargetName: "lb-5678.us-east-1.elb.amazonaws.com",
+						TargetType: "AAAA",
+						Ttl:        300,
+						UseTtl:     true,
+						Comment:    "test comment 1",
+						Ea: ibclient.EA{
+							"Location": "Los Angeles",
+						},
+					}),
+				),
+			},
+			{
+				ResourceName:      "infoblox_alias_record.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+
+			// negative test cases
+			{
+				Config: `
+					resource "infoblox_alias_record" "foo"{
+						fqdn = "test.com"
+						target_name = "lb-5678.us-east-1.elb.amazonaws.com"
+						target_type = "CNAME"
+					}`,
+				ExpectError: regexp.MustCompile(
+					"'target_type' must be one of 'A', 'AAAA', 'MX', 'NAPTR', 'PTR', 'SPF', 'SRV' or 'TXT'"),
+			},
+			{
+				Config: `
+					resource "infoblox_alias_record" "foo"{
+						dns_view = "nondefault_view"
+						fqdn = "test.com"
+						target_name = "lb-5678.us-east-1.elb.amazonaws.com"
+						target_type = "AAAA"
+					}`,
+				ExpectError: regexp.MustCompile("changing the value of 'dns_view' field is not allowed"),
+			},
+		},
+	})
+}
@@ -0,0 +1,251 @@
+package infoblox
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+)
+
+func resourceCAARecord() *schema.Resource {
+	return &schema.Resource{
+		Create:   resourceCAARecordCreate,
+		Read:     resourceCAARecordGet,
+		Update:   resourceCAARecordUpdate,
+		Delete:   resourceCAARecordDelete,
+		Importer: &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			"dns_view": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     defaultDNSView,
+				Description: "DNS view which the zone does exist within.",
+			},
+			"fqdn": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "FQDN for the CAA-record.",
+			},
+			"ca_flag": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     0,
+				Description: "The flags (0-255) of the CAA-record; 128 marks the property as critical.",
+			},
+			"ca_tag": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The property tag of the CAA-record: 'issue', 'issuewild' or 'iodef'.",
+			},
+			"ca_value": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The value of the property, ex. the domain name of a certificate authority.",
+			},
+			"ttl": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     ttlUndef,
+				Description: "TTL value for the CAA-record.",
+			},
+			"comment": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "Description of the CAA-record.",
+			},
+			"ext_attrs": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "Extensible attributes of the CAA-record to be added/updated, as a map in JSON format.",
+			},
+		},
+	}
+}
+
+// Builds a CAA-record object out of the resource's fields,
+// except 'dns_view' which cannot be changed once the record is created.
+func buildCAARecord(d *schema.ResourceData) (*recordCAA, error) {
+	fqdn := d.Get("fqdn").(string)
+	if fqdn == "" {
+		return nil, fmt.Errorf("'fqdn' must not be empty")
+	}
+
+	tempInt := d.Get("ca_flag").(int)
+	if err := ibclient.CheckIntRange("ca_flag", tempInt, 0, 255); err != nil {
+		return nil, err
+	}
+	caFlag := uint32(tempInt)
+
+	caTag := d.Get("ca_tag").(string)
+	if caTag == "" {
+		return nil, fmt.Errorf("'ca_tag' must not be empty")
+	}
+	caValue := d.Get("ca_value").(string)
+	if caValue == "" {
+		return nil, fmt.Errorf("'ca_value' must not be empty")
+	}
+
+	var ttl uint32
+	useTtl := false
+	tempTTL := d.Get("ttl").(int)
+	if tempTTL >= 0 {
+		useTtl = true
+		ttl = uint32(tempTTL)
+	} else if tempTTL != ttlUndef {
+		return nil, fmt.Errorf("TTL value must be 0 or higher")
+	}
+
+	comment := d.Get("comment").(string)
+
+	extAttrJSON := d.Get("ext_attrs").(string)
+	extAttrs := make(map[string]interface{})
+	if extAttrJSON != "" {
+		if err := json.Unmarshal([]byte(extAttrJSON), &extAttrs); err != nil {
+			return nil, fmt.Errorf("cannot process 'ext_attrs' field: %w", err)
+		}
+	}
+
+	return newRecordCAA(recordCAA{
+		Name:    fqdn,
+		CaFlag:  caFlag,
+		CaTag:   caTag,
+		CaValue: caValue,
+		Ttl:     ttl,
+		UseTtl:  useTtl,
+		Comment: comment,
+		Ea:      extAttrs,
+	}), nil
+}
+
+func resourceCAARecordCreate(d *schema.ResourceData, m interface{}) error {
+	rec, err := buildCAARecord(d)
+	if err != nil {
+		return err
+	}
+	rec.View = d.Get("dns_view").(string)
+
+	connector := m.(ibclient.IBConnector)
+	ref, err := connector.CreateObject(rec)
+	if err != nil {
+		return fmt.Errorf("error creating CAA-record: %w", err)
+	}
+	d.SetId(ref)
+
+	return nil
+}
+
+func resourceCAARecordGet(d *schema.ResourceData, m interface{}) error {
+	connector := m.(ibclient.IBConnector)
+
+	obj := newRecordCAA(recordCAA{})
+	if err := connector.GetObject(obj, d.Id(), ibclient.NewQueryParams(false, nil), obj); err != nil {
+		return fmt.Errorf("failed getting CAA-record: %w", err)
+	}
+
+	ttl := int(obj.Ttl)
+	if !obj.UseTtl {
+		ttl = ttlUndef
+	}
+	if err := d.Set("ttl", ttl); err != nil {
+		return err
+	}
+
+	if obj.Ea != nil && len(obj.Ea) > 0 {
+		// TODO: temporary scaffold, need to rework marshalling/unmarshalling of EAs
+		//       (avoiding additional layer of keys ("value" key)
+		eaMap := (map[string]interface{})(obj.Ea)
+		ea, err := json.Marshal(eaMap)
+		if err != nil {
+			return err
+		}
+		if err = d.Set("ext_attrs", string(ea)); err != nil {
+			return err
+		}
+	}
+
+	if err := d.Set("comment", obj.Comment); err != nil {
+		return err
+	}
+	if err := d.Set("dns_view", obj.View); err != nil {
+		return err
+	}
+	if err := d.Set("fqdn", obj.Name); err != nil {
+		return err
+	}
+	if err := d.Set("ca_flag", int(obj.CaFlag)); err != nil {
+		return err
+	}
+	if err := d.Set("ca_tag", obj.CaTag); err != nil {
+		return err
+	}
+	if err := d.Set("ca_value", obj.CaValue); err != nil {
+		return err
+	}
+
+	d.SetId(obj.Ref)
+
+	return nil
+}
+
+func resourceCAARecordUpdate(d *schema.ResourceData, m interface{}) error {
+	var updateSuccessful bool
+	defer func() {
+		// Reverting the state back, in case of a failure,
+		// otherwise Terraform will keep the values, which leaded to the failure,
+		// in the state file.
+		if !updateSuccessful {
+			prevDNSView, _ := d.GetChange("dns_view")
+			prevFQDN, _ := d.GetChange("fqdn")
+			prevCaFlag, _ := d.GetChange("ca_flag")
+			prevCaTag, _ := d.GetChange("ca_tag")
+			prevCaValue, _ := d.GetChange("ca_value")
+			prevTTL, _ := d.GetChange("ttl")
+			prevComment, _ := d.GetChange("comment")
+			prevEa, _ := d.GetChange("ext_attrs")
+
+			_ = d.Set("dns_view", prevDNSView.(string))
+			_ = d.Set("fqdn", prevFQDN.(string))
+			_ = d.Set("ca_flag", prevCaFlag.(int))
+			_ = d.Set("ca_tag", prevCaTag.(string))
+			_ = d.Set("ca_value", prevCaValue.(string))
+			_ = d.Set("ttl", prevTTL.(int))
+			_ = d.Set("comment", prevComment.(string))
+			_ = d.Set("ext_attrs", prevEa.(string))
+		}
+	}()
+
+	if d.HasChange("dns_view") {
+		return fmt.Errorf("changing the value of 'dns_view' field is not allowed")
+	}
+
+	rec, err := buildCAARecord(d)
+	if err != nil {
+		return err
+	}
+
+	connector := m.(ibclient.IBConnector)
+	ref, err := connector.UpdateObject(rec, d.Id())
+	if err != nil {
+		return fmt.Errorf("error updating CAA-record: %w", err)
+	}
+	updateSuccessful = true
+	d.SetId(ref)
+
+	return nil
+}
+
+func resourceCAARecordDelete(d *schema.ResourceData, m interface{}) error {
+	connector := m.(ibclient.IBConnector)
+
+	if _, err := connector.DeleteObject(d.Id()); err != nil {
+		return fmt.Errorf("deletion of CAA-record failed: %w", err)
+	}
+	d.SetId("")
+
+	return nil
+}
@@ -0,0 +1,171 @@
+package infoblox
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+)
+
+func testAccCheckCAARecordDestroy(s *terraform.State) error {
+	meta := testAccProvider.Meta()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "infoblox_caa_record" {
+			continue
+		}
+		connector := meta.(ibclient.IBConnector)
+		rec := newRecordCAA(recordCAA{})
+		err := connector.GetObject(rec, rs.Primary.ID, ibclient.NewQueryParams(false, nil), rec)
+		if err == nil {
+			return fmt.Errorf("CAA-record still exists")
+		}
+	}
+	return nil
+}
+
+func testAccCAARecordCompare(t *testing.T, resPath string, expectedRec *recordCAA) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		res, found := s.RootModule().Resources[resPath]
+		if !found {
+			return fmt.Errorf("not found: %s", resPath)
+		}
+		if res.Primary.ID == "" {
+			return fmt.Errorf("ID is not set")
+		}
+		meta := testAccProvider.Meta()
+		connector := meta.(ibclient.IBConnector)
+
+		rec := newRecordCAA(recordCAA{})
+		if err := connector.GetObject(rec, res.Primary.ID, ibclient.NewQueryParams(false, nil), rec); err != nil {
+			return fmt.Errorf("CAA-record not found: %s", err)
+		}
+
+		if rec.Name != expectedRec.Name {
+			return fmt.Errorf(
+				"'fqdn' does not match: got '%s', expected '%s'",
+				rec.Name, expectedRec.Name)
+		}
+		if rec.View != expectedRec.View {
+			return fmt.Errorf(
+				"'dns_view' does not match: got '%s', expected '%s'",
+				rec.View, expectedRec.View)
+		}
+		if rec.CaFlag != expectedRec.CaFlag {
+			return fmt.Errorf(
+				"'ca_flag' does not match: got '%d', expected '%d'",
+				rec.CaFlag, expectedRec.CaFlag)
+		}
+		if rec.CaTag != expectedRec.CaTag {
+			return fmt.Errorf(
+				"'ca_tag' does not match: got '%s', expected '%s'",
+				rec.CaTag, expectedRec.CaTag)
+		}
+		if rec.CaValue != expectedRec.CaValue {
+			return fmt.Errorf(
+				"'ca_value' does not match: got '%s', expected '%s'",
+				rec.CaValue, expectedRec.CaValue)
+		}
+		if rec.UseTtl != expectedRec.UseTtl {
+			return fmt.Errorf(
+				"TTL usage does not match: got '%t', expected '%t'",
+				rec.UseTtl, expectedRec.UseTtl)
+		}
+		if rec.UseTtl && rec.Ttl != expectedRec.Ttl {
+			return fmt.Errorf(
+				"'ttl' does not match: got '%d', expected '%d'",
+				rec.Ttl, expectedRec.Ttl)
+		}
+		if rec.Comment != expectedRec.Comment {
+			return fmt.Errorf(
+				"'comment' does not match: got '%s', expected '%s'",
+				rec.Comment, expectedRec.Comment)
+		}
+		return validateEAs(rec.Ea, expectedRec.Ea)
+	}
+}
+
+func TestAccResourceCAARecord(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCAARecordDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "infoblox_caa_record" "foo"{
+						fqdn = "test.com"
+						ca_tag = "issue"
+						ca_value = "letsencrypt.org"
+					}`,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCAARecordCompare(t, "infoblox_caa_record.foo", &recordCAA{
+						Name:    "test.com",
+						View:    "default",
+						CaTag:   "issue",
+						CaValue: "letsencrypt.org",
+					}),
+				),
+			},
+			{
+				Config: `
+					resource "infoblox_caa_record" "foo"{
+						fqdn = "test.com"
+						ca_flag = 128
+						ca_tag = "iodef"
+						ca_value = "mailto:security@test.com"
+						ttl = 300
+						comment = "test comment 1"
+						ext_attrs = jsonencode({
+							"Location" = "Los Angeles"
+						})
+					}`,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCAARecordCompare(t, "infoblox_caa_record.foo", &recordCAA{
+						Name:    "test.com",
+						View:    "default",
+						CaFlag:  128,
+						CaTag:   "iodef",
+						CaValue: "mailto:security@test.com",
+						Ttl:     300,
+						UseTtl:  true,
+						Comment: "test comment 1",
+						Ea: ibclient.EA{
+							"Location": "Los Angeles",
+						},
+					}),
+				),
+			},
+			{
+				ResourceName:      "infoblox_caa_record.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+
+			// negative test cases
+			{
+				Config: `
+					resource "infoblox_caa_record" "foo"{
+						fqdn = "test.com"
+						ca_flag = 256
+						ca_tag = "issue"
+						ca_value = "letsencrypt.org"
+					}`,
+				ExpectError: regexp.MustCompile("ca_flag"),
+			},
+			{
+				Config: `
+					resource "infoblox_caa_record" "foo"{
+						dns_view = "nondefault_view"
+						fqdn = "test.com"
+						ca_tag = "issue"
+						ca_value = "letsencrypt.org"
+					}`,
+				ExpectError: regexp.MustCompile("changing the value of 'dns_view' field is not allowed"),
+			},
+		},
+	})
+}
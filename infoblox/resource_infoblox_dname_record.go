@@ -0,0 +1,217 @@
+package infoblox
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+)
+
+func resourceDNAMERecord() *schema.Resource {
+	return &schema.Resource{
+		Create:   resourceDNAMERecordCreate,
+		Read:     resourceDNAMERecordGet,
+		Update:   resourceDNAMERecordUpdate,
+		Delete:   resourceDNAMERecordDelete,
+		Importer: &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			"dns_view": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     defaultDNSView,
+				Description: "DNS view which the zone does exist within.",
+			},
+			"fqdn": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "FQDN for the DNAME-record; the names below it are redirected to 'target'.",
+			},
+			"target": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The domain name which the names below 'fqdn' are redirected to.",
+			},
+			"ttl": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     ttlUndef,
+				Description: "TTL value for the DNAME-record.",
+			},
+			"comment": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "Description of the DNAME-record.",
+			},
+			"ext_attrs": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "Extensible attributes of the DNAME-record to be added/updated, as a map in JSON format.",
+			},
+		},
+	}
+}
+
+// Builds a DNAME-record object out of the resource's fields,
+// except 'dns_view' which cannot be changed once the record is created.
+func buildDNAMERecord(d *schema.ResourceData) (*recordDNAME, error) {
+	fqdn := d.Get("fqdn").(string)
+	if fqdn == "" {
+		return nil, fmt.Errorf("'fqdn' must not be empty")
+	}
+	target := d.Get("target").(string)
+	if target == "" {
+		return nil, fmt.Errorf("'target' must not be empty")
+	}
+
+	var ttl uint32
+	useTtl := false
+	tempTTL := d.Get("ttl").(int)
+	if tempTTL >= 0 {
+		useTtl = true
+		ttl = uint32(tempTTL)
+	} else if tempTTL != ttlUndef {
+		return nil, fmt.Errorf("TTL value must be 0 or higher")
+	}
+
+	comment := d.Get("comment").(string)
+
+	extAttrJSON := d.Get("ext_attrs").(string)
+	extAttrs := make(map[string]interface{})
+	if extAttrJSON != "" {
+		if err := json.Unmarshal([]byte(extAttrJSON), &extAttrs); err != nil {
+			return nil, fmt.Errorf("cannot process 'ext_attrs' field: %w", err)
+		}
+	}
+
+	return newRecordDNAME(recordDNAME{
+		Name:    fqdn,
+		Target:  target,
+		Ttl:     ttl,
+		UseTtl:  useTtl,
+		Comment: comment,
+		Ea:      extAttrs,
+	}), nil
+}
+
+func resourceDNAMERecordCreate(d *schema.ResourceData, m interface{}) error {
+	rec, err := buildDNAMERecord(d)
+	if err != nil {
+		return err
+	}
+	rec.View = d.Get("dns_view").(string)
+
+	connector := m.(ibclient.IBConnector)
+	ref, err := connector.CreateObject(rec)
+	if err != nil {
+		return fmt.Errorf("error creating DNAME-record: %w", err)
+	}
+	d.SetId(ref)
+
+	return nil
+}
+
+func resourceDNAMERecordGet(d *schema.ResourceData, m interface{}) error {
+	connector := m.(ibclient.IBConnector)
+
+	obj := newRecordDNAME(recordDNAME{})
+	if err := connector.GetObject(obj, d.Id(), ibclient.NewQueryParams(false, nil), obj); err != nil {
+		return fmt.Errorf("failed getting DNAME-record: %w", err)
+	}
+
+	ttl := int(obj.Ttl)
+	if !obj.UseTtl {
+		ttl = ttlUndef
+	}
+	if err := d.Set("ttl", ttl); err != nil {
+		return err
+	}
+
+	if obj.Ea != nil && len(obj.Ea) > 0 {
+		// TODO: temporary scaffold, need to rework marshalling/unmarshalling of EAs
+		//       (avoiding additional layer of keys ("value" key)
+		eaMap := (map[string]interface{})(obj.Ea)
+		ea, err := json.Marshal(eaMap)
+		if err != nil {
+			return err
+		}
+		if err = d.Set("ext_attrs", string(ea)); err != nil {
+			return err
+		}
+	}
+
+	if err := d.Set("comment", obj.Comment); err != nil {
+		return err
+	}
+	if err := d.Set("dns_view", obj.View); err != nil {
+		return err
+	}
+	if err := d.Set("fqdn", obj.Name); err != nil {
+		return err
+	}
+	if err := d.Set("target", obj.Target); err != nil {
+		return err
+	}
+
+	d.SetId(obj.Ref)
+
+	return nil
+}
+
+func resourceDNAMERecordUpdate(d *schema.ResourceData, m interface{}) error {
+	var updateSuccessful bool
+	defer func() {
+		// Reverting the state back, in case of a failure,
+		// otherwise Terraform will keep the values, which leaded to the failure,
+		// in the state file.
+		if !updateSuccessful {
+			prevDNSView, _ := d.GetChange("dns_view")
+			prevFQDN, _ := d.GetChange("fqdn")
+			prevTarget, _ := d.GetChange("target")
+			prevTTL, _ := d.GetChange("ttl")
+			prevComment, _ := d.GetChange("comment")
+			prevEa, _ := d.GetChange("ext_attrs")
+
+			_ = d.Set("dns_view", prevDNSView.(string))
+			_ = d.Set("fqdn", prevFQDN.(string))
+			_ = d.Set("target", prevTarget.(string))
+			_ = d.Set("ttl", prevTTL.(int))
+			_ = d.Set("comment", prevComment.(string))
+			_ = d.Set("ext_attrs", prevEa.(string))
+		}
+	}()
+
+	if d.HasChange("dns_view") {
+		return fmt.Errorf("changing the value of 'dns_view' field is not allowed")
+	}
+
+	rec, err := buildDNAMERecord(d)
+	if err != nil {
+		return err
+	}
+
+	connector := m.(ibclient.IBConnector)
+	ref, err := connector.UpdateObject(rec, d.Id())
+	if err != nil {
+		return fmt.Errorf("error updating DNAME-record: %w", err)
+	}
+	updateSuccessful = true
+	d.SetId(ref)
+
+	return nil
+}
+
+func resourceDNAMERecordDelete(d *schema.ResourceData, m interface{}) error {
+	connector := m.(ibclient.IBConnector)
+
+	if _, err := connector.DeleteObject(d.Id()); err != nil {
+		return fmt.Errorf("deletion of DNAME-record failed: %w", err)
+	}
+	d.SetId("")
+
+	return nil
+}
@@ -0,0 +1,144 @@
+package infoblox
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+)
+
+func testAccCheckDNAMERecordDestroy(s *terraform.State) error {
+	meta := testAccProvider.Meta()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "infoblox_dname_record" {
+			continue
+		}
+		connector := meta.(ibclient.IBConnector)
+		rec := newRecordDNAME(recordDNAME{})
+		err := connector.GetObject(rec, rs.Primary.ID, ibclient.NewQueryParams(false, nil), rec)
+		if err == nil {
+			return fmt.Errorf("DNAME-record still exists")
+		}
+	}
+	return nil
+}
+
+func testAccDNAMERecordCompare(t *testing.T, resPath string, expectedRec *recordDNAME) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		res, found := s.RootModule().Resources[resPath]
+		if !found {
+			return fmt.Errorf("not found: %s", resPath)
+		}
+		if res.Primary.ID == "" {
+			return fmt.Errorf("ID is not set")
+		}
+		meta := testAccProvider.Meta()
+		connector := meta.(ibclient.IBConnector)
+
+		rec := newRecordDNAME(recordDNAME{})
+		if err := connector.GetObject(rec, res.Primary.ID, ibclient.NewQueryParams(false, nil), rec); err != nil {
+			return fmt.Errorf("DNAME-record not found: %s", err)
+		}
+
+		if rec.Name != expectedRec.Name {
+			return fmt.Errorf(
+				"'fqdn' does not match: got '%s', expected '%s'",
+				rec.Name, expectedRec.Name)
+		}
+		if rec.View != expectedRec.View {
+			return fmt.Errorf(
+				"'dns_view' does not match: got '%s', expected '%s'",
+				rec.View, expectedRec.View)
+		}
+		if rec.Target != expectedRec.Target {
+			return fmt.Errorf(
+				"'target' does not match: got '%s', expected '%s'",
+				rec.Target, expectedRec.Target)
+		}
+		if rec.UseTtl != expectedRec.UseTtl {
+			return fmt.Errorf(
+				"TTL usage does not match: got '%t', expected '%t'",
+				rec.UseTtl, expectedRec.UseTtl)
+		}
+		if rec.UseTtl && rec.Ttl != expectedRec.Ttl {
+			return fmt.Errorf(
+				"'ttl' does not match: got '%d', expected '%d'",
+				rec.Ttl, expectedRec.Ttl)
+		}
+		if rec.Comment != expectedRec.Comment {
+			return fmt.Errorf(
+				"'comment' does not match: got '%s', expected '%s'",
+				rec.Comment, expectedRec.Comment)
+		}
+		return validateEAs(rec.Ea, expectedRec.Ea)
+	}
+}
+
+func TestAccResourceDNAMERecord(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDNAMERecordDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "infoblox_dname_record" "foo"{
+						fqdn = "old-branch.test.com"
+						target = "branch.test.com"
+					}`,
+				Check: resource.ComposeTestCheckFunc(
+					testAccDNAMERecordCompare(t, "infoblox_dname_record.foo", &recordDNAME{
+						Name:   "old-branch.test.com",
+						View:   "default",
+						Target: "branch.test.com",
+					}),
+				),
+			},
+			{
+				Config: `
+					resource "infoblox_dname_record" "foo"{
+						fqdn = "old-branch.test.com"
+						target = "branch2.test.com"
+						ttl = 300
+						comment = "test comment 1"
+						ext_attrs = jsonencode({
+							"Location" = "Los Angeles"
+						})
+					}`,
+				Check: resource.ComposeTestCheckFunc(
+					testAccDNAMERecordCompare(t, "infoblox_dname_record.foo", &recordDNAME{
+						Name:    "old-branch.test.com",
+						View:    "default",
+						Target:  "branch2.test.com",
+						Ttl:     300,
+						UseTtl:  true,
+						Comment: "test comment 1",
+						Ea: ibclient.EA{
+							"Location": "Los Angeles",
+						},
+					}),
+				),
+			},
+			{
+				ResourceName:      "infoblox_dname_record.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+
+			// negative test cases
+			{
+				Config: `
+					resource "infoblox_dname_record" "foo"{
+						dns_view = "nondefault_view"
+						fqdn = "old-branch.test.com"
+						target = "branch2.test.com"
+					}`,
+				ExpectError: regexp.MustCompile("changing the value of 'dns_view' field is not allowed"),
+			},
+		},
+	})
+}
@@ -0,0 +1,295 @@
+package infoblox
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+)
+
+func resourceDNSView() *schema.Resource {
+	return &schema.Resource{
+		Create:   resourceDNSViewCreate,
+		Read:     resourceDNSViewRead,
+		Update:   resourceDNSViewUpdate,
+		Delete:   resourceDNSViewDelete,
+		Importer: &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the DNS view.",
+			},
+			"network_view": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     defaultNetView,
+				Description: "The network view which the DNS view is associated with.",
+			},
+			"match_clients": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "The list of clients (source addresses of queries) which the DNS view serves.",
+				Elem:        aclItemSchemaElem(),
+			},
+			"match_destinations": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "The list of destination addresses of queries which the DNS view serves.",
+				Elem:        aclItemSchemaElem(),
+			},
+			"recursion": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "If set, recursive queries are allowed for the DNS view.",
+			},
+			"forwarders": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "The list of IP addresses of name servers which the off-site queries are forwarded to.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"forward_only": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "If set, the off-site queries are sent to the forwarders only.",
+			},
+			"comment": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "Description of the DNS view.",
+			},
+			"ext_attrs": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "Extensible attributes of the DNS view to be added/updated, as a map in JSON format.",
+			},
+		},
+	}
+}
+
+func convertInterfaceToStrings(items []interface{}) []string {
+	res := make([]string, 0, len(items))
+	for _, item := range items {
+		res = append(res, item.(string))
+	}
+
+	return res
+}
+
+func resourceDNSViewCreate(d *schema.ResourceData, m interface{}) error {
+	name := d.Get("name").(string)
+	if name == "" {
+		return fmt.Errorf("'name' must not be empty")
+	}
+	networkView := d.Get("network_view").(string)
+
+	connector := m.(ibclient.IBConnector)
+
+	matchClients, err := convertInterfaceToACLItems(
+		connector, "match_clients", d.Get("match_clients").([]interface{}))
+	if err != nil {
+		return err
+	}
+	matchDestinations, err := convertInterfaceToACLItems(
+		connector, "match_destinations", d.Get("match_destinations").([]interface{}))
+	if err != nil {
+		return err
+	}
+
+	recursion := d.Get("recursion").(bool)
+	forwarders := convertInterfaceToStrings(d.Get("forwarders").([]interface{}))
+	forwardOnly := d.Get("forward_only").(bool)
+	comment := d.Get("comment").(string)
+
+	extAttrJSON := d.Get("ext_attrs").(string)
+	extAttrs := make(map[string]interface{})
+	if extAttrJSON != "" {
+		if err := json.Unmarshal([]byte(extAttrJSON), &extAttrs); err != nil {
+			return fmt.Errorf("cannot process 'ext_attrs' field: %w", err)
+		}
+	}
+
+	view := newDNSView(dnsView{
+		Name:              name,
+		NetworkView:       networkView,
+		MatchClients:      matchClients,
+		MatchDestinations: matchDestinations,
+		Recursion:         recursion,
+		Forwarders:        forwarders,
+		UseForwarders:     len(forwarders) > 0,
+		ForwardOnly:       forwardOnly,
+		Comment:           comment,
+		Ea:                extAttrs,
+	})
+
+	ref, err := connector.CreateObject(view)
+	if err != nil {
+		return fmt.Errorf("creation of the DNS view '%s' failed: %w", name, err)
+	}
+	d.SetId(ref)
+
+	return nil
+}
+
+func resourceDNSViewRead(d *schema.ResourceData, m interface{}) error {
+	connector := m.(ibclient.IBConnector)
+
+	obj := newDNSView(dnsView{})
+	if err := connector.GetObject(obj, d.Id(), ibclient.NewQueryParams(false, nil), obj); err != nil {
+		return fmt.Errorf("failed getting the DNS view: %w", err)
+	}
+
+	if obj.Ea != nil && len(obj.Ea) > 0 {
+		// TODO: temporary scaffold, need to rework marshalling/unmarshalling of EAs
+		//       (avoiding additional layer of keys ("value" key)
+		eaMap := (map[string]interface{})(obj.Ea)
+		ea, err := json.Marshal(eaMap)
+		if err != nil {
+			return err
+		}
+		if err = d.Set("ext_attrs", string(ea)); err != nil {
+			return err
+		}
+	}
+
+	forwarders := obj.Forwarders
+	if !obj.UseForwarders {
+		forwarders = nil
+	}
+
+	if err := d.Set("name", obj.Name); err != nil {
+		return err
+	}
+	if err := d.Set("network_view", obj.NetworkView); err != nil {
+		return err
+	}
+	if err := d.Set("match_clients", convertACLItemsToInterface(obj.MatchClients)); err != nil {
+		return err
+	}
+	if err := d.Set("match_destinations", convertACLItemsToInterface(obj.MatchDestinations)); err != nil {
+		return err
+	}
+	if err := d.Set("recursion", obj.Recursion); err != nil {
+		return err
+	}
+	if err := d.Set("forwarders", forwarders); err != nil {
+		return err
+	}
+	if err := d.Set("forward_only", obj.ForwardOnly); err != nil {
+		return err
+	}
+	if err := d.Set("comment", obj.Comment); err != nil {
+		return err
+	}
+
+	d.SetId(obj.Ref)
+
+	return nil
+}
+
+func resourceDNSViewUpdate(d *schema.ResourceData, m interface{}) error {
+	var updateSuccessful bool
+	defer func() {
+		// Reverting the state back, in case of a failure,
+		// otherwise Terraform will keep the values, which leaded to the failure,
+		// in the state file.
+		if !updateSuccessful {
+			prevName, _ := d.GetChange("name")
+			prevNetView, _ := d.GetChange("network_view")
+			prevMatchClients, _ := d.GetChange("match_clients")
+			prevMatchDestinations, _ := d.GetChange("match_destinations")
+			prevRecursion, _ := d.GetChange("recursion")
+			prevForwarders, _ := d.GetChange("forwarders")
+			prevForwardOnly, _ := d.GetChange("forward_only")
+			prevComment, _ := d.GetChange("comment")
+			prevEa, _ := d.GetChange("ext_attrs")
+
+			_ = d.Set("name", prevName.(string))
+			_ = d.Set("network_view", prevNetView.(string))
+			_ = d.Set("match_clients", prevMatchClients.([]interface{}))
+			_ = d.Set("match_destinations", prevMatchDestinations.([]interface{}))
+			_ = d.Set("recursion", prevRecursion.(bool))
+			_ = d.Set("forwarders", prevForwarders.([]interface{}))
+			_ = d.Set("forward_only", prevForwardOnly.(bool))
+			_ = d.Set("comment", prevComment.(string))
+			_ = d.Set("ext_attrs", prevEa.(string))
+		}
+	}()
+
+	if d.HasChange("network_view") {
+		return fmt.Errorf("changing the value of 'network_view' field is not allowed")
+	}
+
+	name := d.Get("name").(string)
+	if name == "" {
+		return fmt.Errorf("'name' must not be empty")
+	}
+
+	connector := m.(ibclient.IBConnector)
+
+	matchClients, err := convertInterfaceToACLItems(
+		connector, "match_clients", d.Get("match_clients").([]interface{}))
+	if err != nil {
+		return err
+	}
+	matchDestinations, err := convertInterfaceToACLItems(
+		connector, "match_destinations", d.Get("match_destinations").([]interface{}))
+	if err != nil {
+		return err
+	}
+
+	recursion := d.Get("recursion").(bool)
+	forwarders := convertInterfaceToStrings(d.Get("forwarders").([]interface{}))
+	forwardOnly := d.Get("forward_only").(bool)
+	comment := d.Get("comment").(string)
+
+	extAttrJSON := d.Get("ext_attrs").(string)
+	extAttrs := make(map[string]interface{})
+	if extAttrJSON != "" {
+		if err := json.Unmarshal([]byte(extAttrJSON), &extAttrs); err != nil {
+			return fmt.Errorf("cannot process 'ext_attrs' field: %w", err)
+		}
+	}
+
+	view := newDNSView(dnsView{
+		Name:              name,
+		MatchClients:      matchClients,
+		MatchDestinations: matchDestinations,
+		Recursion:         recursion,
+		Forwarders:        forwarders,
+		UseForwarders:     len(forwarders) > 0,
+		ForwardOnly:       forwardOnly,
+		Comment:           comment,
+		Ea:                extAttrs,
+	})
+
+	ref, err := connector.UpdateObject(view, d.Id())
+	if err != nil {
+		return fmt.Errorf("error updating the DNS view: %w", err)
+	}
+	updateSuccessful = true
+	d.SetId(ref)
+
+	return nil
+}
+
+func resourceDNSViewDelete(d *schema.ResourceData, m interface{}) error {
+	connector := m.(ibclient.IBConnector)
+
+	if _, err := connector.DeleteObject(d.Id()); err != nil {
+		return fmt.Errorf("deletion of the DNS view failed: %w", err)
+	}
+	d.SetId("")
+
+	return nil
+}
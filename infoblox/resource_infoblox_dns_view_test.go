@@ -0,0 +1,178 @@
+package infoblox
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+)
+
+func testAccCheckDNSViewDestroy(s *terraform.State) error {
+	meta := testAccProvider.Meta()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "infoblox_dns_view" {
+			continue
+		}
+		connector := meta.(ibclient.IBConnector)
+		view := newDNSView(dnsView{})
+		err := connector.GetObject(view, rs.Primary.ID, ibclient.NewQueryParams(false, nil), view)
+		if err == nil {
+			return fmt.Errorf("DNS view still exists")
+		}
+	}
+	return nil
+}
+
+func testAccDNSViewCompare(t *testing.T, resPath string, expectedView *dnsView) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		res, found := s.RootModule().Resources[resPath]
+		if !found {
+			return fmt.Errorf("not found: %s", resPath)
+		}
+		if res.Primary.ID == "" {
+			return fmt.Errorf("ID is not set")
+		}
+		meta := testAccProvider.Meta()
+		connector := meta.(ibclient.IBConnector)
+
+		view := newDNSView(dnsView{})
+		if err := connector.GetObject(view, res.Primary.ID, ibclient.NewQueryParams(false, nil), view); err != nil {
+			return fmt.Errorf("DNS view not found: %s", err)
+		}
+
+		if view.Name != expectedView.Name {
+			return fmt.Errorf(
+				"'name' does not match: got '%s', expected '%s'",
+				view.Name, expectedView.Name)
+		}
+		if view.NetworkView != expectedView.NetworkView {
+			return fmt.Errorf(
+				"'network_view' does not match: got '%s', expected '%s'",
+				view.NetworkView, expectedView.NetworkView)
+		}
+		if len(view.MatchClients) != len(expectedView.MatchClients) {
+			return fmt.Errorf(
+				"the number of items in 'match_clients' does not match: got '%d', expected '%d'",
+				len(view.MatchClients), len(expectedView.MatchClients))
+		}
+		for i, item := range expectedView.MatchClients {
+			if *view.MatchClients[i].AddressAC != *item.AddressAC {
+				return fmt.Errorf(
+					"item of 'match_clients' does not match: got '%+v', expected '%+v'",
+					*view.MatchClients[i].AddressAC, *item.AddressAC)
+			}
+		}
+		if view.Recursion != expectedView.Recursion {
+			return fmt.Errorf(
+				"'recursion' does not match: got '%t', expected '%t'",
+				view.Recursion, expectedView.Recursion)
+		}
+		if len(view.Forwarders) != len(expectedView.Forwarders) {
+			return fmt.Errorf(
+				"the number of forwarders does not match: got '%d', expected '%d'",
+				len(view.Forwarders), len(expectedView.Forwarders))
+		}
+		for i, fwd := range expectedView.Forwarders {
+			if view.Forwarders[i] != fwd {
+				return fmt.Errorf(
+					"forwarder does not match: got '%s', expected '%s'",
+					view.Forwarders[i], fwd)
+			}
+		}
+		if view.ForwardOnly != expectedView.ForwardOnly {
+			return fmt.Errorf(
+				"'forward_only' does not match: got '%t', expected '%t'",
+				view.ForwardOnly, expectedView.ForwardOnly)
+		}
+		if view.Comment != expectedView.Comment {
+			return fmt.Errorf(
+				"'comment' does not match: got '%s', expected '%s'",
+				view.Comment, expectedView.Comment)
+		}
+		return validateEAs(view.Ea, expectedView.Ea)
+	}
+}
+
+func TestAccResourceDNSView(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDNSViewDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "infoblox_dns_view" "foo"{
+						name = "internal_view1"
+					}`,
+				Check: resource.ComposeTestCheckFunc(
+					testAccDNSViewCompare(t, "infoblox_dns_view.foo", &dnsView{
+						Name:        "internal_view1",
+						NetworkView: "default",
+					}),
+				),
+			},
+			{
+				Config: `
+					resource "infoblox_dns_view" "foo"{
+						name = "internal_view2"
+						match_clients {
+							address = "10.0.0.0/8"
+						}
+						match_clients {
+							address = "10.1.0.0/16"
+							permission = "DENY"
+						}
+						recursion = true
+						forwarders = ["10.0.0.1", "10.0.0.2"]
+						forward_only = true
+						comment = "test comment 1"
+						ext_attrs = jsonencode({
+							"Location" = "Los Angeles"
+						})
+					}`,
+				Check: resource.ComposeTestCheckFunc(
+					testAccDNSViewCompare(t, "infoblox_dns_view.foo", &dnsView{
+						Name:        "internal_view2",
+						NetworkView: "default",
+						MatchClients: []aclItem{
+							{AddressAC: &addressAC{Address: "10.0.0.0/8", Permission: "ALLOW"}},
+							{AddressAC: &addressAC{Address: "10.1.0.0/16", Permission: "DENY"}},
+						},
+						Recursion:   true,
+						Forwarders:  []string{"10.0.0.1", "10.0.0.2"},
+						ForwardOnly: true,
+						Comment:     "test comment 1",
+						Ea: ibclient.EA{
+							"Location": "Los Angeles",
+						},
+					}),
+				),
+			},
+
+			// negative test cases
+			{
+				Config: `
+					resource "infoblox_dns_view" "foo"{
+						name = "internal_view2"
+						network_view = "nondefault_netview"
+					}`,
+				ExpectError: regexp.MustCompile("changing the value of 'network_view' field is not allowed"),
+			},
+			{
+				Config: `
+					resource "infoblox_dns_view" "foo"{
+						name = "internal_view2"
+						match_clients {
+							address = "10.0.0.0/8"
+							named_acl = "acl1"
+						}
+					}`,
+				ExpectError: regexp.MustCompile("exactly one of 'address', 'tsig_key_name' and 'named_acl' must be defined"),
+			},
+		},
+	})
+}
@@ -0,0 +1,694 @@
+package infoblox
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+)
+
+// fixedAddressKind describes the differences between IPv4 and IPv6 fixed addresses.
+type fixedAddressKind struct {
+	isIPv6      bool
+	description string
+}
+
+var (
+	fixedAddressKindIPv4 = fixedAddressKind{
+		isIPv6:      false,
+		description: "IPv4 fixed address",
+	}
+	fixedAddressKindIPv6 = fixedAddressKind{
+		isIPv6:      true,
+		description: "IPv6 fixed address",
+	}
+)
+
+func (kind fixedAddressKind) objectType() string {
+	if kind.isIPv6 {
+		return "ipv6fixedaddress"
+	}
+	return "fixedaddress"
+}
+
+func (kind fixedAddressKind) ipVersion() string {
+	if kind.isIPv6 {
+		return "IPv6"
+	}
+	return "IPv4"
+}
+
+// The fields which identify the client, for the values of 'match_client'.
+// 'RESERVED' value means that the address is not assigned to any client.
+var fixedAddressMatchClientFields = map[string]string{
+	"MAC_ADDRESS": "mac",
+	"CLIENT_ID":   "client_identifier",
+	"CIRCUIT_ID":  "agent_circuit_id",
+	"REMOTE_ID":   "agent_remote_id",
+	"RESERVED":    "",
+}
+
+// The WAPI names of the client matching fields, besides 'mac', which are cleared once removed.
+var fixedAddressClearableFields = map[string]string{
+	"client_identifier": "dhcp_client_identifier",
+	"agent_circuit_id":  "agent_circuit_id",
+	"agent_remote_id":   "agent_remote_id",
+}
+
+const (
+	fixedAddressTypeAddress = "ADDRESS"
+	fixedAddressTypePrefix  = "PREFIX"
+	fixedAddressTypeBoth    = "BOTH"
+)
+
+// The options which have a meaningful 'use_option' flag: they are inherited
+// from the upper level unless the flag is set.
+var dhcpSpecialOptions = map[string]bool{
+	"routers":                  true,
+	"router-templates":         true,
+	"domain-name-servers":      true,
+	"domain-name":              true,
+	"broadcast-address":        true,
+	"broadcast-address-offset": true,
+	"dhcp-lease-time":          true,
+	"dhcp6.name-servers":       true,
+}
+
+func dhcpOptionSchemaElem() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "The name of the DHCP option, ex. 'routers'; either 'name' or 'num' must be defined.",
+			},
+			"num": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     0,
+				Description: "The code of the DHCP option; either 'name' or 'num' must be defined.",
+			},
+			"value": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The value of the DHCP option.",
+			},
+			"vendor_class": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "The name of the vendor class which the option belongs to; 'DHCP' ('DHCPv6') by default.",
+			},
+			"use_option": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "The flag which defines if a special option, ex. 'routers', is used instead of the inherited one.",
+			},
+		},
+	}
+}
+
+func convertInterfaceToDHCPOptions(fieldName string, items []interface{}) ([]dhcpOption, error) {
+	res := make([]dhcpOption, 0, len(items))
+	for _, item := range items {
+		if item == nil {
+			return nil, fmt.Errorf("either 'name' or 'num' must be defined for every item of '%s'", fieldName)
+		}
+		m := item.(map[string]interface{})
+		opt := dhcpOption{
+			Name:        m["name"].(string),
+			Value:       m["value"].(string),
+			VendorClass: m["vendor_class"].(string),
+			UseOption:   m["use_option"].(bool),
+		}
+		num := m["num"].(int)
+		if num < 0 {
+			return nil, fmt.Errorf("'num' of '%s' must not be negative", fieldName)
+		}
+		opt.Num = uint32(num)
+		if opt.Name == "" && opt.Num == 0 {
+			return nil, fmt.Errorf("either 'name' or 'num' must be defined for every item of '%s'", fieldName)
+		}
+		res = append(res, opt)
+	}
+
+	return res, nil
+}
+
+func convertDHCPOptionsToInterface(opts []dhcpOption) []map[string]interface{} {
+	res := make([]map[string]interface{}, 0, len(opts))
+	for _, opt := range opts {
+		res = append(res, map[string]interface{}{
+			"name":         opt.Name,
+			"num":          int(opt.Num),
+			"value":        opt.Value,
+			"vendor_class": opt.VendorClass,
+			"use_option":   opt.UseOption,
+		})
+	}
+
+	return res
+}
+
+// Brings the options of the state in accordance with the actual options of an object.
+// The options keep the form they are defined in (by name or by code);
+// the options which are added on NIOS side are appended, except the special ones
+// which are not used (NIOS returns them with the inherited values).
+func mergeDHCPOptions(stateOpts, actual []dhcpOption) []dhcpOption {
+	matched := make(map[int]bool)
+	res := make([]dhcpOption, 0, len(actual))
+	for _, stateOpt := range stateOpts {
+		for j, act := range actual {
+			if matched[j] {
+				continue
+			}
+			if stateOpt.VendorClass != "" && stateOpt.VendorClass != act.VendorClass {
+				continue
+			}
+			if stateOpt.Name != "" && stateOpt.Name != act.Name {
+				continue
+			}
+			if stateOpt.Num != 0 && stateOpt.Num != act.Num {
+				continue
+			}
+			matched[j] = true
+
+			merged := act
+			if stateOpt.Name == "" {
+				merged.Name = ""
+			}
+			if stateOpt.Num == 0 {
+				merged.Num = 0
+			}
+			if stateOpt.VendorClass == "" {
+				merged.VendorClass = ""
+			}
+			if !dhcpSpecialOptions[act.Name] {
+				merged.UseOption = stateOpt.UseOption
+			}
+			res = append(res, merged)
+			break
+		}
+	}
+	for j, act := range actual {
+		if matched[j] || (dhcpSpecialOptions[act.Name] && !act.UseOption) {
+			continue
+		}
+		res = append(res, act)
+	}
+
+	return res
+}
+
+func resourceFixedAddress(kind fixedAddressKind) *schema.Resource {
+	ipVersion := kind.ipVersion()
+	res := &schema.Resource{
+		Importer: &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			"network_view": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     defaultNetView,
+				Description: "Network view which the fixed address belongs to.",
+			},
+			"ip_addr": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: fmt.Sprintf("The %s address, for static allocation.", ipVersion),
+			},
+			"cidr": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "",
+				Description: fmt.Sprintf(
+					"The %s network (in CIDR format) to allocate the next available address from.", ipVersion),
+			},
+			"allocated_ip_addr": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The address which is allocated: either 'ip_addr' or the one allocated from 'cidr'.",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "The name of the fixed address.",
+			},
+			"options": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        dhcpOptionSchemaElem(),
+				Description: "DHCP options of the fixed address.",
+			},
+			"comment": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "A description of the fixed address.",
+			},
+			"ext_attrs": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "Extensible attributes of the fixed address to be added/updated, as a map in JSON format.",
+			},
+		},
+	}
+
+	if kind.isIPv6 {
+		res.Schema["duid"] = &schema.Schema{
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "The DUID of the client.",
+		}
+		res.Schema["address_type"] = &schema.Schema{
+			Type:        schema.TypeString,
+			Optional:    true,
+			Default:     fixedAddressTypeAddress,
+			Description: "The type of the fixed address: 'ADDRESS', 'PREFIX' or 'BOTH'.",
+		}
+		res.Schema["prefix"] = &schema.Schema{
+			Type:        schema.TypeString,
+			Optional:    true,
+			Default:     "",
+			Description: "The IPv6 prefix (in CIDR format) which is delegated to the client, for 'PREFIX' and 'BOTH' address types.",
+		}
+	} else {
+		res.Schema["match_client"] = &schema.Schema{
+			Type:        schema.TypeString,
+			Optional:    true,
+			Default:     "MAC_ADDRESS",
+			Description: "The way the client is identified: 'MAC_ADDRESS', 'CLIENT_ID', 'CIRCUIT_ID', 'REMOTE_ID' or 'RESERVED'.",
+		}
+		res.Schema["mac"] = &schema.Schema{
+			Type:        schema.TypeString,
+			Optional:    true,
+			Default:     "",
+			Description: "The MAC address of the client, required for 'MAC_ADDRESS' matching.",
+		}
+		res.Schema["client_identifier"] = &schema.Schema{
+			Type:        schema.TypeString,
+			Optional:    true,
+			Default:     "",
+			Description: "The DHCP client identifier, required for 'CLIENT_ID' matching.",
+		}
+		res.Schema["agent_circuit_id"] = &schema.Schema{
+			Type:        schema.TypeString,
+			Optional:    true,
+			Default:     "",
+			Description: "The circuit ID of the relay agent, required for 'CIRCUIT_ID' matching.",
+		}
+		res.Schema["agent_remote_id"] = &schema.Schema{
+			Type:        schema.TypeString,
+			Optional:    true,
+			Default:     "",
+			Description: "The remote ID of the relay agent, required for 'REMOTE_ID' matching.",
+		}
+	}
+
+	return res
+}
+
+// Defines the value of 'ipv4addr' ('ipv6addr') WAPI field: either the static address,
+// the address which was previously allocated from the same network, or the function
+// which allocates the next available address.
+func fixedAddressIPValue(
+	kind fixedAddressKind, netView, ipAddr, cidr, prevCidr, prevAllocated string) (string, error) {
+
+	if (ipAddr == "") == (cidr == "") {
+		return "", fmt.Errorf("exactly one of 'ip_addr' and 'cidr' must be defined")
+	}
+	if ipAddr != "" {
+		if !isIPAddrOfVersion(net.ParseIP(ipAddr), kind.isIPv6) {
+			return "", fmt.Errorf("'%s' is not a valid value for 'ip_addr'", ipAddr)
+		}
+		return ipAddr, nil
+	}
+
+	ip, _, err := net.ParseCIDR(cidr)
+	if err != nil || !isIPAddrOfVersion(ip, kind.isIPv6) {
+		return "", fmt.Errorf("'%s' is not a valid value for 'cidr'", cidr)
+	}
+	if cidr == prevCidr && prevAllocated != "" {
+		return prevAllocated, nil
+	}
+
+	return fmt.Sprintf("func:nextavailableip:%s,%s", cidr, netView), nil
+}
+
+// Builds a fixed address object out of the resource's fields, except 'network_view'
+// which cannot be changed once the fixed address is created.
+func buildFixedAddress(
+	d *schema.ResourceData, kind fixedAddressKind, prevCidr, prevAllocated string) (*fixedAddress, error) {
+
+	netView := d.Get("network_view").(string)
+	ipAddr := d.Get("ip_addr").(string)
+	cidr := d.Get("cidr").(string)
+
+	options, err := convertInterfaceToDHCPOptions("options", d.Get("options").([]interface{}))
+	if err != nil {
+		return nil, err
+	}
+
+	extAttrJSON := d.Get("ext_attrs").(string)
+	extAttrs := make(map[string]interface{})
+	if extAttrJSON != "" {
+		if err := json.Unmarshal([]byte(extAttrJSON), &extAttrs); err != nil {
+			return nil, fmt.Errorf("cannot process 'ext_attrs' field: %w", err)
+		}
+	}
+
+	fa := fixedAddress{
+		Name:       d.Get("name").(string),
+		Comment:    d.Get("comment").(string),
+		Options:    options,
+		UseOptions: len(options) > 0,
+		Ea:         extAttrs,
+	}
+
+	if !kind.isIPv6 {
+		if fa.Ipv4Addr, err = fixedAddressIPValue(kind, netView, ipAddr, cidr, prevCidr, prevAllocated); err != nil {
+			return nil, err
+		}
+
+		matchClient := d.Get("match_client").(string)
+		clientField, found := fixedAddressMatchClientFields[matchClient]
+		if !found {
+			return nil, fmt.Errorf(
+				"'%s' is not a valid value for 'match_client', the valid values are: "+
+					"MAC_ADDRESS, CLIENT_ID, CIRCUIT_ID, REMOTE_ID, RESERVED", matchClient)
+		}
+		if clientField != "" && d.Get(clientField).(string) == "" {
+			return nil, fmt.Errorf("'%s' must be defined if 'match_client' is '%s'", clientField, matchClient)
+		}
+		fa.MatchClient = matchClient
+		fa.Mac = d.Get("mac").(string)
+		if matchClient == "RESERVED" && fa.Mac == "" {
+			fa.Mac = ibclient.MACADDR_ZERO
+		}
+		fa.ClientIdentifier = d.Get("client_identifier").(string)
+		fa.AgentCircuitId = d.Get("agent_circuit_id").(string)
+		fa.AgentRemoteId = d.Get("agent_remote_id").(string)
+
+		return newFixedAddress(fa, false), nil
+	}
+
+	fa.Duid = d.Get("duid").(string)
+	if fa.Duid == "" {
+		return nil, fmt.Errorf("'duid' must not be empty")
+	}
+
+	addrType := d.Get("address_type").(string)
+	prefix := d.Get("prefix").(string)
+	switch addrType {
+	case fixedAddressTypeAddress, fixedAddressTypeBoth, fixedAddressTypePrefix:
+	default:
+		return nil, fmt.Errorf(
+			"'%s' is not a valid value for 'address_type', the valid values are: ADDRESS, PREFIX, BOTH", addrType)
+	}
+	fa.AddressType = addrType
+
+	if addrType == fixedAddressTypePrefix {
+		if ipAddr != "" || cidr != "" {
+			return nil, fmt.Errorf("neither 'ip_addr' nor 'cidr' may be defined if 'address_type' is 'PREFIX'")
+		}
+	} else {
+		if fa.Ipv6Addr, err = fixedAddressIPValue(kind, netView, ipAddr, cidr, prevCidr, prevAllocated); err != nil {
+			return nil, err
+		}
+	}
+
+	if addrType == fixedAddressTypeAddress {
+		if prefix != "" {
+			return nil, fmt.Errorf("'prefix' may be defined only if 'address_type' is 'PREFIX' or 'BOTH'")
+		}
+	} else {
+		ip, ipNet, err := net.ParseCIDR(prefix)
+		if err != nil || !isIPAddrOfVersion(ip, kind.isIPv6) {
+			return nil, fmt.Errorf(
+				"'prefix' must be a valid IPv6 prefix in CIDR format if 'address_type' is '%s'", addrType)
+		}
+		bits, _ := ipNet.Mask.Size()
+		fa.Ipv6Prefix = ipNet.IP.String()
+		fa.Ipv6PrefixBits = uint32(bits)
+	}
+
+	return newFixedAddress(fa, true), nil
+}
+
+func resourceFixedAddressCreate(d *schema.ResourceData, m interface{}, kind fixedAddressKind) error {
+	fa, err := buildFixedAddress(d, kind, "", "")
+	if err != nil {
+		return err
+	}
+	fa.NetworkView = d.Get("network_view").(string)
+
+	connector := m.(ibclient.IBConnector)
+	ref, err := connector.CreateObject(fa)
+	if err != nil {
+		return fmt.Errorf("error creating %s: %w", kind.description, err)
+	}
+	d.SetId(ref)
+
+	return resourceFixedAddressRead(d, m, kind)
+}
+
+func resourceFixedAddressRead(d *schema.ResourceData, m interface{}, kind fixedAddressKind) error {
+	if !strings.HasPrefix(d.Id(), kind.objectType()+"/") {
+		return fmt.Errorf("reference '%s' for '%s' object has an invalid format", d.Id(), kind.objectType())
+	}
+
+	connector := m.(ibclient.IBConnector)
+
+	obj := newFixedAddress(fixedAddress{}, kind.isIPv6)
+	if err := connector.GetObject(obj, d.Id(), ibclient.NewQueryParams(false, nil), obj); err != nil {
+		return fmt.Errorf("failed getting %s: %w", kind.description, err)
+	}
+
+	if obj.Ea != nil && len(obj.Ea) > 0 {
+		// TODO: temporary scaffold, need to rework marshalling/unmarshalling of EAs
+		//       (avoiding additional layer of keys ("value" key)
+		eaMap := (map[string]interface{})(obj.Ea)
+		ea, err := json.Marshal(eaMap)
+		if err != nil {
+			return err
+		}
+		if err = d.Set("ext_attrs", string(ea)); err != nil {
+			return err
+		}
+	}
+
+	if err := d.Set("network_view", obj.NetworkView); err != nil {
+		return err
+	}
+	if err := d.Set("name", obj.Name); err != nil {
+		return err
+	}
+	if err := d.Set("comment", obj.Comment); err != nil {
+		return err
+	}
+
+	stateOptions, err := convertInterfaceToDHCPOptions("options", d.Get("options").([]interface{}))
+	if err != nil {
+		return err
+	}
+	options := mergeDHCPOptions(stateOptions, obj.Options)
+	if err = d.Set("options", convertDHCPOptionsToInterface(options)); err != nil {
+		return err
+	}
+
+	// The network, defined for the dynamic allocation, is kept
+	// while the address belongs to it; otherwise the address is considered as a static one.
+	allocated := obj.Ipv4Addr
+	if kind.isIPv6 {
+		allocated = obj.Ipv6Addr
+	}
+	ipAddr := allocated
+	cidr := d.Get("cidr").(string)
+	if _, ipNet, err := net.ParseCIDR(cidr); err == nil && ipNet.Contains(net.ParseIP(allocated)) {
+		ipAddr = ""
+	} else {
+		cidr = ""
+	}
+	if err = d.Set("ip_addr", ipAddr); err != nil {
+		return err
+	}
+	if err = d.Set("cidr", cidr); err != nil {
+		return err
+	}
+	if err = d.Set("allocated_ip_addr", allocated); err != nil {
+		return err
+	}
+
+	if kind.isIPv6 {
+		if err = d.Set("duid", obj.Duid); err != nil {
+			return err
+		}
+		if err = d.Set("address_type", obj.AddressType); err != nil {
+			return err
+		}
+		prefix := ""
+		if obj.Ipv6Prefix != "" {
+			prefix = fmt.Sprintf("%s/%d", obj.Ipv6Prefix, obj.Ipv6PrefixBits)
+		}
+		if err = d.Set("prefix", prefix); err != nil {
+			return err
+		}
+	} else {
+		if err = d.Set("match_client", obj.MatchClient); err != nil {
+			return err
+		}
+		// The zero MAC address is set by the provider for the reserved addresses.
+		mac := obj.Mac
+		if mac == ibclient.MACADDR_ZERO && d.Get("mac").(string) == "" {
+			mac = ""
+		}
+		if err = d.Set("mac", mac); err != nil {
+			return err
+		}
+		if err = d.Set("client_identifier", obj.ClientIdentifier); err != nil {
+			return err
+		}
+		if err = d.Set("agent_circuit_id", obj.AgentCircuitId); err != nil {
+			return err
+		}
+		if err = d.Set("agent_remote_id", obj.AgentRemoteId); err != nil {
+			return err
+		}
+	}
+
+	d.SetId(obj.Ref)
+
+	return nil
+}
+
+func resourceFixedAddressUpdate(d *schema.ResourceData, m interface{}, kind fixedAddressKind) error {
+	var updateSuccessful bool
+	defer func() {
+		// Reverting the state back, in case of a failure,
+		// otherwise Terraform will keep the values, which leaded to the failure,
+		// in the state file.
+		if !updateSuccessful {
+			fields := []string{"network_view", "ip_addr", "cidr", "name", "options", "comment", "ext_attrs"}
+			if kind.isIPv6 {
+				fields = append(fields, "duid", "address_type", "prefix")
+			} else {
+				fields = append(fields, "match_client", "mac", "client_identifier", "agent_circuit_id", "agent_remote_id")
+			}
+			for _, field := range fields {
+				prevValue, _ := d.GetChange(field)
+				_ = d.Set(field, prevValue)
+			}
+		}
+	}()
+
+	if d.HasChange("network_view") {
+		return fmt.Errorf("changing the value of 'network_view' field is not allowed")
+	}
+
+	prevCidr, _ := d.GetChange("cidr")
+	fa, err := buildFixedAddress(d, kind, prevCidr.(string), d.Get("allocated_ip_addr").(string))
+	if err != nil {
+		return err
+	}
+
+	connector := m.(ibclient.IBConnector)
+	ref, err := connector.UpdateObject(fa, d.Id())
+	if err != nil {
+		return fmt.Errorf("error updating %s: %w", kind.description, err)
+	}
+
+	// The empty fields are omitted from the object, so the removed ones are cleared separately.
+	if !kind.isIPv6 {
+		clearFields := make(map[string]interface{})
+		if fa.Mac == "" && d.HasChange("mac") {
+			clearFields["mac"] = ibclient.MACADDR_ZERO
+		}
+		for field, wapiField := range fixedAddressClearableFields {
+			if d.HasChange(field) && d.Get(field).(string) == "" {
+				clearFields[wapiField] = ""
+			}
+		}
+		if len(clearFields) > 0 {
+			ref, err = connector.UpdateObject(newGenericRecord(fa.ObjectType(), nil, clearFields), ref)
+			if err != nil {
+				return fmt.Errorf("error clearing the removed fields of %s: %w", kind.description, err)
+			}
+		}
+	}
+	updateSuccessful = true
+	d.SetId(ref)
+
+	return resourceFixedAddressRead(d, m, kind)
+}
+
+func resourceFixedAddressDelete(d *schema.ResourceData, m interface{}, kind fixedAddressKind) error {
+	connector := m.(ibclient.IBConnector)
+
+	if _, err := connector.DeleteObject(d.Id()); err != nil {
+		return fmt.Errorf("deletion of %s failed: %w", kind.description, err)
+	}
+	d.SetId("")
+
+	return nil
+}
+
+func resourceIPv4FixedAddressCreate(d *schema.ResourceData, m interface{}) error {
+	return resourceFixedAddressCreate(d, m, fixedAddressKindIPv4)
+}
+
+func resourceIPv4FixedAddressRead(d *schema.ResourceData, m interface{}) error {
+	return resourceFixedAddressRead(d, m, fixedAddressKindIPv4)
+}
+
+func resourceIPv4FixedAddressUpdate(d *schema.ResourceData, m interface{}) error {
+	return resourceFixedAddressUpdate(d, m, fixedAddressKindIPv4)
+}
+
+func resourceIPv4FixedAddressDelete(d *schema.ResourceData, m interface{}) error {
+	return resourceFixedAddressDelete(d, m, fixedAddressKindIPv4)
+}
+
+func resourceIPv4FixedAddress() *schema.Resource {
+	fa := resourceFixedAddress(fixedAddressKindIPv4)
+	fa.Create = resourceIPv4FixedAddressCreate
+	fa.Read = resourceIPv4FixedAddressRead
+	fa.Update = resourceIPv4FixedAddressUpdate
+	fa.Delete = resourceIPv4FixedAddressDelete
+
+	return fa
+}
+
+func resourceIPv6FixedAddressCreate(d *schema.ResourceData, m interface{}) error {
+	return resourceFixedAddressCreate(d, m, fixedAddressKindIPv6)
+}
+
+func resourceIPv6FixedAddressRead(d *schema.ResourceData, m interface{}) error {
+	return resourceFixedAddressRead(d, m, fixedAddressKindIPv6)
+}
+
+func resourceIPv6FixedAddressUpdate(d *schema.ResourceData, m interface{}) error {
+	return resourceFixedAddressUpdate(d, m, fixedAddressKindIPv6)
+}
+
+func resourceIPv6FixedAddressDelete(d *schema.ResourceData, m interface{}) error {
+	return resourceFixedAddressDelete(d, m, fixedAddressKindIPv6)
+}
+
+func resourceIPv6FixedAddress() *schema.Resource {
+	fa := resourceFixedAddress(fixedAddressKindIPv6)
+	fa.Create = resourceIPv6FixedAddressCreate
+	fa.Read = resourceIPv6FixedAddressRead
+	fa.Update = resourceIPv6FixedAddressUpdate
+	fa.Delete = resourceIPv6FixedAddressDelete
+
+	return fa
+}
@@ -0,0 +1,403 @@
+package infoblox
+
+import (
+	"fmt"
+	"net"
+	"reflect"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+)
+
+func testAccCheckFixedAddressDestroy(s *terraform.State) error {
+	meta := testAccProvider.Meta()
+
+	for _, rs := range s.RootModule().Resources {
+		var kind fixedAddressKind
+		switch rs.Type {
+		case "infoblox_ipv4_fixed_address":
+			kind = fixedAddressKindIPv4
+		case "infoblox_ipv6_fixed_address":
+			kind = fixedAddressKindIPv6
+		default:
+			continue
+		}
+		connector := meta.(ibclient.IBConnector)
+		obj := newFixedAddress(fixedAddress{}, kind.isIPv6)
+		err := connector.GetObject(obj, rs.Primary.ID, ibclient.NewQueryParams(false, nil), obj)
+		if err == nil {
+			return fmt.Errorf("%s still exists: %s", kind.description, rs.Primary.ID)
+		}
+		if !isNotFoundError(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+// The address is expected to be either the exact address or the network which the address belongs to.
+func testAccFixedAddressCompare(
+	t *testing.T, resPath string, kind fixedAddressKind, expected *fixedAddress, expectedAddr string) resource.TestCheckFunc {
+
+	return func(s *terraform.State) error {
+		res, found := s.RootModule().Resources[resPath]
+		if !found {
+			return fmt.Errorf("not found: %s", resPath)
+		}
+
+		connector := testAccProvider.Meta().(ibclient.IBConnector)
+		obj := newFixedAddress(fixedAddress{}, kind.isIPv6)
+		if err := connector.GetObject(obj, res.Primary.ID, ibclient.NewQueryParams(false, nil), obj); err != nil {
+			return err
+		}
+
+		addr := obj.Ipv4Addr
+		if kind.isIPv6 {
+			addr = obj.Ipv6Addr
+		}
+		if _, ipNet, err := net.ParseCIDR(expectedAddr); err == nil {
+			if !ipNet.Contains(net.ParseIP(addr)) {
+				return fmt.Errorf("the address '%s' does not belong to '%s'", addr, expectedAddr)
+			}
+		} else if !sameIPAddrs(addr, expectedAddr) {
+			return fmt.Errorf("the address does not match: got '%s', expected '%s'", addr, expectedAddr)
+		}
+
+		if obj.NetworkView != expected.NetworkView {
+			return fmt.Errorf(
+				"'network_view' does not match: got '%s', expected '%s'", obj.NetworkView, expected.NetworkView)
+		}
+		if obj.Name != expected.Name {
+			return fmt.Errorf("'name' does not match: got '%s', expected '%s'", obj.Name, expected.Name)
+		}
+		if obj.Comment != expected.Comment {
+			return fmt.Errorf("'comment' does not match: got '%s', expected '%s'", obj.Comment, expected.Comment)
+		}
+		if kind.isIPv6 {
+			if obj.Duid != expected.Duid {
+				return fmt.Errorf("'duid' does not match: got '%s', expected '%s'", obj.Duid, expected.Duid)
+			}
+			if obj.AddressType != expected.AddressType {
+				return fmt.Errorf(
+					"'address_type' does not match: got '%s', expected '%s'", obj.AddressType, expected.AddressType)
+			}
+			if obj.Ipv6Prefix != expected.Ipv6Prefix || obj.Ipv6PrefixBits != expected.Ipv6PrefixBits {
+				return fmt.Errorf(
+					"the prefix does not match: got '%s/%d', expected '%s/%d'",
+					obj.Ipv6Prefix, obj.Ipv6PrefixBits, expected.Ipv6Prefix, expected.Ipv6PrefixBits)
+			}
+		} else {
+			if obj.MatchClient != expected.MatchClient {
+				return fmt.Errorf(
+					"'match_client' does not match: got '%s', expected '%s'", obj.MatchClient, expected.MatchClient)
+			}
+			if expected.Mac != "" && obj.Mac != expected.Mac {
+				return fmt.Errorf("'mac' does not match: got '%s', expected '%s'", obj.Mac, expected.Mac)
+			}
+			if obj.ClientIdentifier != expected.ClientIdentifier {
+				return fmt.Errorf(
+					"'dhcp_client_identifier' does not match: got '%s', expected '%s'",
+					obj.ClientIdentifier, expected.ClientIdentifier)
+			}
+			if obj.AgentCircuitId != expected.AgentCircuitId {
+				return fmt.Errorf(
+					"'agent_circuit_id' does not match: got '%s', expected '%s'",
+					obj.AgentCircuitId, expected.AgentCircuitId)
+			}
+			if obj.AgentRemoteId != expected.AgentRemoteId {
+				return fmt.Errorf(
+					"'agent_remote_id' does not match: got '%s', expected '%s'",
+					obj.AgentRemoteId, expected.AgentRemoteId)
+			}
+		}
+
+		actualOptions := mergeDHCPOptions(expected.Options, obj.Options)
+		if !reflect.DeepEqual(actualOptions, expected.Options) && len(actualOptions)+len(expected.Options) > 0 {
+			return fmt.Errorf("'options' do not match: got '%+v', expected '%+v'", actualOptions, expected.Options)
+		}
+
+		return validateEAs(obj.Ea, expected.Ea)
+	}
+}
+
+func TestAccResourceIPv4FixedAddress(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckFixedAddressDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "infoblox_ipv4_fixed_address" "foo" {
+						ip_addr = "10.0.0.61"
+						mac = "00:0c:24:ab:cd:01"
+					}`,
+				Check: resource.ComposeTestCheckFunc(
+					testAccFixedAddressCompare(t, "infoblox_ipv4_fixed_address.foo", fixedAddressKindIPv4, &fixedAddress{
+						NetworkView: "default",
+						MatchClient: "MAC_ADDRESS",
+						Mac:         "00:0c:24:ab:cd:01",
+					}, "10.0.0.61"),
+					resource.TestCheckResourceAttr("infoblox_ipv4_fixed_address.foo", "allocated_ip_addr", "10.0.0.61"),
+				),
+			},
+			{
+				Config: `
+					resource "infoblox_ipv4_fixed_address" "foo" {
+						cidr = "10.1.0.0/24"
+						match_client = "CLIENT_ID"
+						client_identifier = "01:00:0c:24:ab:cd:02"
+						name = "appliance1"
+						comment = "DHCP reservation of the appliance"
+						options {
+							name = "routers"
+							value = "10.1.0.1"
+						}
+						options {
+							num = 66
+							value = "tftp.test.com"
+						}
+						ext_attrs = jsonencode({
+							"Location" = "Test location"
+						})
+					}`,
+				Check: resource.ComposeTestCheckFunc(
+					testAccFixedAddressCompare(t, "infoblox_ipv4_fixed_address.foo", fixedAddressKindIPv4, &fixedAddress{
+						NetworkView:      "default",
+						MatchClient:      "CLIENT_ID",
+						ClientIdentifier: "01:00:0c:24:ab:cd:02",
+						Name:             "appliance1",
+						Comment:          "DHCP reservation of the appliance",
+						Options: []dhcpOption{
+							{Name: "routers", Value: "10.1.0.1", UseOption: true},
+							{Num: 66, Value: "tftp.test.com", UseOption: true},
+						},
+						Ea: ibclient.EA{
+							"Location": "Test location",
+						},
+					}, "10.1.0.0/24"),
+					resource.TestCheckResourceAttr("infoblox_ipv4_fixed_address.foo", "ip_addr", ""),
+					resource.TestCheckResourceAttr("infoblox_ipv4_fixed_address.foo", "options.#", "2"),
+					resource.TestCheckResourceAttr("infoblox_ipv4_fixed_address.foo", "options.1.name", ""),
+					resource.TestCheckResourceAttr("infoblox_ipv4_fixed_address.foo", "options.1.num", "66"),
+				),
+			},
+			{
+				ResourceName:            "infoblox_ipv4_fixed_address.foo",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"ip_addr", "cidr", "options"},
+			},
+			{
+				Config: `
+					resource "infoblox_ipv4_fixed_address" "foo" {
+						ip_addr = "10.0.0.61"
+						match_client = "REMOTE_ID"
+						agent_remote_id = "remote-1"
+					}`,
+				Check: resource.ComposeTestCheckFunc(
+					testAccFixedAddressCompare(t, "infoblox_ipv4_fixed_address.foo", fixedAddressKindIPv4, &fixedAddress{
+						NetworkView:   "default",
+						MatchClient:   "REMOTE_ID",
+						AgentRemoteId: "remote-1",
+					}, "10.0.0.61"),
+					resource.TestCheckResourceAttr("infoblox_ipv4_fixed_address.foo", "client_identifier", ""),
+				),
+			},
+			{
+				Config: `
+					resource "infoblox_ipv4_fixed_address" "foo" {
+						ip_addr = "10.0.0.61"
+						mac = "00:0c:24:ab:cd:03"
+					}`,
+				Check: resource.ComposeTestCheckFunc(
+					testAccFixedAddressCompare(t, "infoblox_ipv4_fixed_address.foo", fixedAddressKindIPv4, &fixedAddress{
+						NetworkView: "default",
+						MatchClient: "MAC_ADDRESS",
+						Mac:         "00:0c:24:ab:cd:03",
+					}, "10.0.0.61"),
+					resource.TestCheckResourceAttr("infoblox_ipv4_fixed_address.foo", "agent_remote_id", ""),
+				),
+			},
+			{
+				Config: `
+					resource "infoblox_ipv4_fixed_address" "foo" {
+						ip_addr = "10.0.0.62"
+						match_client = "RESERVED"
+					}`,
+				Check: resource.ComposeTestCheckFunc(
+					testAccFixedAddressCompare(t, "infoblox_ipv4_fixed_address.foo", fixedAddressKindIPv4, &fixedAddress{
+						NetworkView: "default",
+						MatchClient: "RESERVED",
+					}, "10.0.0.62"),
+					resource.TestCheckResourceAttr("infoblox_ipv4_fixed_address.foo", "mac", ""),
+				),
+			},
+
+			// negative test cases
+			{
+				Config: `
+					resource "infoblox_ipv4_fixed_address" "foo" {
+						ip_addr = "10.0.0.62"
+						match_client = "CIRCUIT_ID"
+					}`,
+				ExpectError: regexp.MustCompile("'agent_circuit_id' must be defined if 'match_client' is 'CIRCUIT_ID'"),
+			},
+			{
+				Config: `
+					resource "infoblox_ipv4_fixed_address" "foo" {
+						ip_addr = "10.0.0.62"
+						match_client = "HOSTNAME"
+					}`,
+				ExpectError: regexp.MustCompile("'HOSTNAME' is not a valid value for 'match_client'"),
+			},
+			{
+				Config: `
+					resource "infoblox_ipv4_fixed_address" "foo" {
+						ip_addr = "10.0.0.62"
+						cidr = "10.1.0.0/24"
+						match_client = "RESERVED"
+					}`,
+				ExpectError: regexp.MustCompile("exactly one of 'ip_addr' and 'cidr' must be defined"),
+			},
+			{
+				Config: `
+					resource "infoblox_ipv4_fixed_address" "foo" {
+						network_view = "nondefault_netview"
+						ip_addr = "10.0.0.62"
+						match_client = "RESERVED"
+					}`,
+				ExpectError: regexp.MustCompile("changing the value of 'network_view' field is not allowed"),
+			},
+		},
+	})
+}
+
+func TestAccResourceIPv6FixedAddress(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckFixedAddressDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "infoblox_ipv6_fixed_address" "foo" {
+						cidr = "2001:db8:1::/64"
+						duid = "00:01:00:01:ab:cd:01"
+					}`,
+				Check: resource.ComposeTestCheckFunc(
+					testAccFixedAddressCompare(t, "infoblox_ipv6_fixed_address.foo", fixedAddressKindIPv6, &fixedAddress{
+						NetworkView: "default",
+						Duid:        "00:01:00:01:ab:cd:01",
+						AddressType: "ADDRESS",
+					}, "2001:db8:1::/64"),
+				),
+			},
+			{
+				Config: `
+					resource "infoblox_ipv6_fixed_address" "foo" {
+						cidr = "2001:db8:1::/64"
+						duid = "00:01:00:01:ab:cd:01"
+						address_type = "BOTH"
+						prefix = "2001:db8:100::/56"
+						comment = "delegated prefix"
+					}`,
+				Check: resource.ComposeTestCheckFunc(
+					testAccFixedAddressCompare(t, "infoblox_ipv6_fixed_address.foo", fixedAddressKindIPv6, &fixedAddress{
+						NetworkView:    "default",
+						Duid:           "00:01:00:01:ab:cd:01",
+						AddressType:    "BOTH",
+						Ipv6Prefix:     "2001:db8:100::",
+						Ipv6PrefixBits: 56,
+						Comment:        "delegated prefix",
+					}, "2001:db8:1::/64"),
+					resource.TestCheckResourceAttr("infoblox_ipv6_fixed_address.foo", "prefix", "2001:db8:100::/56"),
+				),
+			},
+			{
+				ResourceName:            "infoblox_ipv6_fixed_address.foo",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"ip_addr", "cidr"},
+			},
+
+			// negative test cases
+			{
+				Config: `
+					resource "infoblox_ipv6_fixed_address" "foo" {
+						ip_addr = "2001:db8:1::61"
+						duid = "00:01:00:01:ab:cd:01"
+						address_type = "PREFIX"
+						prefix = "2001:db8:100::/56"
+					}`,
+				ExpectError: regexp.MustCompile("neither 'ip_addr' nor 'cidr' may be defined if 'address_type' is 'PREFIX'"),
+			},
+			{
+				Config: `
+					resource "infoblox_ipv6_fixed_address" "foo" {
+						ip_addr = "10.0.0.61"
+						duid = "00:01:00:01:ab:cd:01"
+					}`,
+				ExpectError: regexp.MustCompile("'10.0.0.61' is not a valid value for 'ip_addr'"),
+			},
+		},
+	})
+}
+
+func TestMergeDHCPOptions(t *testing.T) {
+	stateOpts := []dhcpOption{
+		{Num: 66, Value: "tftp.test.com", UseOption: true},
+		{Name: "routers", Value: "10.0.0.1", UseOption: true},
+	}
+	actual := []dhcpOption{
+		{Name: "dhcp-lease-time", Num: 51, Value: "43200", VendorClass: "DHCP", UseOption: false},
+		{Name: "routers", Num: 3, Value: "10.0.0.254", VendorClass: "DHCP", UseOption: true},
+		{Name: "tftp-server-name", Num: 66, Value: "tftp.test.com", VendorClass: "DHCP", UseOption: false},
+		{Name: "domain-name", Num: 15, Value: "test.com", VendorClass: "DHCP", UseOption: true},
+	}
+
+	expected := []dhcpOption{
+		{Num: 66, Value: "tftp.test.com", UseOption: true},
+		{Name: "routers", Value: "10.0.0.254", UseOption: true},
+		{Name: "domain-name", Num: 15, Value: "test.com", VendorClass: "DHCP", UseOption: true},
+	}
+	if res := mergeDHCPOptions(stateOpts, actual); !reflect.DeepEqual(res, expected) {
+		t.Errorf("unexpected options: got '%+v', expected '%+v'", res, expected)
+	}
+}
+
+func TestFixedAddressIPValue(t *testing.T) {
+	testCases := []struct {
+		kind                                  fixedAddressKind
+		ipAddr, cidr, prevCidr, prevAllocated string
+		expected                              string
+		expectError                           bool
+	}{
+		{fixedAddressKindIPv4, "10.0.0.1", "", "", "", "10.0.0.1", false},
+		{fixedAddressKindIPv4, "", "10.0.0.0/24", "", "", "func:nextavailableip:10.0.0.0/24,default", false},
+		{fixedAddressKindIPv4, "", "10.0.0.0/24", "10.0.0.0/24", "10.0.0.5", "10.0.0.5", false},
+		{fixedAddressKindIPv4, "", "10.0.1.0/24", "10.0.0.0/24", "10.0.0.5", "func:nextavailableip:10.0.1.0/24,default", false},
+		{fixedAddressKindIPv6, "2001:db8::1", "", "", "", "2001:db8::1", false},
+		{fixedAddressKindIPv4, "2001:db8::1", "", "", "", "", true},
+		{fixedAddressKindIPv6, "", "10.0.0.0/24", "", "", "", true},
+		{fixedAddressKindIPv4, "", "", "", "", "", true},
+	}
+
+	for i, tc := range testCases {
+		res, err := fixedAddressIPValue(tc.kind, "default", tc.ipAddr, tc.cidr, tc.prevCidr, tc.prevAllocated)
+		if tc.expectError {
+			if err == nil {
+				t.Errorf("case %d: an error is expected", i)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("case %d: unexpected error: %s", i, err)
+		} else if res != tc.expected {
+			t.Errorf("case %d: got '%s', expected '%s'", i, res, tc.expected)
+		}
+	}
+}
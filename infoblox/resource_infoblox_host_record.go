@@ -0,0 +1,620 @@
+package infoblox
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+)
+
+// hostRecordAddrKind describes the differences between
+// IPv4 and IPv6 addresses of a host record.
+type hostRecordAddrKind struct {
+	fieldName   string
+	hwAddrField string
+	isIPv6      bool
+}
+
+var (
+	hostRecordAddrKindIPv4 = hostRecordAddrKind{
+		fieldName:   "ipv4_address",
+		hwAddrField: "mac",
+		isIPv6:      false,
+	}
+	hostRecordAddrKindIPv6 = hostRecordAddrKind{
+		fieldName:   "ipv6_address",
+		hwAddrField: "duid",
+		isIPv6:      true,
+	}
+)
+
+// hostRecordAddr is an item of 'ipv4_address' or 'ipv6_address' field.
+type hostRecordAddr struct {
+	ipAddr     string
+	cidr       string
+	hwAddr     string
+	enableDhcp bool
+	allocated  string
+}
+
+func hostRecordAddrSchemaElem(kind hostRecordAddrKind) *schema.Resource {
+	ipVersion := "IPv4"
+	hwAddrDescription := "The MAC address of the host's interface, required if DHCP is enabled for the address."
+	if kind.isIPv6 {
+		ipVersion = "IPv6"
+		hwAddrDescription = "The DUID of the host's interface, required if DHCP is enabled for the address."
+	}
+
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"ip_addr": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: fmt.Sprintf("The %s address, for static allocation.", ipVersion),
+			},
+			"cidr": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "",
+				Description: fmt.Sprintf(
+					"The %s network (in CIDR format) to allocate the next available address from.", ipVersion),
+			},
+			kind.hwAddrField: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: hwAddrDescription,
+			},
+			"enable_dhcp": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "The flag which defines if the address is used for DHCP purposes.",
+			},
+			"allocated_ip_addr": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The address which is allocated for the host: either 'ip_addr' or the one allocated from 'cidr'.",
+			},
+		},
+	}
+}
+
+func convertInterfaceToHostRecordAddrs(kind hostRecordAddrKind, items []interface{}) ([]*hostRecordAddr, error) {
+	res := make([]*hostRecordAddr, 0, len(items))
+	for _, item := range items {
+		if item == nil {
+			return nil, fmt.Errorf(
+				"exactly one of 'ip_addr' and 'cidr' must be defined for every item of '%s'", kind.fieldName)
+		}
+		m := item.(map[string]interface{})
+		addr := &hostRecordAddr{
+			ipAddr:     m["ip_addr"].(string),
+			cidr:       m["cidr"].(string),
+			hwAddr:     m[kind.hwAddrField].(string),
+			enableDhcp: m["enable_dhcp"].(bool),
+		}
+		if allocated, found := m["allocated_ip_addr"]; found && allocated != nil {
+			addr.allocated = allocated.(string)
+		}
+		res = append(res, addr)
+	}
+
+	return res, nil
+}
+
+func convertHostRecordAddrsToInterface(kind hostRecordAddrKind, addrs []*hostRecordAddr) []map[string]interface{} {
+	res := make([]map[string]interface{}, 0, len(addrs))
+	for _, addr := range addrs {
+		res = append(res, map[string]interface{}{
+			"ip_addr":           addr.ipAddr,
+			"cidr":              addr.cidr,
+			kind.hwAddrField:    addr.hwAddr,
+			"enable_dhcp":       addr.enableDhcp,
+			"allocated_ip_addr": addr.allocated,
+		})
+	}
+
+	return res
+}
+
+// Validates the addresses and defines the value of 'ipv4addr' ('ipv6addr') WAPI field
+// for every address: either the static address, the address which was previously allocated
+// from the same network, or the function which allocates the next available address.
+// The addresses which are allocated already are set as 'allocated' values.
+func prepareHostRecordAddrs(
+	kind hostRecordAddrKind, netView string, addrs, prevAddrs []*hostRecordAddr) ([]string, error) {
+
+	usedPrev := make(map[int]bool)
+	res := make([]string, 0, len(addrs))
+	for _, addr := range addrs {
+		if (addr.ipAddr == "") == (addr.cidr == "") {
+			return nil, fmt.Errorf(
+				"exactly one of 'ip_addr' and 'cidr' must be defined for every item of '%s'", kind.fieldName)
+		}
+		if addr.enableDhcp && addr.hwAddr == "" {
+			return nil, fmt.Errorf(
+				"'%s' must be defined for the items of '%s' which have 'enable_dhcp' set to true",
+				kind.hwAddrField, kind.fieldName)
+		}
+
+		if addr.ipAddr != "" {
+			if !isIPAddrOfVersion(net.ParseIP(addr.ipAddr), kind.isIPv6) {
+				return nil, fmt.Errorf("'%s' is not a valid value for 'ip_addr' of '%s'", addr.ipAddr, kind.fieldName)
+			}
+			addr.allocated = addr.ipAddr
+			res = append(res, addr.ipAddr)
+			continue
+		}
+
+		ip, _, err := net.ParseCIDR(addr.cidr)
+		if err != nil || !isIPAddrOfVersion(ip, kind.isIPv6) {
+			return nil, fmt.Errorf("'%s' is not a valid value for 'cidr' of '%s'", addr.cidr, kind.fieldName)
+		}
+
+		// The address which is allocated already from the same network is kept.
+		addr.allocated = ""
+		for i, prev := range prevAddrs {
+			if !usedPrev[i] && prev.ipAddr == "" && prev.cidr == addr.cidr && prev.allocated != "" {
+				usedPrev[i] = true
+				addr.allocated = prev.allocated
+				break
+			}
+		}
+		if addr.allocated != "" {
+			res = append(res, addr.allocated)
+		} else {
+			res = append(res, fmt.Sprintf("func:nextavailableip:%s,%s", addr.cidr, netView))
+		}
+	}
+
+	return res, nil
+}
+
+// Brings the addresses of the state in accordance with the actual addresses of the host record.
+// The addresses which are added on NIOS side are appended as static ones,
+// the addresses which are deleted on NIOS side are removed.
+func mergeHostRecordAddrs(stateAddrs []*hostRecordAddr, actual []*hostRecordAddr) []*hostRecordAddr {
+	matched := make(map[int]bool)
+	assigned := make([]*hostRecordAddr, len(stateAddrs))
+
+	match := func(i int, addrToMatch string, cidr *net.IPNet) {
+		for j, act := range actual {
+			if matched[j] {
+				continue
+			}
+			if addrToMatch != "" && !sameIPAddrs(addrToMatch, act.allocated) {
+				continue
+			}
+			if cidr != nil && !cidr.Contains(net.ParseIP(act.allocated)) {
+				continue
+			}
+			matched[j] = true
+			merged := *stateAddrs[i]
+			merged.allocated = act.allocated
+			merged.hwAddr = act.hwAddr
+			merged.enableDhcp = act.enableDhcp
+			if merged.ipAddr != "" {
+				merged.ipAddr = act.allocated
+			}
+			assigned[i] = &merged
+			return
+		}
+	}
+
+	// Exact matches go first, to not let the addresses allocated
+	// from a network be taken by the other items of the same network.
+	for i, addr := range stateAddrs {
+		switch {
+		case addr.ipAddr != "":
+			match(i, addr.ipAddr, nil)
+		case addr.allocated != "":
+			match(i, addr.allocated, nil)
+		}
+	}
+	for i, addr := range stateAddrs {
+		if addr.ipAddr != "" || addr.allocated != "" {
+			continue
+		}
+		if _, cidr, err := net.ParseCIDR(addr.cidr); err == nil {
+			match(i, "", cidr)
+		}
+	}
+
+	res := make([]*hostRecordAddr, 0, len(actual))
+	for _, addr := range assigned {
+		if addr != nil {
+			res = append(res, addr)
+		}
+	}
+	for j, act := range actual {
+		if !matched[j] {
+			res = append(res, &hostRecordAddr{
+				ipAddr:     act.allocated,
+				hwAddr:     act.hwAddr,
+				enableDhcp: act.enableDhcp,
+				allocated:  act.allocated,
+			})
+		}
+	}
+
+	return res
+}
+
+func resourceHostRecord() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceHostRecordCreate,
+		Read:   resourceHostRecordGet,
+		Update: resourceHostRecordUpdate,
+		Delete: resourceHostRecordDelete,
+
+		Importer: &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			"fqdn": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the host record, in FQDN format if DNS is configured for the host.",
+			},
+			"dns_view": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     defaultDNSView,
+				Description: "DNS view which the zone of the host record does exist within.",
+			},
+			"network_view": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     defaultNetView,
+				Description: "Network view which the addresses of the host record belong to.",
+			},
+			"configure_for_dns": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "The flag which defines if DNS records are created for the host record.",
+			},
+			"ipv4_address": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        hostRecordAddrSchemaElem(hostRecordAddrKindIPv4),
+				Description: "IPv4 addresses of the host.",
+			},
+			"ipv6_address": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        hostRecordAddrSchemaElem(hostRecordAddrKindIPv6),
+				Description: "IPv6 addresses of the host.",
+			},
+			"aliases": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Alternative names of the host, in FQDN format.",
+			},
+			"ttl": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     ttlUndef,
+				Description: "TTL value of the host record.",
+			},
+			"comment": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "A description of the host record.",
+			},
+			"ext_attrs": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "Extensible attributes of the host record to be added/updated, as a map in JSON format.",
+			},
+		},
+	}
+}
+
+// Builds a host record object out of the resource's fields,
+// except 'dns_view' and 'network_view' which cannot be changed once the record is created.
+// The addresses are built taking into account the previously allocated ones.
+func buildHostRecord(
+	d *schema.ResourceData, prevIPv4Addrs, prevIPv6Addrs []*hostRecordAddr) (
+	*hostRecord, []*hostRecordAddr, []*hostRecordAddr, error) {
+
+	fqdn := d.Get("fqdn").(string)
+	if fqdn == "" {
+		return nil, nil, nil, fmt.Errorf("'fqdn' must not be empty")
+	}
+	netView := d.Get("network_view").(string)
+
+	ipv4Addrs, err := convertInterfaceToHostRecordAddrs(
+		hostRecordAddrKindIPv4, d.Get("ipv4_address").([]interface{}))
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	ipv4Values, err := prepareHostRecordAddrs(hostRecordAddrKindIPv4, netView, ipv4Addrs, prevIPv4Addrs)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	ipv6Addrs, err := convertInterfaceToHostRecordAddrs(
+		hostRecordAddrKindIPv6, d.Get("ipv6_address").([]interface{}))
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	ipv6Values, err := prepareHostRecordAddrs(hostRecordAddrKindIPv6, netView, ipv6Addrs, prevIPv6Addrs)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	if len(ipv4Addrs) == 0 && len(ipv6Addrs) == 0 {
+		return nil, nil, nil, fmt.Errorf("at least one item of 'ipv4_address' or 'ipv6_address' must be defined")
+	}
+
+	wapiIPv4Addrs := make([]hostRecordIpv4Addr, 0, len(ipv4Addrs))
+	for i, addr := range ipv4Addrs {
+		wapiIPv4Addrs = append(wapiIPv4Addrs, hostRecordIpv4Addr{
+			Ipv4Addr:         ipv4Values[i],
+			Mac:              addr.hwAddr,
+			ConfigureForDhcp: addr.enableDhcp,
+		})
+	}
+	wapiIPv6Addrs := make([]hostRecordIpv6Addr, 0, len(ipv6Addrs))
+	for i, addr := range ipv6Addrs {
+		wapiIPv6Addrs = append(wapiIPv6Addrs, hostRecordIpv6Addr{
+			Ipv6Addr:         ipv6Values[i],
+			Duid:             addr.hwAddr,
+			ConfigureForDhcp: addr.enableDhcp,
+		})
+	}
+
+	configureForDNS := d.Get("configure_for_dns").(bool)
+	aliases := make([]string, 0)
+	for _, alias := range d.Get("aliases").(*schema.Set).List() {
+		aliases = append(aliases, alias.(string))
+	}
+	sort.Strings(aliases)
+	if len(aliases) > 0 && !configureForDNS {
+		return nil, nil, nil, fmt.Errorf("'aliases' may be defined only if 'configure_for_dns' is set to true")
+	}
+
+	var ttl uint32
+	useTtl := false
+	tempTTL := d.Get("ttl").(int)
+	if tempTTL >= 0 {
+		useTtl = true
+		ttl = uint32(tempTTL)
+	} else if tempTTL != ttlUndef {
+		return nil, nil, nil, fmt.Errorf("TTL value must be 0 or higher")
+	}
+
+	comment := d.Get("comment").(string)
+
+	extAttrJSON := d.Get("ext_attrs").(string)
+	extAttrs := make(map[string]interface{})
+	if extAttrJSON != "" {
+		if err := json.Unmarshal([]byte(extAttrJSON), &extAttrs); err != nil {
+			return nil, nil, nil, fmt.Errorf("cannot process 'ext_attrs' field: %w", err)
+		}
+	}
+
+	return newHostRecord(hostRecord{
+		Name:            fqdn,
+		ConfigureForDns: configureForDNS,
+		Ipv4Addrs:       wapiIPv4Addrs,
+		Ipv6Addrs:       wapiIPv6Addrs,
+		Aliases:         aliases,
+		Ttl:             ttl,
+		UseTtl:          useTtl,
+		Comment:         comment,
+		Ea:              extAttrs,
+	}), ipv4Addrs, ipv6Addrs, nil
+}
+
+func resourceHostRecordCreate(d *schema.ResourceData, m interface{}) error {
+	rec, ipv4Addrs, ipv6Addrs, err := buildHostRecord(d, nil, nil)
+	if err != nil {
+		return err
+	}
+	rec.NetworkView = d.Get("network_view").(string)
+	if rec.ConfigureForDns {
+		rec.View = d.Get("dns_view").(string)
+	}
+
+	connector := m.(ibclient.IBConnector)
+	ref, err := connector.CreateObject(rec)
+	if err != nil {
+		return fmt.Errorf("error creating host record: %w", err)
+	}
+	d.SetId(ref)
+
+	if err = d.Set("ipv4_address", convertHostRecordAddrsToInterface(hostRecordAddrKindIPv4, ipv4Addrs)); err != nil {
+		return err
+	}
+	if err = d.Set("ipv6_address", convertHostRecordAddrsToInterface(hostRecordAddrKindIPv6, ipv6Addrs)); err != nil {
+		return err
+	}
+
+	return resourceHostRecordGet(d, m)
+}
+
+func resourceHostRecordGet(d *schema.ResourceData, m interface{}) error {
+	connector := m.(ibclient.IBConnector)
+
+	obj := newHostRecord(hostRecord{})
+	if err := connector.GetObject(obj, d.Id(), ibclient.NewQueryParams(false, nil), obj); err != nil {
+		return fmt.Errorf("failed getting host record: %w", err)
+	}
+
+	ttl := int(obj.Ttl)
+	if !obj.UseTtl {
+		ttl = ttlUndef
+	}
+	if err := d.Set("ttl", ttl); err != nil {
+		return err
+	}
+
+	if obj.Ea != nil && len(obj.Ea) > 0 {
+		// TODO: temporary scaffold, need to rework marshalling/unmarshalling of EAs
+		//       (avoiding additional layer of keys ("value" key)
+		eaMap := (map[string]interface{})(obj.Ea)
+		ea, err := json.Marshal(eaMap)
+		if err != nil {
+			return err
+		}
+		if err = d.Set("ext_attrs", string(ea)); err != nil {
+			return err
+		}
+	}
+
+	if err := d.Set("comment", obj.Comment); err != nil {
+		return err
+	}
+	if err := d.Set("fqdn", obj.Name); err != nil {
+		return err
+	}
+	if err := d.Set("network_view", obj.NetworkView); err != nil {
+		return err
+	}
+	if err := d.Set("configure_for_dns", obj.ConfigureForDns); err != nil {
+		return err
+	}
+	// A host record which is not configured for DNS does not belong to a DNS view.
+	if obj.ConfigureForDns {
+		if err := d.Set("dns_view", obj.View); err != nil {
+			return err
+		}
+	}
+	if err := d.Set("aliases", obj.Aliases); err != nil {
+		return err
+	}
+
+	actualIPv4Addrs := make([]*hostRecordAddr, 0, len(obj.Ipv4Addrs))
+	for _, addr := range obj.Ipv4Addrs {
+		actualIPv4Addrs = append(actualIPv4Addrs, &hostRecordAddr{
+			allocated:  addr.Ipv4Addr,
+			hwAddr:     addr.Mac,
+			enableDhcp: addr.ConfigureForDhcp,
+		})
+	}
+	stateIPv4Addrs, err := convertInterfaceToHostRecordAddrs(
+		hostRecordAddrKindIPv4, d.Get("ipv4_address").([]interface{}))
+	if err != nil {
+		return err
+	}
+	ipv4Addrs := mergeHostRecordAddrs(stateIPv4Addrs, actualIPv4Addrs)
+	if err = d.Set("ipv4_address", convertHostRecordAddrsToInterface(hostRecordAddrKindIPv4, ipv4Addrs)); err != nil {
+		return err
+	}
+
+	actualIPv6Addrs := make([]*hostRecordAddr, 0, len(obj.Ipv6Addrs))
+	for _, addr := range obj.Ipv6Addrs {
+		actualIPv6Addrs = append(actualIPv6Addrs, &hostRecordAddr{
+			allocated:  addr.Ipv6Addr,
+			hwAddr:     addr.Duid,
+			enableDhcp: addr.ConfigureForDhcp,
+		})
+	}
+	stateIPv6Addrs, err := convertInterfaceToHostRecordAddrs(
+		hostRecordAddrKindIPv6, d.Get("ipv6_address").([]interface{}))
+	if err != nil {
+		return err
+	}
+	ipv6Addrs := mergeHostRecordAddrs(stateIPv6Addrs, actualIPv6Addrs)
+	if err = d.Set("ipv6_address", convertHostRecordAddrsToInterface(hostRecordAddrKindIPv6, ipv6Addrs)); err != nil {
+		return err
+	}
+
+	d.SetId(obj.Ref)
+
+	return nil
+}
+
+func resourceHostRecordUpdate(d *schema.ResourceData, m interface{}) error {
+	var updateSuccessful bool
+	defer func() {
+		// Reverting the state back, in case of a failure,
+		// otherwise Terraform will keep the values, which leaded to the failure,
+		// in the state file.
+		if !updateSuccessful {
+			prevDNSView, _ := d.GetChange("dns_view")
+			prevNetView, _ := d.GetChange("network_view")
+			prevFQDN, _ := d.GetChange("fqdn")
+			prevConfigureForDNS, _ := d.GetChange("configure_for_dns")
+			prevIPv4Addrs, _ := d.GetChange("ipv4_address")
+			prevIPv6Addrs, _ := d.GetChange("ipv6_address")
+			prevAliases, _ := d.GetChange("aliases")
+			prevTTL, _ := d.GetChange("ttl")
+			prevComment, _ := d.GetChange("comment")
+			prevEa, _ := d.GetChange("ext_attrs")
+
+			_ = d.Set("dns_view", prevDNSView.(string))
+			_ = d.Set("network_view", prevNetView.(string))
+			_ = d.Set("fqdn", prevFQDN.(string))
+			_ = d.Set("configure_for_dns", prevConfigureForDNS.(bool))
+			_ = d.Set("ipv4_address", prevIPv4Addrs.([]interface{}))
+			_ = d.Set("ipv6_address", prevIPv6Addrs.([]interface{}))
+			_ = d.Set("aliases", prevAliases.(*schema.Set))
+			_ = d.Set("ttl", prevTTL.(int))
+			_ = d.Set("comment", prevComment.(string))
+			_ = d.Set("ext_attrs", prevEa.(string))
+		}
+	}()
+
+	if d.HasChange("dns_view") {
+		return fmt.Errorf("changing the value of 'dns_view' field is not allowed")
+	}
+	if d.HasChange("network_view") {
+		return fmt.Errorf("changing the value of 'network_view' field is not allowed")
+	}
+
+	prevIPv4Items, _ := d.GetChange("ipv4_address")
+	prevIPv4Addrs, err := convertInterfaceToHostRecordAddrs(hostRecordAddrKindIPv4, prevIPv4Items.([]interface{}))
+	if err != nil {
+		return err
+	}
+	prevIPv6Items, _ := d.GetChange("ipv6_address")
+	prevIPv6Addrs, err := convertInterfaceToHostRecordAddrs(hostRecordAddrKindIPv6, prevIPv6Items.([]interface{}))
+	if err != nil {
+		return err
+	}
+
+	rec, ipv4Addrs, ipv6Addrs, err := buildHostRecord(d, prevIPv4Addrs, prevIPv6Addrs)
+	if err != nil {
+		return err
+	}
+	if rec.ConfigureForDns && d.HasChange("configure_for_dns") {
+		rec.View = d.Get("dns_view").(string)
+	}
+
+	connector := m.(ibclient.IBConnector)
+	ref, err := connector.UpdateObject(rec, d.Id())
+	if err != nil {
+		return fmt.Errorf("error updating host record: %w", err)
+	}
+	updateSuccessful = true
+	d.SetId(ref)
+
+	if err = d.Set("ipv4_address", convertHostRecordAddrsToInterface(hostRecordAddrKindIPv4, ipv4Addrs)); err != nil {
+		return err
+	}
+	if err = d.Set("ipv6_address", convertHostRecordAddrsToInterface(hostRecordAddrKindIPv6, ipv6Addrs)); err != nil {
+		return err
+	}
+
+	return resourceHostRecordGet(d, m)
+}
+
+func resourceHostRecordDelete(d *schema.ResourceData, m interface{}) error {
+	connector := m.(ibclient.IBConnector)
+
+	if _, err := connector.DeleteObject(d.Id()); err != nil {
+		return fmt.Errorf("deletion of host record failed: %w", err)
+	}
+	d.SetId("")
+
+	return nil
+}
@@ -0,0 +1,330 @@
+package infoblox
+
+import (
+	"fmt"
+	"net"
+	"regexp"
+	"sort"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+)
+
+func testAccCheckHostRecordDestroy(s *terraform.State) error {
+	meta := testAccProvider.Meta()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "infoblox_host_record" {
+			continue
+		}
+		connector := meta.(ibclient.IBConnector)
+		rec := newHostRecord(hostRecord{})
+		err := connector.GetObject(rec, rs.Primary.ID, ibclient.NewQueryParams(false, nil), rec)
+		if err == nil {
+			return fmt.Errorf("host record still exists")
+		}
+	}
+	return nil
+}
+
+// The expected addresses of IPv4/IPv6 address lists may be defined either as addresses or as networks,
+// for the dynamically allocated ones.
+func compareHostRecordAddrs(fieldName string, actual, expected []string) error {
+	if len(actual) != len(expected) {
+		return fmt.Errorf(
+			"the number of '%s' items does not match: got '%d', expected '%d'",
+			fieldName, len(actual), len(expected))
+	}
+	for i, exp := range expected {
+		if _, cidr, err := net.ParseCIDR(exp); err == nil {
+			if !cidr.Contains(net.ParseIP(actual[i])) {
+				return fmt.Errorf("address '%s' does not belong to '%s'", actual[i], exp)
+			}
+			continue
+		}
+		if !sameIPAddrs(actual[i], exp) {
+			return fmt.Errorf("address does not match: got '%s', expected '%s'", actual[i], exp)
+		}
+	}
+	return nil
+}
+
+func testAccHostRecordCompare(
+	t *testing.T, resPath string, expectedRec *hostRecord, expectedIPv4, expectedIPv6 []string) resource.TestCheckFunc {
+
+	return func(s *terraform.State) error {
+		res, found := s.RootModule().Resources[resPath]
+		if !found {
+			return fmt.Errorf("not found: %s", resPath)
+		}
+		if res.Primary.ID == "" {
+			return fmt.Errorf("ID is not set")
+		}
+		meta := testAccProvider.Meta()
+		connector := meta.(ibclient.IBConnector)
+
+		rec := newHostRecord(hostRecord{})
+		if err := connector.GetObject(rec, res.Primary.ID, ibclient.NewQueryParams(false, nil), rec); err != nil {
+			return fmt.Errorf("host record not found: %s", err)
+		}
+
+		if rec.Name != expectedRec.Name {
+			return fmt.Errorf(
+				"'fqdn' does not match: got '%s', expected '%s'",
+				rec.Name, expectedRec.Name)
+		}
+		if rec.ConfigureForDns != expectedRec.ConfigureForDns {
+			return fmt.Errorf(
+				"'configure_for_dns' does not match: got '%t', expected '%t'",
+				rec.ConfigureForDns, expectedRec.ConfigureForDns)
+		}
+		if expectedRec.ConfigureForDns && rec.View != expectedRec.View {
+			return fmt.Errorf(
+				"'dns_view' does not match: got '%s', expected '%s'",
+				rec.View, expectedRec.View)
+		}
+		if rec.UseTtl != expectedRec.UseTtl {
+			return fmt.Errorf(
+				"'use_ttl' does not match: got '%t', expected '%t'",
+				rec.UseTtl, expectedRec.UseTtl)
+		}
+		if rec.UseTtl && rec.Ttl != expectedRec.Ttl {
+			return fmt.Errorf(
+				"'ttl' does not match: got '%d', expected '%d'",
+				rec.Ttl, expectedRec.Ttl)
+		}
+		if rec.Comment != expectedRec.Comment {
+			return fmt.Errorf(
+				"'comment' does not match: got '%s', expected '%s'",
+				rec.Comment, expectedRec.Comment)
+		}
+
+		aliases := append([]string{}, rec.Aliases...)
+		sort.Strings(aliases)
+		if len(aliases) != len(expectedRec.Aliases) {
+			return fmt.Errorf(
+				"the number of aliases does not match: got '%d', expected '%d'",
+				len(aliases), len(expectedRec.Aliases))
+		}
+		for i, alias := range expectedRec.Aliases {
+			if aliases[i] != alias {
+				return fmt.Errorf("alias does not match: got '%s', expected '%s'", aliases[i], alias)
+			}
+		}
+
+		var ipv4Addrs, ipv6Addrs []string
+		for _, addr := range rec.Ipv4Addrs {
+			ipv4Addrs = append(ipv4Addrs, addr.Ipv4Addr)
+		}
+		for _, addr := range rec.Ipv6Addrs {
+			ipv6Addrs = append(ipv6Addrs, addr.Ipv6Addr)
+		}
+		if err := compareHostRecordAddrs("ipv4_address", ipv4Addrs, expectedIPv4); err != nil {
+			return err
+		}
+		if err := compareHostRecordAddrs("ipv6_address", ipv6Addrs, expectedIPv6); err != nil {
+			return err
+		}
+
+		return validateEAs(rec.Ea, expectedRec.Ea)
+	}
+}
+
+func TestAccResourceHostRecord(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckHostRecordDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "infoblox_host_record" "foo" {
+						fqdn = "host1.test.com"
+						ipv4_address {
+							ip_addr = "10.0.0.11"
+						}
+					}`,
+				Check: resource.ComposeTestCheckFunc(
+					testAccHostRecordCompare(t, "infoblox_host_record.foo", &hostRecord{
+						Name:            "host1.test.com",
+						View:            "default",
+						ConfigureForDns: true,
+					}, []string{"10.0.0.11"}, nil),
+					resource.TestCheckResourceAttr("infoblox_host_record.foo", "ipv4_address.0.allocated_ip_addr", "10.0.0.11"),
+				),
+			},
+			{
+				Config: `
+					resource "infoblox_host_record" "foo" {
+						fqdn = "host1.test.com"
+						ipv4_address {
+							ip_addr = "10.0.0.11"
+							mac = "12:34:56:78:9a:bc"
+							enable_dhcp = true
+						}
+						ipv4_address {
+							cidr = "10.0.0.0/24"
+						}
+						ipv6_address {
+							ip_addr = "2001:db8::11"
+						}
+						ipv6_address {
+							cidr = "2001:db8::/64"
+						}
+						aliases = ["alias1.test.com", "alias2.test.com"]
+						ttl = 300
+						comment = "multi-homed host"
+						ext_attrs = jsonencode({
+							"Location" = "Test location"
+						})
+					}`,
+				Check: resource.ComposeTestCheckFunc(
+					testAccHostRecordCompare(t, "infoblox_host_record.foo", &hostRecord{
+						Name:            "host1.test.com",
+						View:            "default",
+						ConfigureForDns: true,
+						Aliases:         []string{"alias1.test.com", "alias2.test.com"},
+						Ttl:             300,
+						UseTtl:          true,
+						Comment:         "multi-homed host",
+						Ea: ibclient.EA{
+							"Location": "Test location",
+						},
+					},
+						[]string{"10.0.0.11", "10.0.0.0/24"},
+						[]string{"2001:db8::11", "2001:db8::/64"}),
+				),
+			},
+			{
+				// the address allocated from the network must be kept
+				Config: `
+					resource "infoblox_host_record" "foo" {
+						fqdn = "host2.test.com"
+						ipv4_address {
+							cidr = "10.0.0.0/24"
+						}
+						ipv6_address {
+							cidr = "2001:db8::/64"
+						}
+						aliases = ["alias1.test.com"]
+					}`,
+				Check: resource.ComposeTestCheckFunc(
+					testAccHostRecordCompare(t, "infoblox_host_record.foo", &hostRecord{
+						Name:            "host2.test.com",
+						View:            "default",
+						ConfigureForDns: true,
+						Aliases:         []string{"alias1.test.com"},
+					},
+						[]string{"10.0.0.0/24"},
+						[]string{"2001:db8::/64"}),
+				),
+			},
+			{
+				ResourceName:            "infoblox_host_record.foo",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"ipv4_address", "ipv6_address"},
+			},
+
+			// negative test cases
+			{
+				Config: `
+					resource "infoblox_host_record" "foo" {
+						fqdn = "host2.test.com"
+						ipv4_address {
+							ip_addr = "10.0.0.12"
+							cidr = "10.0.0.0/24"
+						}
+					}`,
+				ExpectError: regexp.MustCompile(
+					"exactly one of 'ip_addr' and 'cidr' must be defined for every item of 'ipv4_address'"),
+			},
+			{
+				Config: `
+					resource "infoblox_host_record" "foo" {
+						fqdn = "host2.test.com"
+						ipv4_address {
+							ip_addr = "10.0.0.12"
+							enable_dhcp = true
+						}
+					}`,
+				ExpectError: regexp.MustCompile(
+					"'mac' must be defined for the items of 'ipv4_address' which have 'enable_dhcp' set to true"),
+			},
+			{
+				Config: `
+					resource "infoblox_host_record" "foo" {
+						fqdn = "host2.test.com"
+						dns_view = "nondefault_view"
+						ipv4_address {
+							cidr = "10.0.0.0/24"
+						}
+					}`,
+				ExpectError: regexp.MustCompile("changing the value of 'dns_view' field is not allowed"),
+			},
+		},
+	})
+}
+
+func TestMergeHostRecordAddrs(t *testing.T) {
+	state := []*hostRecordAddr{
+		{cidr: "10.0.0.0/24", allocated: "10.0.0.5"},
+		{ipAddr: "10.0.1.1"},
+		{cidr: "10.0.0.0/24"},
+		{ipAddr: "10.0.2.1"},
+	}
+	actual := []*hostRecordAddr{
+		{allocated: "10.0.0.7", hwAddr: "12:34:56:78:9a:bc", enableDhcp: true},
+		{allocated: "10.0.0.5"},
+		{allocated: "10.0.1.1"},
+		{allocated: "10.0.3.1"},
+	}
+
+	res := mergeHostRecordAddrs(state, actual)
+
+	expected := []hostRecordAddr{
+		{cidr: "10.0.0.0/24", allocated: "10.0.0.5"},
+		{ipAddr: "10.0.1.1", allocated: "10.0.1.1"},
+		{cidr: "10.0.0.0/24", allocated: "10.0.0.7", hwAddr: "12:34:56:78:9a:bc", enableDhcp: true},
+		{ipAddr: "10.0.3.1", allocated: "10.0.3.1"},
+	}
+	if len(res) != len(expected) {
+		t.Fatalf("expected %d addresses, got %d", len(expected), len(res))
+	}
+	for i := range expected {
+		if *res[i] != expected[i] {
+			t.Errorf("address %d does not match: got %+v, expected %+v", i, *res[i], expected[i])
+		}
+	}
+}
+
+func TestPrepareHostRecordAddrs(t *testing.T) {
+	prev := []*hostRecordAddr{
+		{cidr: "10.0.0.0/24", allocated: "10.0.0.5"},
+		{ipAddr: "10.0.1.1", allocated: "10.0.1.1"},
+	}
+	addrs := []*hostRecordAddr{
+		{ipAddr: "10.0.2.1"},
+		{cidr: "10.0.0.0/24"},
+		{cidr: "10.0.0.0/24"},
+	}
+
+	values, err := prepareHostRecordAddrs(hostRecordAddrKindIPv4, "default", addrs, prev)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	expected := []string{"10.0.2.1", "10.0.0.5", "func:nextavailableip:10.0.0.0/24,default"}
+	for i := range expected {
+		if values[i] != expected[i] {
+			t.Errorf("value %d does not match: got '%s', expected '%s'", i, values[i], expected[i])
+		}
+	}
+
+	_, err = prepareHostRecordAddrs(
+		hostRecordAddrKindIPv6, "default", []*hostRecordAddr{{ipAddr: "10.0.0.1"}}, nil)
+	if err == nil {
+		t.Errorf("an error is expected for an IPv4 address in 'ipv6_address'")
+	}
+}
@@ -3,6 +3,7 @@ package infoblox
 import (
 	"encoding/json"
 	"fmt"
+	"sort"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -83,6 +84,12 @@ func resourceIPAllocation() *schema.Resource {
 				Required:    true,
 				Description: "The host name for Host Record in FQDN format.",
 			},
+			"aliases": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Alternative names of the host record, in FQDN format; every alias must belong to a zone of the record's DNS view.",
+			},
 			"ttl": {
 				Type:        schema.TypeInt,
 				Optional:    true,
@@ -156,6 +163,47 @@ func getOrFindHostRec(d *schema.ResourceData, m interface{}) (
 	return objMgr.SearchHostRecordByAltId(actualIntId.String(), ref, eaNameForInternalId)
 }
 
+// Returns the aliases of the host record, defined by the resource,
+// checking that every alias belongs to a zone of the DNS view.
+func getHostRecAliases(d *schema.ResourceData, connector ibclient.IBConnector) ([]string, error) {
+	aliases := make([]string, 0)
+	for _, alias := range d.Get("aliases").(*schema.Set).List() {
+		aliases = append(aliases, alias.(string))
+	}
+	if len(aliases) == 0 {
+		return aliases, nil
+	}
+	sort.Strings(aliases)
+
+	if !d.Get("enable_dns").(bool) {
+		return nil, fmt.Errorf("'aliases' may be defined only if 'enable_dns' is set to true")
+	}
+	dnsView := d.Get("dns_view").(string)
+	if dnsView == "" {
+		dnsView = defaultDNSView
+	}
+
+	for _, alias := range aliases {
+		labels := strings.Split(strings.TrimSuffix(alias, "."), ".")
+		found := false
+		for i := 0; i < len(labels) && !found; i++ {
+			zone := strings.Join(labels[i:], ".")
+			_, err := getZoneAuthRef(connector, zone, dnsView)
+			switch {
+			case err == nil:
+				found = true
+			case !isNotFoundError(err):
+				return nil, fmt.Errorf("failed getting the zone '%s' under DNS view '%s': %w", zone, dnsView, err)
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("alias '%s' does not belong to any zone of DNS view '%s'", alias, dnsView)
+		}
+	}
+
+	return aliases, nil
+}
+
 func resourceAllocationRequest(d *schema.ResourceData, m interface{}) error {
 	networkView := d.Get("network_view").(string)
 	dnsView := d.Get("dns_view").(string)
@@ -209,6 +257,11 @@ func resourceAllocationRequest(d *schema.ResourceData, m interface{}) error {
 	connector := m.(ibclient.IBConnector)
 	objMgr := ibclient.NewObjectManager(connector, "Terraform", tenantID)
 
+	aliases, err := getHostRecAliases(d, connector)
+	if err != nil {
+		return err
+	}
+
 	internalId := generateInternalId()
 	extAttrs[eaNameForInternalId] = internalId.String()
 
@@ -225,7 +278,7 @@ func resourceAllocationRequest(d *schema.ResourceData, m interface{}) error {
 		macAddr, "",
 		useTtl, ttl,
 		comment,
-		extAttrs, []string{})
+		extAttrs, aliases)
 	if err != nil {
 		return fmt.Errorf("error while creating a host record: %s", err.Error())
 	}
@@ -347,6 +400,10 @@ func resourceAllocationGet(d *schema.ResourceData, m interface{}) error {
 		return err
 	}
 
+	if err = d.Set("aliases", obj.Aliases); err != nil {
+		return err
+	}
+
 	if err = d.Set("ref", obj.Ref); err != nil {
 		return err
 	}
@@ -369,6 +426,7 @@ func resourceAllocationUpdate(d *schema.ResourceData, m interface{}) (err error)
 			prevIPv4CIDR, _ := d.GetChange("ipv4_cidr")
 			prevIPv6CIDR, _ := d.GetChange("ipv6_cidr")
 			prevEnableDNS, _ := d.GetChange("enable_dns")
+			prevAliases, _ := d.GetChange("aliases")
 			prevTTL, _ := d.GetChange("ttl")
 			prevComment, _ := d.GetChange("comment")
 			prevEa, _ := d.GetChange("ext_attrs")
@@ -381,6 +439,7 @@ func resourceAllocationUpdate(d *schema.ResourceData, m interface{}) (err error)
 			_ = d.Set("ipv4_cidr", prevIPv4CIDR.(string))
 			_ = d.Set("ipv6_cidr", prevIPv6CIDR.(string))
 			_ = d.Set("enable_dns", prevEnableDNS.(bool))
+			_ = d.Set("aliases", prevAliases.(*schema.Set))
 			_ = d.Set("ttl", prevTTL.(int))
 			_ = d.Set("comment", prevComment.(string))
 			_ = d.Set("ext_attrs", prevEa.(string))
@@ -469,6 +528,11 @@ func resourceAllocationUpdate(d *schema.ResourceData, m interface{}) (err error)
 	connector := m.(ibclient.IBConnector)
 	objMgr := ibclient.NewObjectManager(connector, "Terraform", tenantID)
 
+	aliases, err := getHostRecAliases(d, connector)
+	if err != nil {
+		return err
+	}
+
 	// Retrieve the IP of Host or Fixed Address record,
 	// when IP is allocated using CIDR and an empty IP is passed for update.
 	needIpv4Addr := ipv4Cidr == "" && ipv4Addr == ""
@@ -524,12 +588,29 @@ func resourceAllocationUpdate(d *schema.ResourceData, m interface{}) (err error)
 		macAddr, duid,
 		useTtl, ttl,
 		comment,
-		extAttrs, []string{})
+		extAttrs, aliases)
 	if err != nil {
 		return fmt.Errorf(
 			"error while updating the host record with ID '%s': %s", d.Id(), err.Error())
 	}
+
+	// An empty list of aliases is omitted by UpdateHostRecord(),
+	// thus the aliases are to be removed separately.
+	if len(aliases) == 0 && len(hostRecObj.Aliases) > 0 {
+		clearAliases := newGenericRecord(
+			hostRecObj.ObjectType(), nil, map[string]interface{}{"aliases": []string{}})
+		ref, err := connector.UpdateObject(clearAliases, hostRecObj.Ref)
+		if err != nil {
+			return fmt.Errorf(
+				"error while removing aliases of the host record with ID '%s': %w", d.Id(), err)
+		}
+		hostRecObj.Ref = ref
+		hostRecObj.Aliases = nil
+	}
 	updateSuccessful = true
+	if err = d.Set("aliases", hostRecObj.Aliases); err != nil {
+		return err
+	}
 	if err = d.Set("ref", hostRecObj.Ref); err != nil {
 		return err
 	}
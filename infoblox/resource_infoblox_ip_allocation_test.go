@@ -2,6 +2,7 @@ package infoblox
 
 import (
 	"fmt"
+	"regexp"
 	"sort"
 	"testing"
 
@@ -157,6 +158,23 @@ func validateIPAllocation(
 				ipAlloc.Comment, expComment)
 		}
 
+		expAliases := append([]string{}, expectedValue.Aliases...)
+		actualAliases := append([]string{}, ipAlloc.Aliases...)
+		if len(expAliases) != len(actualAliases) {
+			return fmt.Errorf(
+				"the number of aliases is '%d', but expected '%d'",
+				len(actualAliases), len(expAliases))
+		}
+		sort.Strings(expAliases)
+		sort.Strings(actualAliases)
+		for i, alias := range expAliases {
+			if actualAliases[i] != alias {
+				return fmt.Errorf(
+					"the alias '%s' is not expected, expected '%s'",
+					actualAliases[i], alias)
+			}
+		}
+
 		expV4Addrs := expectedValue.Ipv4Addrs
 		actualV4Addrs := ipAlloc.Ipv4Addrs
 		if expV4Addrs == nil && actualV4Addrs != nil || expV4Addrs != nil && actualV4Addrs == nil {
@@ -388,6 +406,95 @@ func TestAcc_resourceIPAllocation(t *testing.T) {
 					},
 				),
 			},
+			{
+				Config: `
+				resource "infoblox_ip_allocation" "foo3"{
+					network_view="default"
+					dns_view = "default"
+					fqdn="testhostnameip2.test.com"
+					ipv4_addr="10.0.0.2"
+					aliases = ["alias1.test.com", "alias2.test.com"]
+					comment = "IPv4 and IPv6 are allocated"
+					ext_attrs = jsonencode({
+						"VM Name" =  "tf-ec2-instance"
+						"Tenant ID" = "terraform_test_tenant"
+						Location = "Test loc."
+						Site = "Test site"
+					  })
+					}`,
+				Check: validateIPAllocation(
+					"infoblox_ip_allocation.foo3",
+					&ibclient.HostRecord{
+						NetworkView: "default",
+						View:        "default",
+						EnableDns:   true,
+						Name:        "testhostnameip2.test.com",
+						Ipv4Addrs:   []ibclient.HostRecordIpv4Addr{*ibclient.NewHostRecordIpv4Addr("10.0.0.2", "", false, "")},
+						Aliases:     []string{"alias1.test.com", "alias2.test.com"},
+						Comment:     "IPv4 and IPv6 are allocated",
+						Ea: ibclient.EA{
+							"Tenant ID": "terraform_test_tenant",
+							"VM Name":   "tf-ec2-instance",
+							"Location":  "Test loc.",
+							"Site":      "Test site",
+						},
+					},
+				),
+			},
+			{
+				Config: `
+				resource "infoblox_ip_allocation" "foo3"{
+					network_view="default"
+					dns_view = "default"
+					fqdn="testhostnameip2.test.com"
+					ipv4_addr="10.0.0.2"
+					comment = "IPv4 and IPv6 are allocated"
+					ext_attrs = jsonencode({
+						"VM Name" =  "tf-ec2-instance"
+						"Tenant ID" = "terraform_test_tenant"
+						Location = "Test loc."
+						Site = "Test site"
+					  })
+					}`,
+				Check: validateIPAllocation(
+					"infoblox_ip_allocation.foo3",
+					&ibclient.HostRecord{
+						NetworkView: "default",
+						View:        "default",
+						EnableDns:   true,
+						Name:        "testhostnameip2.test.com",
+						Ipv4Addrs:   []ibclient.HostRecordIpv4Addr{*ibclient.NewHostRecordIpv4Addr("10.0.0.2", "", false, "")},
+						Comment:     "IPv4 and IPv6 are allocated",
+						Ea: ibclient.EA{
+							"Tenant ID": "terraform_test_tenant",
+							"VM Name":   "tf-ec2-instance",
+							"Location":  "Test loc.",
+							"Site":      "Test site",
+						},
+					},
+				),
+			},
+
+			// negative test cases
+			{
+				Config: `
+				resource "infoblox_ip_allocation" "foo3"{
+					network_view="default"
+					dns_view = "default"
+					fqdn="testhostnameip2.test.com"
+					ipv4_addr="10.0.0.2"
+					aliases = ["alias1.nonexistent-zone.org"]
+					comment = "IPv4 and IPv6 are allocated"
+					ext_attrs = jsonencode({
+						"VM Name" =  "tf-ec2-instance"
+						"Tenant ID" = "terraform_test_tenant"
+						Location = "Test loc."
+						Site = "Test site"
+					  })
+					}`,
+				ExpectError: regexp.MustCompile(
+					"alias 'alias1.nonexistent-zone.org' does not belong to any zone of DNS view 'default'"),
+			},
 		},
 	})
 }
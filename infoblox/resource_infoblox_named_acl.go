@@ -0,0 +1,354 @@
+package infoblox
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+)
+
+const defaultTsigKeyAlg = "HMAC-MD5"
+
+func aclItemSchemaElem() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"address": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "",
+				Description: "An IP address, a network in CIDR format or a range of IP addresses;" +
+					" 'Any' means any address.",
+			},
+			"permission": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "ALLOW",
+				Description: "The permission for the address: 'ALLOW' or 'DENY'.",
+			},
+			"tsig_key_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "The name of a TSIG key.",
+			},
+			"tsig_key": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Sensitive:   true,
+				Description: "The secret of the TSIG key, in base64 format.",
+			},
+			"tsig_key_alg": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     defaultTsigKeyAlg,
+				Description: "The algorithm of the TSIG key: 'HMAC-MD5' or 'HMAC-SHA256'.",
+			},
+			"named_acl": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "The name of a named ACL.",
+			},
+		},
+	}
+}
+
+// Returns the name of a named ACL, which is a part of the object's reference.
+func namedACLNameFromRef(ref string) string {
+	refParts := strings.SplitN(ref, ":", 2)
+	if len(refParts) < 2 {
+		return ref
+	}
+	return refParts[1]
+}
+
+func getNamedACLRef(connector ibclient.IBConnector, name string) (string, error) {
+	var res []namedACL
+	sf := map[string]string{
+		"name": name,
+	}
+	err := connector.GetObject(newNamedACL(namedACL{}), "", ibclient.NewQueryParams(false, sf), &res)
+	if err != nil {
+		return "", fmt.Errorf("cannot find the named ACL '%s': %w", name, err)
+	}
+	if len(res) == 0 {
+		return "", fmt.Errorf("cannot find the named ACL '%s'", name)
+	}
+
+	return res[0].Ref, nil
+}
+
+func convertACLItemsToInterface(items []aclItem) []map[string]interface{} {
+	res := make([]map[string]interface{}, 0, len(items))
+	for _, item := range items {
+		itemMap := map[string]interface{}{
+			"address":       "",
+			"permission":    "ALLOW",
+			"tsig_key_name": "",
+			"tsig_key":      "",
+			"tsig_key_alg":  defaultTsigKeyAlg,
+			"named_acl":     "",
+		}
+		switch {
+		case item.AddressAC != nil:
+			itemMap["address"] = item.AddressAC.Address
+			itemMap["permission"] = item.AddressAC.Permission
+		case item.TsigAC != nil:
+			itemMap["tsig_key_name"] = item.TsigAC.TsigKeyName
+			itemMap["tsig_key"] = item.TsigAC.TsigKey
+			itemMap["tsig_key_alg"] = item.TsigAC.TsigKeyAlg
+		default:
+			itemMap["named_acl"] = namedACLNameFromRef(item.NamedACLRef)
+		}
+		res = append(res, itemMap)
+	}
+
+	return res
+}
+
+func convertInterfaceToACLItems(
+	connector ibclient.IBConnector, fieldName string, items []interface{}) ([]aclItem, error) {
+
+	res := make([]aclItem, 0, len(items))
+	for _, itemInf := range items {
+		itemMap := itemInf.(map[string]interface{})
+		address := itemMap["address"].(string)
+		permission := itemMap["permission"].(string)
+		tsigKeyName := itemMap["tsig_key_name"].(string)
+		tsigKey := itemMap["tsig_key"].(string)
+		tsigKeyAlg := itemMap["tsig_key_alg"].(string)
+		aclName := itemMap["named_acl"].(string)
+
+		numDefined := 0
+		for _, val := range []string{address, tsigKeyName, aclName} {
+			if val != "" {
+				numDefined++
+			}
+		}
+		if numDefined != 1 {
+			return nil, fmt.Errorf(
+				"exactly one of 'address', 'tsig_key_name' and 'named_acl' must be defined for every item of '%s'",
+				fieldName)
+		}
+
+		switch {
+		case aclName != "":
+			ref, err := getNamedACLRef(connector, aclName)
+			if err != nil {
+				return nil, err
+			}
+			res = append(res, aclItem{NamedACLRef: ref})
+		case tsigKeyName != "":
+			if tsigKey == "" {
+				return nil, fmt.Errorf(
+					"'tsig_key' must not be empty for a TSIG key item of '%s'", fieldName)
+			}
+			if tsigKeyAlg != "HMAC-MD5" && tsigKeyAlg != "HMAC-SHA256" {
+				return nil, fmt.Errorf(
+					"'tsig_key_alg' must be either 'HMAC-MD5' or 'HMAC-SHA256' for every item of '%s'", fieldName)
+			}
+			res = append(res, aclItem{TsigAC: &tsigAC{
+				TsigKey:     tsigKey,
+				TsigKeyAlg:  tsigKeyAlg,
+				TsigKeyName: tsigKeyName,
+			}})
+		default:
+			if permission != "ALLOW" && permission != "DENY" {
+				return nil, fmt.Errorf(
+					"'permission' must be either 'ALLOW' or 'DENY' for every item of '%s'", fieldName)
+			}
+			res = append(res, aclItem{AddressAC: &addressAC{
+				Address:    address,
+				Permission: permission,
+			}})
+		}
+	}
+
+	return res, nil
+}
+
+func resourceNamedACL() *schema.Resource {
+	return &schema.Resource{
+		Create:   resourceNamedACLCreate,
+		Read:     resourceNamedACLRead,
+		Update:   resourceNamedACLUpdate,
+		Delete:   resourceNamedACLDelete,
+		Importer: &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the named ACL.",
+			},
+			"access_list": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "The list of IP addresses, networks, TSIG keys and other named ACLs which the ACL consists of.",
+				Elem:        aclItemSchemaElem(),
+			},
+			"comment": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "Description of the named ACL.",
+			},
+			"ext_attrs": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "Extensible attributes of the named ACL to be added/updated, as a map in JSON format.",
+			},
+		},
+	}
+}
+
+func resourceNamedACLCreate(d *schema.ResourceData, m interface{}) error {
+	name := d.Get("name").(string)
+	if name == "" {
+		return fmt.Errorf("'name' must not be empty")
+	}
+
+	connector := m.(ibclient.IBConnector)
+
+	accessList, err := convertInterfaceToACLItems(
+		connector, "access_list", d.Get("access_list").([]interface{}))
+	if err != nil {
+		return err
+	}
+
+	comment := d.Get("comment").(string)
+
+	extAttrJSON := d.Get("ext_attrs").(string)
+	extAttrs := make(map[string]interface{})
+	if extAttrJSON != "" {
+		if err := json.Unmarshal([]byte(extAttrJSON), &extAttrs); err != nil {
+			return fmt.Errorf("cannot process 'ext_attrs' field: %w", err)
+		}
+	}
+
+	acl := newNamedACL(namedACL{
+		Name:       name,
+		AccessList: accessList,
+		Comment:    comment,
+		Ea:         extAttrs,
+	})
+
+	ref, err := connector.CreateObject(acl)
+	if err != nil {
+		return fmt.Errorf("creation of the named ACL '%s' failed: %w", name, err)
+	}
+	d.SetId(ref)
+
+	return nil
+}
+
+func resourceNamedACLRead(d *schema.ResourceData, m interface{}) error {
+	connector := m.(ibclient.IBConnector)
+
+	obj := newNamedACL(namedACL{})
+	if err := connector.GetObject(obj, d.Id(), ibclient.NewQueryParams(false, nil), obj); err != nil {
+		return fmt.Errorf("failed getting the named ACL: %w", err)
+	}
+
+	if obj.Ea != nil && len(obj.Ea) > 0 {
+		// TODO: temporary scaffold, need to rework marshalling/unmarshalling of EAs
+		//       (avoiding additional layer of keys ("value" key)
+		eaMap := (map[string]interface{})(obj.Ea)
+		ea, err := json.Marshal(eaMap)
+		if err != nil {
+			return err
+		}
+		if err = d.Set("ext_attrs", string(ea)); err != nil {
+			return err
+		}
+	}
+
+	if err := d.Set("name", obj.Name); err != nil {
+		return err
+	}
+	if err := d.Set("access_list", convertACLItemsToInterface(obj.AccessList)); err != nil {
+		return err
+	}
+	if err := d.Set("comment", obj.Comment); err != nil {
+		return err
+	}
+
+	d.SetId(obj.Ref)
+
+	return nil
+}
+
+func resourceNamedACLUpdate(d *schema.ResourceData, m interface{}) error {
+	var updateSuccessful bool
+	defer func() {
+		// Reverting the state back, in case of a failure,
+		// otherwise Terraform will keep the values, which leaded to the failure,
+		// in the state file.
+		if !updateSuccessful {
+			prevName, _ := d.GetChange("name")
+			prevAccessList, _ := d.GetChange("access_list")
+			prevComment, _ := d.GetChange("comment")
+			prevEa, _ := d.GetChange("ext_attrs")
+
+			_ = d.Set("name", prevName.(string))
+			_ = d.Set("access_list", prevAccessList.([]interface{}))
+			_ = d.Set("comment", prevComment.(string))
+			_ = d.Set("ext_attrs", prevEa.(string))
+		}
+	}()
+
+	name := d.Get("name").(string)
+	if name == "" {
+		return fmt.Errorf("'name' must not be empty")
+	}
+
+	connector := m.(ibclient.IBConnector)
+
+	accessList, err := convertInterfaceToACLItems(
+		connector, "access_list", d.Get("access_list").([]interface{}))
+	if err != nil {
+		return err
+	}
+
+	comment := d.Get("comment").(string)
+
+	extAttrJSON := d.Get("ext_attrs").(string)
+	extAttrs := make(map[string]interface{})
+	if extAttrJSON != "" {
+		if err := json.Unmarshal([]byte(extAttrJSON), &extAttrs); err != nil {
+			return fmt.Errorf("cannot process 'ext_attrs' field: %w", err)
+		}
+	}
+
+	acl := newNamedACL(namedACL{
+		Name:       name,
+		AccessList: accessList,
+		Comment:    comment,
+		Ea:         extAttrs,
+	})
+
+	ref, err := connector.UpdateObject(acl, d.Id())
+	if err != nil {
+		return fmt.Errorf("error updating the named ACL: %w", err)
+	}
+	updateSuccessful = true
+	d.SetId(ref)
+
+	return nil
+}
+
+func resourceNamedACLDelete(d *schema.ResourceData, m interface{}) error {
+	connector := m.(ibclient.IBConnector)
+
+	if _, err := connector.DeleteObject(d.Id()); err != nil {
+		return fmt.Errorf("deletion of the named ACL failed: %w", err)
+	}
+	d.SetId("")
+
+	return nil
+}
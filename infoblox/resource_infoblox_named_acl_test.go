@@ -0,0 +1,207 @@
+package infoblox
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+)
+
+func testAccCheckNamedACLDestroy(s *terraform.State) error {
+	meta := testAccProvider.Meta()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "infoblox_named_acl" {
+			continue
+		}
+		connector := meta.(ibclient.IBConnector)
+		acl := newNamedACL(namedACL{})
+		err := connector.GetObject(acl, rs.Primary.ID, ibclient.NewQueryParams(false, nil), acl)
+		if err == nil {
+			return fmt.Errorf("named ACL still exists")
+		}
+	}
+	return nil
+}
+
+// Compares two ACL items; a named ACL reference of the expected item
+// is the name of the ACL, not the full object's reference.
+func compareACLItems(actual, expected aclItem) bool {
+	switch {
+	case expected.AddressAC != nil:
+		return actual.AddressAC != nil && *actual.AddressAC == *expected.AddressAC
+	case expected.TsigAC != nil:
+		return actual.TsigAC != nil &&
+			actual.TsigAC.TsigKeyName == expected.TsigAC.TsigKeyName &&
+			actual.TsigAC.TsigKeyAlg == expected.TsigAC.TsigKeyAlg
+	default:
+		return actual.AddressAC == nil && actual.TsigAC == nil &&
+			namedACLNameFromRef(actual.NamedACLRef) == expected.NamedACLRef
+	}
+}
+
+func testAccNamedACLCompare(t *testing.T, resPath string, expectedACL *namedACL) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		res, found := s.RootModule().Resources[resPath]
+		if !found {
+			return fmt.Errorf("not found: %s", resPath)
+		}
+		if res.Primary.ID == "" {
+			return fmt.Errorf("ID is not set")
+		}
+		meta := testAccProvider.Meta()
+		connector := meta.(ibclient.IBConnector)
+
+		acl := newNamedACL(namedACL{})
+		if err := connector.GetObject(acl, res.Primary.ID, ibclient.NewQueryParams(false, nil), acl); err != nil {
+			return fmt.Errorf("named ACL not found: %s", err)
+		}
+
+		if acl.Name != expectedACL.Name {
+			return fmt.Errorf(
+				"'name' does not match: got '%s', expected '%s'",
+				acl.Name, expectedACL.Name)
+		}
+		if len(acl.AccessList) != len(expectedACL.AccessList) {
+			return fmt.Errorf(
+				"the number of items in 'access_list' does not match: got '%d', expected '%d'",
+				len(acl.AccessList), len(expectedACL.AccessList))
+		}
+		for i, item := range expectedACL.AccessList {
+			if !compareACLItems(acl.AccessList[i], item) {
+				return fmt.Errorf(
+					"item #%d of 'access_list' does not match the expected one", i)
+			}
+		}
+		if acl.Comment != expectedACL.Comment {
+			return fmt.Errorf(
+				"'comment' does not match: got '%s', expected '%s'",
+				acl.Comment, expectedACL.Comment)
+		}
+		return validateEAs(acl.Ea, expectedACL.Ea)
+	}
+}
+
+func TestAccResourceNamedACL(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckNamedACLDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "infoblox_named_acl" "foo"{
+						name = "acl-office"
+						access_list {
+							address = "10.1.0.0/16"
+						}
+						access_list {
+							address = "10.1.1.1"
+							permission = "DENY"
+						}
+					}`,
+				Check: resource.ComposeTestCheckFunc(
+					testAccNamedACLCompare(t, "infoblox_named_acl.foo", &namedACL{
+						Name: "acl-office",
+						AccessList: []aclItem{
+							{AddressAC: &addressAC{Address: "10.1.0.0/16", Permission: "ALLOW"}},
+							{AddressAC: &addressAC{Address: "10.1.1.1", Permission: "DENY"}},
+						},
+					}),
+				),
+			},
+			{
+				Config: `
+					resource "infoblox_named_acl" "foo"{
+						name = "acl-office"
+						access_list {
+							address = "10.1.0.0/16"
+						}
+						access_list {
+							tsig_key_name = "office-key"
+							tsig_key = "bWluaW1hbC1zZWNyZXQta2V5"
+							tsig_key_alg = "HMAC-SHA256"
+						}
+						comment = "test comment 1"
+						ext_attrs = jsonencode({
+							"Location" = "Los Angeles"
+						})
+					}
+
+					resource "infoblox_named_acl" "bar"{
+						name = "acl-transfer"
+						access_list {
+							named_acl = infoblox_named_acl.foo.name
+						}
+						access_list {
+							address = "192.168.10.0/24"
+						}
+					}
+
+					resource "infoblox_zone_auth" "zone"{
+						fqdn = "zone-acl.test.com"
+						allow_transfer {
+							named_acl = infoblox_named_acl.bar.name
+						}
+						allow_update {
+							named_acl = infoblox_named_acl.foo.name
+						}
+					}`,
+				Check: resource.ComposeTestCheckFunc(
+					testAccNamedACLCompare(t, "infoblox_named_acl.foo", &namedACL{
+						Name: "acl-office",
+						AccessList: []aclItem{
+							{AddressAC: &addressAC{Address: "10.1.0.0/16", Permission: "ALLOW"}},
+							{TsigAC: &tsigAC{TsigKeyName: "office-key", TsigKeyAlg: "HMAC-SHA256"}},
+						},
+						Comment: "test comment 1",
+						Ea: ibclient.EA{
+							"Location": "Los Angeles",
+						},
+					}),
+					testAccNamedACLCompare(t, "infoblox_named_acl.bar", &namedACL{
+						Name: "acl-transfer",
+						AccessList: []aclItem{
+							{NamedACLRef: "acl-office"},
+							{AddressAC: &addressAC{Address: "192.168.10.0/24", Permission: "ALLOW"}},
+						},
+					}),
+					resource.TestCheckResourceAttr("infoblox_zone_auth.zone", "allow_transfer.0.named_acl", "acl-transfer"),
+					resource.TestCheckResourceAttr("infoblox_zone_auth.zone", "allow_update.0.named_acl", "acl-office"),
+				),
+			},
+			{
+				ResourceName:      "infoblox_named_acl.bar",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+
+			// negative test cases
+			{
+				Config: `
+					resource "infoblox_named_acl" "foo2"{
+						name = "acl-invalid"
+						access_list {
+							address = "10.2.0.0/16"
+							tsig_key_name = "office-key"
+						}
+					}`,
+				ExpectError: regexp.MustCompile(
+					"exactly one of 'address', 'tsig_key_name' and 'named_acl' must be defined for every item of 'access_list'"),
+			},
+			{
+				Config: `
+					resource "infoblox_named_acl" "foo2"{
+						name = "acl-invalid"
+						access_list {
+							tsig_key_name = "office-key"
+						}
+					}`,
+				ExpectError: regexp.MustCompile("'tsig_key' must not be empty for a TSIG key item of 'access_list'"),
+			},
+		},
+	})
+}
@@ -0,0 +1,301 @@
+package infoblox
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+)
+
+func resourceNAPTRRecord() *schema.Resource {
+	return &schema.Resource{
+		Create:   resourceNAPTRRecordCreate,
+		Read:     resourceNAPTRRecordGet,
+		Update:   resourceNAPTRRecordUpdate,
+		Delete:   resourceNAPTRRecordDelete,
+		Importer: &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			"dns_view": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     defaultDNSView,
+				Description: "DNS view which the zone does exist within.",
+			},
+			"fqdn": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "FQDN for the NAPTR-record.",
+			},
+			"order": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				Description: "Configures the order (0-65535) in which the NAPTR-records must be processed.",
+			},
+			"preference": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				Description: "Configures the preference (0-65535) among the NAPTR-records with the same order.",
+			},
+			"flags": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "The flags which control the interpretation of the fields: 'U', 'S', 'A', 'P' or empty.",
+			},
+			"services": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "The services and protocols available down the rewrite path, ex. 'E2U+sip'.",
+			},
+			"regexp": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "The regular expression-based rewrite rule which is applied to the original string.",
+			},
+			"replacement": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     ".",
+				Description: "The next domain name to look up; '.' means there is no replacement.",
+			},
+			"ttl": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     ttlUndef,
+				Description: "TTL value for the NAPTR-record.",
+			},
+			"comment": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "Description of the NAPTR-record.",
+			},
+			"ext_attrs": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "Extensible attributes of the NAPTR-record to be added/updated, as a map in JSON format.",
+			},
+		},
+	}
+}
+
+// Builds a NAPTR-record object out of the resource's fields,
+// except 'dns_view' which cannot be changed once the record is created.
+func buildNAPTRRecord(d *schema.ResourceData) (*recordNAPTR, error) {
+	fqdn := d.Get("fqdn").(string)
+	if fqdn == "" {
+		return nil, fmt.Errorf("'fqdn' must not be empty")
+	}
+
+	tempInt := d.Get("order").(int)
+	if err := ibclient.CheckIntRange("order", tempInt, 0, 65535); err != nil {
+		return nil, err
+	}
+	order := uint32(tempInt)
+
+	tempInt = d.Get("preference").(int)
+	if err := ibclient.CheckIntRange("preference", tempInt, 0, 65535); err != nil {
+		return nil, err
+	}
+	preference := uint32(tempInt)
+
+	flags := d.Get("flags").(string)
+	switch flags {
+	case "", "U", "S", "A", "P":
+	default:
+		return nil, fmt.Errorf("'flags' must be one of 'U', 'S', 'A', 'P' or empty")
+	}
+
+	services := d.Get("services").(string)
+	regexp := d.Get("regexp").(string)
+	replacement := d.Get("replacement").(string)
+	if replacement == "" {
+		return nil, fmt.Errorf("'replacement' must not be empty, use '.' if there is no replacement")
+	}
+	if regexp != "" && replacement != "." {
+		return nil, fmt.Errorf("'regexp' and 'replacement' must not be set together")
+	}
+
+	var ttl uint32
+	useTtl := false
+	tempTTL := d.Get("ttl").(int)
+	if tempTTL >= 0 {
+		useTtl = true
+		ttl = uint32(tempTTL)
+	} else if tempTTL != ttlUndef {
+		return nil, fmt.Errorf("TTL value must be 0 or higher")
+	}
+
+	comment := d.Get("comment").(string)
+
+	extAttrJSON := d.Get("ext_attrs").(string)
+	extAttrs := make(map[string]interface{})
+	if extAttrJSON != "" {
+		if err := json.Unmarshal([]byte(extAttrJSON), &extAttrs); err != nil {
+			return nil, fmt.Errorf("cannot process 'ext_attrs' field: %w", err)
+		}
+	}
+
+	return newRecordNAPTR(recordNAPTR{
+		Name:        fqdn,
+		Order:       order,
+		Preference:  preference,
+		Flags:       flags,
+		Services:    services,
+		Regexp:      regexp,
+		Replacement: replacement,
+		Ttl:         ttl,
+		UseTtl:      useTtl,
+		Comment:     comment,
+		Ea:          extAttrs,
+	}), nil
+}
+
+func resourceNAPTRRecordCreate(d *schema.ResourceData, m interface{}) error {
+	rec, err := buildNAPTRRecord(d)
+	if err != nil {
+		return err
+	}
+	rec.View = d.Get("dns_view").(string)
+
+	connector := m.(ibclient.IBConnector)
+	ref, err := connector.CreateObject(rec)
+	if err != nil {
+		return fmt.Errorf("error creating NAPTR-record: %w", err)
+	}
+	d.SetId(ref)
+
+	return nil
+}
+
+func resourceNAPTRRecordGet(d *schema.ResourceData, m interface{}) error {
+	connector := m.(ibclient.IBConnector)
+
+	obj := newRecordNAPTR(recordNAPTR{})
+	if err := connector.GetObject(obj, d.Id(), ibclient.NewQueryParams(false, nil), obj); err != nil {
+		return fmt.Errorf("failed getting NAPTR-record: %w", err)
+	}
+
+	ttl := int(obj.Ttl)
+	if !obj.UseTtl {
+		ttl = ttlUndef
+	}
+	if err := d.Set("ttl", ttl); err != nil {
+		return err
+	}
+
+	if obj.Ea != nil && len(obj.Ea) > 0 {
+		// TODO: temporary scaffold, need to rework marshalling/unmarshalling of EAs
+		//       (avoiding additional layer of keys ("value" key)
+		eaMap := (map[string]interface{})(obj.Ea)
+		ea, err := json.Marshal(eaMap)
+		if err != nil {
+			return err
+		}
+		if err = d.Set("ext_attrs", string(ea)); err != nil {
+			return err
+		}
+	}
+
+	if err := d.Set("comment", obj.Comment); err != nil {
+		return err
+	}
+	if err := d.Set("dns_view", obj.View); err != nil {
+		return err
+	}
+	if err := d.Set("fqdn", obj.Name); err != nil {
+		return err
+	}
+	if err := d.Set("order", int(obj.Order)); err != nil {
+		return err
+	}
+	if err := d.Set("preference", int(obj.Preference)); err != nil {
+		return err
+	}
+	if err := d.Set("flags", obj.Flags); err != nil {
+		return err
+	}
+	if err := d.Set("services", obj.Services); err != nil {
+		return err
+	}
+	if err := d.Set("regexp", obj.Regexp); err != nil {
+		return err
+	}
+	if err := d.Set("replacement", obj.Replacement); err != nil {
+		return err
+	}
+
+	d.SetId(obj.Ref)
+
+	return nil
+}
+
+func resourceNAPTRRecordUpdate(d *schema.ResourceData, m interface{}) error {
+	var updateSuccessful bool
+	defer func() {
+		// Reverting the state back, in case of a failure,
+		// otherwise Terraform will keep the values, which leaded to the failure,
+		// in the state file.
+		if !updateSuccessful {
+			prevDNSView, _ := d.GetChange("dns_view")
+			prevFQDN, _ := d.GetChange("fqdn")
+			prevOrder, _ := d.GetChange("order")
+			prevPreference, _ := d.GetChange("preference")
+			prevFlags, _ := d.GetChange("flags")
+			prevServices, _ := d.GetChange("services")
+			prevRegexp, _ := d.GetChange("regexp")
+			prevReplacement, _ := d.GetChange("replacement")
+			prevTTL, _ := d.GetChange("ttl")
+			prevComment, _ := d.GetChange("comment")
+			prevEa, _ := d.GetChange("ext_attrs")
+
+			_ = d.Set("dns_view", prevDNSView.(string))
+			_ = d.Set("fqdn", prevFQDN.(string))
+			_ = d.Set("order", prevOrder.(int))
+			_ = d.Set("preference", prevPreference.(int))
+			_ = d.Set("flags", prevFlags.(string))
+			_ = d.Set("services", prevServices.(string))
+			_ = d.Set("regexp", prevRegexp.(string))
+			_ = d.Set("replacement", prevReplacement.(string))
+			_ = d.Set("ttl", prevTTL.(int))
+			_ = d.Set("comment", prevComment.(string))
+			_ = d.Set("ext_attrs", prevEa.(string))
+		}
+	}()
+
+	if d.HasChange("dns_view") {
+		return fmt.Errorf("changing the value of 'dns_view' field is not allowed")
+	}
+
+	rec, err := buildNAPTRRecord(d)
+	if err != nil {
+		return err
+	}
+
+	connector := m.(ibclient.IBConnector)
+	ref, err := connector.UpdateObject(rec, d.Id())
+	if err != nil {
+		return fmt.Errorf("error updating NAPTR-record: %w", err)
+	}
+	updateSuccessful = true
+	d.SetId(ref)
+
+	return nil
+}
+
+func resourceNAPTRRecordDelete(d *schema.ResourceData, m interface{}) error {
+	connector := m.(ibclient.IBConnector)
+
+	if _, err := connector.DeleteObject(d.Id()); err != nil {
+		return fmt.Errorf("deletion of NAPTR-record failed: %w", err)
+	}
+	d.SetId("")
+
+	return nil
+}
@@ -0,0 +1,198 @@
+package infoblox
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+)
+
+func testAccCheckNAPTRRecordDestroy(s *terraform.State) error {
+	meta := testAccProvider.Meta()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "infoblox_naptr_record" {
+			continue
+		}
+		connector := meta.(ibclient.IBConnector)
+		rec := newRecordNAPTR(recordNAPTR{})
+		err := connector.GetObject(rec, rs.Primary.ID, ibclient.NewQueryParams(false, nil), rec)
+		if err == nil {
+			return fmt.Errorf("NAPTR-record still exists")
+		}
+	}
+	return nil
+}
+
+func testAccNAPTRRecordCompare(t *testing.T, resPath string, expectedRec *recordNAPTR) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		res, found := s.RootModule().Resources[resPath]
+		if !found {
+			return fmt.Errorf("not found: %s", resPath)
+		}
+		if res.Primary.ID == "" {
+			return fmt.Errorf("ID is not set")
+		}
+		meta := testAccProvider.Meta()
+		connector := meta.(ibclient.IBConnector)
+
+		rec := newRecordNAPTR(recordNAPTR{})
+		if err := connector.GetObject(rec, res.Primary.ID, ibclient.NewQueryParams(false, nil), rec); err != nil {
+			return fmt.Errorf("NAPTR-record not found: %s", err)
+		}
+
+		if rec.Name != expectedRec.Name {
+			return fmt.Errorf(
+				"'fqdn' does not match: got '%s', expected '%s'",
+				rec.Name, expectedRec.Name)
+		}
+		if rec.View != expectedRec.View {
+			return fmt.Errorf(
+				"'dns_view' does not match: got '%s', expected '%s'",
+				rec.View, expectedRec.View)
+		}
+		if rec.Order != expectedRec.Order {
+			return fmt.Errorf(
+				"'order' does not match: got '%d', expected '%d'",
+				rec.Order, expectedRec.Order)
+		}
+		if rec.Preference != expectedRec.Preference {
+			return fmt.Errorf(
+				"'preference' does not match: got '%d', expected '%d'",
+				rec.Preference, expectedRec.Preference)
+		}
+		if rec.Flags != expectedRec.Flags {
+			return fmt.Errorf(
+				"'flags' does not match: got '%s', expected '%s'",
+				rec.Flags, expectedRec.Flags)
+		}
+		if rec.Services != expectedRec.Services {
+			return fmt.Errorf(
+				"'services' does not match: got '%s', expected '%s'",
+				rec.Services, expectedRec.Services)
+		}
+		if rec.Regexp != expectedRec.Regexp {
+			return fmt.Errorf(
+				"'regexp' does not match: got '%s', expected '%s'",
+				rec.Regexp, expectedRec.Regexp)
+		}
+		if rec.Replacement != expectedRec.Replacement {
+			return fmt.Errorf(
+				"'replacement' does not match: got '%s', expected '%s'",
+				rec.Replacement, expectedRec.Replacement)
+		}
+		if rec.UseTtl != expectedRec.UseTtl {
+			return fmt.Errorf(
+				"TTL usage does not match: got '%t', expected '%t'",
+				rec.UseTtl, expectedRec.UseTtl)
+		}
+		if rec.UseTtl && rec.Ttl != expectedRec.Ttl {
+			return fmt.Errorf(
+				"'ttl' does not match: got '%d', expected '%d'",
+				rec.Ttl, expectedRec.Ttl)
+		}
+		if rec.Comment != expectedRec.Comment {
+			return fmt.Errorf(
+				"'comment' does not match: got '%s', expected '%s'",
+				rec.Comment, expectedRec.Comment)
+		}
+		return validateEAs(rec.Ea, expectedRec.Ea)
+	}
+}
+
+func TestAccResourceNAPTRRecord(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckNAPTRRecordDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "infoblox_naptr_record" "foo"{
+						fqdn = "voip.test.com"
+						order = 100
+						preference = 10
+						flags = "S"
+						services = "SIP+D2U"
+						replacement = "_sip._udp.test.com"
+					}`,
+				Check: resource.ComposeTestCheckFunc(
+					testAccNAPTRRecordCompare(t, "infoblox_naptr_record.foo", &recordNAPTR{
+						Name:        "voip.test.com",
+						View:        "default",
+						Order:       100,
+						Preference:  10,
+						Flags:       "S",
+						Services:    "SIP+D2U",
+						Replacement: "_sip._udp.test.com",
+					}),
+				),
+			},
+			{
+				Config: `
+					resource "infoblox_naptr_record" "foo"{
+						fqdn = "voip.test.com"
+						order = 100
+						preference = 20
+						flags = "U"
+						services = "E2U+sip"
+						regexp = "!^.*$!sip:info@test.com!"
+						ttl = 300
+						comment = "test comment 1"
+						ext_attrs = jsonencode({
+							"Location" = "Los Angeles"
+						})
+					}`,
+				Check: resource.ComposeTestCheckFunc(
+					testAccNAPTRRecordCompare(t, "infoblox_naptr_record.foo", &recordNAPTR{
+						Name:        "voip.test.com",
+						View:        "default",
+						Order:       100,
+						Preference:  20,
+						Flags:       "U",
+						Services:    "E2U+sip",
+						Regexp:      "!^.*$!sip:info@test.com!",
+						Replacement: ".",
+						Ttl:         300,
+						UseTtl:      true,
+						Comment:     "test comment 1",
+						Ea: ibclient.EA{
+							"Location": "Los Angeles",
+						},
+					}),
+				),
+			},
+			{
+				ResourceName:      "infoblox_naptr_record.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+
+			// negative test cases
+			{
+				Config: `
+					resource "infoblox_naptr_record" "foo"{
+						fqdn = "voip.test.com"
+						order = 100
+						preference = 20
+						flags = "X"
+					}`,
+				ExpectError: regexp.MustCompile("'flags' must be one of 'U', 'S', 'A', 'P' or empty"),
+			},
+			{
+				Config: `
+					resource "infoblox_naptr_record" "foo"{
+						fqdn = "voip.test.com"
+						order = 100
+						preference = 20
+						regexp = "!^.*$!sip:info@test.com!"
+						replacement = "_sip._udp.test.com"
+					}`,
+				ExpectError: regexp.MustCompile("'regexp' and 'replacement' must not be set together"),
+			},
+		},
+	})
+}
@@ -73,10 +73,108 @@ func resourceNetwork() *schema.Resource {
 				Optional:    true,
 				Description: "The Extensible attributes of the Network, as a map in JSON format",
 			},
+			"options": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        dhcpOptionSchemaElem(),
+				Description: "DHCP options of the network.",
+			},
+			"members": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The names of the grid members which serve DHCP for the network.",
+			},
+			"template": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "The name of the network template which the network is created out of; it is used on creation only and is not read back.",
+			},
 		},
 	}
 }
 
+func convertInterfaceToDHCPMembers(items []interface{}) ([]dhcpMember, error) {
+	res := make([]dhcpMember, 0, len(items))
+	for _, item := range items {
+		name, _ := item.(string)
+		if name == "" {
+			return nil, fmt.Errorf("the items of 'members' must not be empty")
+		}
+		res = append(res, dhcpMember{Struct: "dhcpmember", Name: name})
+	}
+
+	return res, nil
+}
+
+// Only grid members are taken into account, Microsoft DHCP servers are skipped.
+func convertDHCPMembersToInterface(members []dhcpMember) []interface{} {
+	res := make([]interface{}, 0, len(members))
+	for _, member := range members {
+		if member.Struct != "dhcpmember" {
+			continue
+		}
+		res = append(res, member.Name)
+	}
+
+	return res
+}
+
+// Creates a network out of the template; 'cidr' is either the network in CIDR format
+// or a call of the function which allocates the next available network.
+func createNetworkFromTemplate(
+	connector ibclient.IBConnector, netView, cidr, template string, isIPv6 bool,
+	comment string, extAttrs map[string]interface{}) (*ibclient.Network, error) {
+
+	obj := newTemplatedNetwork(templatedNetwork{
+		NetworkView: netView,
+		Network:     cidr,
+		Template:    template,
+		Comment:     comment,
+		Ea:          extAttrs,
+	}, isIPv6)
+	ref, err := connector.CreateObject(obj)
+	if err != nil {
+		return nil, err
+	}
+
+	network := ibclient.NewNetwork("", "", isIPv6, "", nil)
+	if err = connector.GetObject(network, ref, ibclient.NewQueryParams(false, nil), network); err != nil {
+		return nil, err
+	}
+
+	return network, nil
+}
+
+func buildNetworkDHCP(d *schema.ResourceData, isIPv6 bool) (*networkDHCP, error) {
+	options, err := convertInterfaceToDHCPOptions("options", d.Get("options").([]interface{}))
+	if err != nil {
+		return nil, err
+	}
+	members, err := convertInterfaceToDHCPMembers(d.Get("members").([]interface{}))
+	if err != nil {
+		return nil, err
+	}
+
+	return newNetworkDHCP(networkDHCP{Options: options, UseOptions: len(options) > 0, Members: members}, isIPv6), nil
+}
+
+// Returns the DHCP properties of a network created out of a template, to be set:
+// only the requested ones are included, the rest are kept as defined by the template.
+func buildTemplatedNetworkDHCP(dhcp *networkDHCP, setOptions, setMembers bool) *genericRecord {
+	fields := make(map[string]interface{})
+	if setOptions {
+		fields["options"] = dhcp.Options
+		fields["use_options"] = dhcp.UseOptions
+	}
+	if setMembers {
+		fields["members"] = dhcp.Members
+	}
+
+	return newGenericRecord(dhcp.ObjectType(), nil, fields)
+}
+
 func resourceNetworkCreate(d *schema.ResourceData, m interface{}, isIPv6 bool) error {
 	networkViewName := d.Get("network_view").(string)
 	parentCidr := d.Get("parent_cidr").(string)
@@ -89,6 +187,12 @@ func resourceNetworkCreate(d *schema.ResourceData, m interface{}, isIPv6 bool) e
 	}
 
 	gateway := d.Get("gateway").(string)
+	template := d.Get("template").(string)
+
+	dhcp, err := buildNetworkDHCP(d, isIPv6)
+	if err != nil {
+		return err
+	}
 
 	comment := d.Get("comment").(string)
 	extAttrJSON := d.Get("ext_attrs").(string)
@@ -112,7 +216,6 @@ func resourceNetworkCreate(d *schema.ResourceData, m interface{}, isIPv6 bool) e
 	objMgr := ibclient.NewObjectManager(connector, "Terraform", tenantID)
 
 	var network *ibclient.Network
-	var err error
 	if cidr == "" && parentCidr != "" && prefixLen > 1 {
 		_, err := objMgr.GetNetworkContainer(networkViewName, parentCidr, isIPv6, nil)
 		if err != nil {
@@ -120,13 +223,25 @@ func resourceNetworkCreate(d *schema.ResourceData, m interface{}, isIPv6 bool) e
 				"Allocation of network block within network container '%s' under network view '%s' failed: %s", parentCidr, networkViewName, err.Error())
 		}
 
-		network, err = objMgr.AllocateNetwork(networkViewName, parentCidr, isIPv6, uint(prefixLen), comment, extAttrs)
+		if template != "" {
+			network, err = createNetworkFromTemplate(
+				connector, networkViewName,
+				fmt.Sprintf("func:nextavailablenetwork:%s,%s,%d", parentCidr, networkViewName, prefixLen),
+				template, isIPv6, comment, extAttrs)
+		} else {
+			network, err = objMgr.AllocateNetwork(networkViewName, parentCidr, isIPv6, uint(prefixLen), comment, extAttrs)
+		}
 		if err != nil {
 			return fmt.Errorf("Allocation of network block failed in network view (%s) : %s", networkViewName, err)
 		}
 		d.Set("cidr", network.Cidr)
 	} else if cidr != "" {
-		network, err = objMgr.CreateNetwork(networkViewName, cidr, isIPv6, comment, extAttrs)
+		if template != "" {
+			network, err = createNetworkFromTemplate(
+				connector, networkViewName, cidr, template, isIPv6, comment, extAttrs)
+		} else {
+			network, err = objMgr.CreateNetwork(networkViewName, cidr, isIPv6, comment, extAttrs)
+		}
 		if err != nil {
 			return fmt.Errorf("Creation of network block failed in network view (%s) : %s", networkViewName, err)
 		}
@@ -135,6 +250,19 @@ func resourceNetworkCreate(d *schema.ResourceData, m interface{}, isIPv6 bool) e
 	}
 	d.SetId(network.Ref)
 
+	// The options, inherited from the upper level, are kept unless there are DHCP settings to define.
+	if len(dhcp.Options) > 0 || len(dhcp.Members) > 0 {
+		var obj ibclient.IBObject = dhcp
+		if template != "" {
+			obj = buildTemplatedNetworkDHCP(dhcp, len(dhcp.Options) > 0, len(dhcp.Members) > 0)
+		}
+		if _, err = connector.UpdateObject(obj, network.Ref); err != nil {
+			return fmt.Errorf(
+				"setting DHCP properties of network block '%s' from network view '%s' failed: %w",
+				network.Cidr, networkViewName, err)
+		}
+	}
+
 	autoAllocateGateway := gateway == ""
 
 	if !autoAllocateGateway && gateway != "none" {
@@ -237,6 +365,33 @@ func resourceNetworkRead(d *schema.ResourceData, m interface{}) error {
 		return err
 	}
 
+	dhcp := newNetworkDHCP(networkDHCP{}, networkIPv6Regexp.MatchString(d.Id()))
+	if err = connector.GetObject(dhcp, d.Id(), ibclient.NewQueryParams(false, nil), dhcp); err != nil {
+		return fmt.Errorf("getting DHCP properties of network block '%s' failed: %w", obj.Cidr, err)
+	}
+	// The options are not overridden for the network, those returned are inherited.
+	if !dhcp.UseOptions {
+		dhcp.Options = nil
+	}
+	stateOptions, err := convertInterfaceToDHCPOptions("options", d.Get("options").([]interface{}))
+	if err != nil {
+		return err
+	}
+
+	// The DHCP properties, defined by the template, are not tracked unless they are defined for the network.
+	// The template itself is not returned by NIOS, it is kept as it is in the state.
+	template := d.Get("template").(string)
+	if template == "" || len(stateOptions) > 0 {
+		if err = d.Set("options", convertDHCPOptionsToInterface(mergeDHCPOptions(stateOptions, dhcp.Options))); err != nil {
+			return err
+		}
+	}
+	if template == "" || len(d.Get("members").([]interface{})) > 0 {
+		if err = d.Set("members", convertDHCPMembersToInterface(dhcp.Members)); err != nil {
+			return err
+		}
+	}
+
 	d.SetId(obj.Ref)
 
 	return nil
@@ -259,6 +414,9 @@ func resourceNetworkUpdate(d *schema.ResourceData, m interface{}) (err error) {
 			prevResIPv6, _ := d.GetChange("reserve_ipv6")
 			prevComment, _ := d.GetChange("comment")
 			prevEa, _ := d.GetChange("ext_attrs")
+			prevOptions, _ := d.GetChange("options")
+			prevMembers, _ := d.GetChange("members")
+			prevTemplate, _ := d.GetChange("template")
 
 			_ = d.Set("network_view", prevNetView.(string))
 			_ = d.Set("cidr", prevCIDR.(string))
@@ -269,6 +427,9 @@ func resourceNetworkUpdate(d *schema.ResourceData, m interface{}) (err error) {
 			_ = d.Set("reserve_ipv6", prevResIPv6.(int))
 			_ = d.Set("comment", prevComment.(string))
 			_ = d.Set("ext_attrs", prevEa.(string))
+			_ = d.Set("options", prevOptions)
+			_ = d.Set("members", prevMembers)
+			_ = d.Set("template", prevTemplate.(string))
 		}
 	}()
 
@@ -288,6 +449,14 @@ func resourceNetworkUpdate(d *schema.ResourceData, m interface{}) (err error) {
 	if d.HasChange("gateway") {
 		return fmt.Errorf("changing the value of 'gateway' field is not allowed")
 	}
+	if d.HasChange("template") {
+		return fmt.Errorf("changing the value of 'template' field is not allowed")
+	}
+	dhcp, err := buildNetworkDHCP(d, networkIPv6Regexp.MatchString(d.Id()))
+	if err != nil {
+		return err
+	}
+
 	extAttrJSON := d.Get("ext_attrs").(string)
 	extAttrs := make(map[string]interface{})
 	if extAttrJSON != "" {
@@ -318,6 +487,18 @@ func resourceNetworkUpdate(d *schema.ResourceData, m interface{}) (err error) {
 	if err != nil {
 		return fmt.Errorf("Updation of IP Network under network view '%s' failed: '%s'", networkViewName, err.Error())
 	}
+
+	if d.HasChanges("options", "members") {
+		var obj ibclient.IBObject = dhcp
+		if d.Get("template").(string) != "" {
+			obj = buildTemplatedNetworkDHCP(dhcp, d.HasChange("options"), d.HasChange("members"))
+		}
+		if _, err = connector.UpdateObject(obj, Network.Ref); err != nil {
+			return fmt.Errorf(
+				"updating DHCP properties of network block '%s' under network view '%s' failed: %w",
+				Network.Cidr, networkViewName, err)
+		}
+	}
 	updateSuccessful = true
 	d.SetId(Network.Ref)
 
@@ -0,0 +1,247 @@
+package infoblox
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+)
+
+func resourceNetworkTemplate() *schema.Resource {
+	return &schema.Resource{
+		Create:   resourceNetworkTemplateCreate,
+		Read:     resourceNetworkTemplateRead,
+		Update:   resourceNetworkTemplateUpdate,
+		Delete:   resourceNetworkTemplateDelete,
+		Importer: &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the network template.",
+			},
+			"netmask": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     0,
+				Description: "The prefix length of the networks created out of the template; required unless 'allow_any_netmask' is set.",
+			},
+			"allow_any_netmask": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "The flag which defines if the template may be used for the networks with any prefix length.",
+			},
+			"range_templates": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The names of the range templates which define the ranges of the networks created out of the template.",
+			},
+			"members": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The names of the grid members which serve DHCP for the networks created out of the template.",
+			},
+			"options": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        dhcpOptionSchemaElem(),
+				Description: "DHCP options of the network template.",
+			},
+			"comment": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "A description of the network template.",
+			},
+			"ext_attrs": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "Extensible attributes of the network template to be added/updated, as a map in JSON format.",
+			},
+		},
+	}
+}
+
+func buildNetworkTemplate(d *schema.ResourceData) (*networkTemplate, error) {
+	extAttrJSON := d.Get("ext_attrs").(string)
+	extAttrs := make(map[string]interface{})
+	if extAttrJSON != "" {
+		if err := json.Unmarshal([]byte(extAttrJSON), &extAttrs); err != nil {
+			return nil, fmt.Errorf("cannot process 'ext_attrs' field: %w", err)
+		}
+	}
+
+	netmask := d.Get("netmask").(int)
+	allowAnyNetmask := d.Get("allow_any_netmask").(bool)
+	if allowAnyNetmask {
+		if netmask != 0 {
+			return nil, fmt.Errorf("'netmask' must not be defined if 'allow_any_netmask' is set")
+		}
+	} else if netmask < 1 || netmask > 32 {
+		return nil, fmt.Errorf("'netmask' must be in range 1..32 unless 'allow_any_netmask' is set")
+	}
+
+	rangeTemplates := make([]string, 0)
+	for _, item := range d.Get("range_templates").([]interface{}) {
+		name, _ := item.(string)
+		if name == "" {
+			return nil, fmt.Errorf("the items of 'range_templates' must not be empty")
+		}
+		rangeTemplates = append(rangeTemplates, name)
+	}
+
+	members, err := convertInterfaceToDHCPMembers(d.Get("members").([]interface{}))
+	if err != nil {
+		return nil, err
+	}
+	options, err := convertInterfaceToDHCPOptions("options", d.Get("options").([]interface{}))
+	if err != nil {
+		return nil, err
+	}
+
+	nt := networkTemplate{
+		Name:            d.Get("name").(string),
+		Netmask:         uint32(netmask),
+		AllowAnyNetmask: allowAnyNetmask,
+		RangeTemplates:  rangeTemplates,
+		Members:         members,
+		Options:         options,
+		UseOptions:      len(options) > 0,
+		Comment:         d.Get("comment").(string),
+		Ea:              extAttrs,
+	}
+
+	return newNetworkTemplate(nt), nil
+}
+
+func resourceNetworkTemplateCreate(d *schema.ResourceData, m interface{}) error {
+	nt, err := buildNetworkTemplate(d)
+	if err != nil {
+		return err
+	}
+
+	connector := m.(ibclient.IBConnector)
+	ref, err := connector.CreateObject(nt)
+	if err != nil {
+		return fmt.Errorf("creation of the network template '%s' failed: %w", nt.Name, err)
+	}
+	d.SetId(ref)
+
+	return resourceNetworkTemplateRead(d, m)
+}
+
+func resourceNetworkTemplateRead(d *schema.ResourceData, m interface{}) error {
+	if !strings.HasPrefix(d.Id(), "networktemplate/") {
+		return fmt.Errorf("reference '%s' for 'networktemplate' object has an invalid format", d.Id())
+	}
+
+	connector := m.(ibclient.IBConnector)
+
+	obj := newNetworkTemplate(networkTemplate{})
+	if err := connector.GetObject(obj, d.Id(), ibclient.NewQueryParams(false, nil), obj); err != nil {
+		return fmt.Errorf("failed getting the network template: %w", err)
+	}
+
+	if obj.Ea != nil && len(obj.Ea) > 0 {
+		// TODO: temporary scaffold, need to rework marshalling/unmarshalling of EAs
+		//       (avoiding additional layer of keys ("value" key)
+		eaMap := (map[string]interface{})(obj.Ea)
+		ea, err := json.Marshal(eaMap)
+		if err != nil {
+			return err
+		}
+		if err = d.Set("ext_attrs", string(ea)); err != nil {
+			return err
+		}
+	}
+
+	if err := d.Set("name", obj.Name); err != nil {
+		return err
+	}
+
+	// The netmask is not meaningful if any netmask is allowed.
+	netmask := int(obj.Netmask)
+	if obj.AllowAnyNetmask {
+		netmask = 0
+	}
+	if err := d.Set("netmask", netmask); err != nil {
+		return err
+	}
+	if err := d.Set("allow_any_netmask", obj.AllowAnyNetmask); err != nil {
+		return err
+	}
+	if err := d.Set("range_templates", obj.RangeTemplates); err != nil {
+		return err
+	}
+	if err := d.Set("members", convertDHCPMembersToInterface(obj.Members)); err != nil {
+		return err
+	}
+
+	stateOptions, err := convertInterfaceToDHCPOptions("options", d.Get("options").([]interface{}))
+	if err != nil {
+		return err
+	}
+	if err = d.Set("options", convertDHCPOptionsToInterface(mergeDHCPOptions(stateOptions, obj.Options))); err != nil {
+		return err
+	}
+
+	if err = d.Set("comment", obj.Comment); err != nil {
+		return err
+	}
+
+	d.SetId(obj.Ref)
+
+	return nil
+}
+
+func resourceNetworkTemplateUpdate(d *schema.ResourceData, m interface{}) error {
+	var updateSuccessful bool
+	defer func() {
+		// Reverting the state back, in case of a failure,
+		// otherwise Terraform will keep the values, which leaded to the failure,
+		// in the state file.
+		if !updateSuccessful {
+			fields := []string{
+				"name", "netmask", "allow_any_netmask", "range_templates", "members", "options",
+				"comment", "ext_attrs"}
+			for _, field := range fields {
+				prevValue, _ := d.GetChange(field)
+				_ = d.Set(field, prevValue)
+			}
+		}
+	}()
+
+	nt, err := buildNetworkTemplate(d)
+	if err != nil {
+		return err
+	}
+
+	connector := m.(ibclient.IBConnector)
+	ref, err := connector.UpdateObject(nt, d.Id())
+	if err != nil {
+		return fmt.Errorf("update of the network template '%s' failed: %w", nt.Name, err)
+	}
+	updateSuccessful = true
+	d.SetId(ref)
+
+	return resourceNetworkTemplateRead(d, m)
+}
+
+func resourceNetworkTemplateDelete(d *schema.ResourceData, m interface{}) error {
+	connector := m.(ibclient.IBConnector)
+
+	if _, err := connector.DeleteObject(d.Id()); err != nil {
+		return fmt.Errorf("deletion of the network template failed: %w", err)
+	}
+	d.SetId("")
+
+	return nil
+}
@@ -0,0 +1,160 @@
+package infoblox
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+)
+
+func testAccCheckNetworkTemplateDestroy(s *terraform.State) error {
+	connector := testAccProvider.Meta().(ibclient.IBConnector)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "infoblox_network_template" {
+			continue
+		}
+		obj := newNetworkTemplate(networkTemplate{})
+		err := connector.GetObject(obj, rs.Primary.ID, ibclient.NewQueryParams(false, nil), obj)
+		if err == nil {
+			return fmt.Errorf("network template still exists: %s", rs.Primary.ID)
+		}
+		if !isNotFoundError(err) {
+			return err
+		}
+	}
+	return testAccCheckRangeTemplateDestroy(s)
+}
+
+func testAccNetworkTemplateCompare(t *testing.T, resPath string, expected *networkTemplate) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		res, found := s.RootModule().Resources[resPath]
+		if !found {
+			return fmt.Errorf("not found: %s", resPath)
+		}
+
+		connector := testAccProvider.Meta().(ibclient.IBConnector)
+		obj := newNetworkTemplate(networkTemplate{})
+		if err := connector.GetObject(obj, res.Primary.ID, ibclient.NewQueryParams(false, nil), obj); err != nil {
+			return err
+		}
+
+		if obj.Name != expected.Name {
+			return fmt.Errorf("'name' does not match: got '%s', expected '%s'", obj.Name, expected.Name)
+		}
+		if obj.AllowAnyNetmask != expected.AllowAnyNetmask {
+			return fmt.Errorf(
+				"'allow_any_netmask' does not match: got '%t', expected '%t'",
+				obj.AllowAnyNetmask, expected.AllowAnyNetmask)
+		}
+		if !expected.AllowAnyNetmask && obj.Netmask != expected.Netmask {
+			return fmt.Errorf("'netmask' does not match: got '%d', expected '%d'", obj.Netmask, expected.Netmask)
+		}
+		if len(obj.RangeTemplates)+len(expected.RangeTemplates) > 0 &&
+			!reflect.DeepEqual(obj.RangeTemplates, expected.RangeTemplates) {
+			return fmt.Errorf(
+				"'range_templates' do not match: got '%v', expected '%v'", obj.RangeTemplates, expected.RangeTemplates)
+		}
+		members := convertDHCPMembersToInterface(obj.Members)
+		expMembers := convertDHCPMembersToInterface(expected.Members)
+		if !reflect.DeepEqual(members, expMembers) {
+			return fmt.Errorf("'members' do not match: got '%v', expected '%v'", members, expMembers)
+		}
+		if obj.Comment != expected.Comment {
+			return fmt.Errorf("'comment' does not match: got '%s', expected '%s'", obj.Comment, expected.Comment)
+		}
+
+		options := mergeDHCPOptions(expected.Options, obj.Options)
+		if !reflect.DeepEqual(options, expected.Options) {
+			return fmt.Errorf("'options' do not match: got '%+v', expected '%+v'", options, expected.Options)
+		}
+
+		return validateEAs(obj.Ea, expected.Ea)
+	}
+}
+
+func TestAccResourceNetworkTemplate(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckNetworkTemplateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "infoblox_network_template" "foo" {
+						name = "office"
+						netmask = 24
+					}`,
+				Check: testAccNetworkTemplateCompare(t, "infoblox_network_template.foo", &networkTemplate{
+					Name:    "office",
+					Netmask: 24,
+					Options: []dhcpOption{},
+				}),
+			},
+			{
+				Config: `
+					resource "infoblox_range_template" "clients" {
+						name = "office-clients"
+						offset = 100
+						number_of_addresses = 100
+					}
+
+					resource "infoblox_network_template" "foo" {
+						name = "office"
+						allow_any_netmask = true
+						range_templates = [infoblox_range_template.clients.name]
+						members = ["infoblox.localdomain"]
+						options {
+							name = "domain-name-servers"
+							value = "10.0.0.2,10.0.0.3"
+						}
+						comment = "the layout of the office networks"
+						ext_attrs = jsonencode({
+							"Location" = "Test location"
+						})
+					}`,
+				Check: testAccNetworkTemplateCompare(t, "infoblox_network_template.foo", &networkTemplate{
+					Name:            "office",
+					AllowAnyNetmask: true,
+					RangeTemplates:  []string{"office-clients"},
+					Members:         []dhcpMember{{Struct: "dhcpmember", Name: "infoblox.localdomain"}},
+					Options: []dhcpOption{
+						{Name: "domain-name-servers", Value: "10.0.0.2,10.0.0.3", UseOption: true},
+					},
+					Comment: "the layout of the office networks",
+					Ea: ibclient.EA{
+						"Location": "Test location",
+					},
+				}),
+			},
+			{
+				ResourceName:            "infoblox_network_template.foo",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"options"},
+			},
+
+			// negative test cases
+			{
+				Config: `
+					resource "infoblox_network_template" "foo" {
+						name = "office"
+					}`,
+				ExpectError: regexp.MustCompile("'netmask' must be in range 1..32 unless 'allow_any_netmask' is set"),
+			},
+			{
+				Config: `
+					resource "infoblox_network_template" "foo" {
+						name = "office"
+						netmask = 24
+						allow_any_netmask = true
+					}`,
+				ExpectError: regexp.MustCompile("'netmask' must not be defined if 'allow_any_netmask' is set"),
+			},
+		},
+	})
+}
@@ -1,7 +1,9 @@
 package infoblox
 
 import (
+	"encoding/json"
 	"fmt"
+	"reflect"
 	"regexp"
 	"testing"
 
@@ -276,3 +278,343 @@ func TestAcc_resourceNetwork_ipv6(t *testing.T) {
 		},
 	})
 }
+
+func validateNetworkDHCP(
+	resourceName string,
+	isIPv6 bool,
+	expectedValue *networkDHCP) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		res, found := s.RootModule().Resources[resourceName]
+		if !found {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+
+		connector := testAccProvider.Meta().(ibclient.IBConnector)
+		dhcp := newNetworkDHCP(networkDHCP{}, isIPv6)
+		if err := connector.GetObject(dhcp, res.Primary.ID, ibclient.NewQueryParams(false, nil), dhcp); err != nil {
+			return err
+		}
+
+		members := convertDHCPMembersToInterface(dhcp.Members)
+		expMembers := convertDHCPMembersToInterface(expectedValue.Members)
+		if !reflect.DeepEqual(members, expMembers) {
+			return fmt.Errorf(
+				"the value of 'members' field is '%v', but expected '%v'", members, expMembers)
+		}
+
+		if len(expectedValue.Options) == 0 && dhcp.UseOptions {
+			return fmt.Errorf("the options are expected to be inherited, but 'use_options' is set")
+		}
+		options := mergeDHCPOptions(expectedValue.Options, dhcp.Options)
+		if !reflect.DeepEqual(options, expectedValue.Options) {
+			return fmt.Errorf(
+				"the value of 'options' field is '%+v', but expected '%+v'", options, expectedValue.Options)
+		}
+
+		return nil
+	}
+}
+
+func TestAcc_resourceNetwork_DHCPProperties(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckNetworkDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "infoblox_ipv4_network" "foo"{
+						cidr = "10.11.0.0/24"
+						options {
+							name = "routers"
+							value = "10.11.0.1"
+						}
+						options {
+							name = "domain-name-servers"
+							value = "10.11.0.2,10.11.0.3"
+						}
+						options {
+							num = 15
+							value = "example.com"
+						}
+						members = ["infoblox.localdomain"]
+					}`,
+				Check: validateNetworkDHCP(
+					"infoblox_ipv4_network.foo",
+					false,
+					&networkDHCP{
+						Members: []dhcpMember{{Struct: "dhcpmember", Name: "infoblox.localdomain"}},
+						Options: []dhcpOption{
+							{Name: "routers", Value: "10.11.0.1", UseOption: true},
+							{Name: "domain-name-servers", Value: "10.11.0.2,10.11.0.3", UseOption: true},
+							{Num: 15, Value: "example.com", UseOption: true},
+						},
+					},
+				),
+			},
+			{
+				Config: `
+					resource "infoblox_ipv4_network" "foo"{
+						cidr = "10.11.0.0/24"
+						options {
+							name = "routers"
+							value = "10.11.0.254"
+						}
+						options {
+							name = "dhcp-lease-time"
+							value = "3600"
+						}
+					}`,
+				Check: validateNetworkDHCP(
+					"infoblox_ipv4_network.foo",
+					false,
+					&networkDHCP{
+						Options: []dhcpOption{
+							{Name: "routers", Value: "10.11.0.254", UseOption: true},
+							{Name: "dhcp-lease-time", Value: "3600", UseOption: true},
+						},
+					},
+				),
+			},
+			{
+				Config: `
+					resource "infoblox_ipv4_network" "foo"{
+						cidr = "10.11.0.0/24"
+					}`,
+				Check: validateNetworkDHCP(
+					"infoblox_ipv4_network.foo",
+					false,
+					&networkDHCP{Options: []dhcpOption{}},
+				),
+			},
+			{
+				Config: `
+					resource "infoblox_ipv6_network" "bar"{
+						cidr = "2001:db8:abcd:13::/64"
+						options {
+							name = "domain-name"
+							value = "example.com"
+						}
+						members = ["infoblox.localdomain"]
+					}`,
+				Check: validateNetworkDHCP(
+					"infoblox_ipv6_network.bar",
+					true,
+					&networkDHCP{
+						Members: []dhcpMember{{Struct: "dhcpmember", Name: "infoblox.localdomain"}},
+						Options: []dhcpOption{
+							{Name: "domain-name", Value: "example.com", UseOption: true},
+						},
+					},
+				),
+			},
+			{
+				Config: `
+					resource "infoblox_ipv4_network" "foo"{
+						cidr = "10.11.0.0/24"
+						options {
+							value = "10.11.0.1"
+						}
+					}`,
+				ExpectError: regexp.MustCompile("either 'name' or 'num' must be defined for every item of 'options'"),
+			},
+		},
+	})
+}
+
+func TestConvertDHCPMembersToInterface(t *testing.T) {
+	members := []dhcpMember{
+		{Struct: "dhcpmember", Name: "member1.localdomain"},
+		{Struct: "msdhcpserver"},
+		{Struct: "dhcpmember", Name: "member2.localdomain"},
+	}
+	expected := []interface{}{"member1.localdomain", "member2.localdomain"}
+	if res := convertDHCPMembersToInterface(members); !reflect.DeepEqual(res, expected) {
+		t.Errorf("got '%v', expected '%v'", res, expected)
+	}
+
+	converted, err := convertInterfaceToDHCPMembers(expected)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(converted, []dhcpMember{members[0], members[2]}) {
+		t.Errorf("got '%+v', expected '%+v'", converted, []dhcpMember{members[0], members[2]})
+	}
+
+	if _, err = convertInterfaceToDHCPMembers([]interface{}{""}); err == nil {
+		t.Error("an error is expected for an empty member name")
+	}
+}
+
+func validateNetworkRanges(resourceName string, expectedRanges int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		res, found := s.RootModule().Resources[resourceName]
+		if !found {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+
+		connector := testAccProvider.Meta().(ibclient.IBConnector)
+		var ranges []genericRecord
+		sf := map[string]string{
+			"network_view": res.Primary.Attributes["network_view"],
+			"network":      res.Primary.Attributes["cidr"],
+		}
+		err := connector.GetObject(
+			newGenericRecord("range", []string{"start_addr", "end_addr"}, nil), "",
+			ibclient.NewQueryParams(false, sf), &ranges)
+		if err != nil && !isNotFoundError(err) {
+			return err
+		}
+		if len(ranges) != expectedRanges {
+			return fmt.Errorf(
+				"the network '%s' has %d ranges, but expected %d",
+				res.Primary.Attributes["cidr"], len(ranges), expectedRanges)
+		}
+
+		return nil
+	}
+}
+
+func TestAcc_resourceNetwork_template(t *testing.T) {
+	templates := `
+		resource "infoblox_range_template" "clients" {
+			name = "tf-test-clients"
+			offset = 100
+			number_of_addresses = 50
+		}
+
+		resource "infoblox_network_template" "office" {
+			name = "tf-test-office"
+			netmask = 24
+			range_templates = [infoblox_range_template.clients.name]
+			options {
+				name = "domain-name-servers"
+				value = "10.12.0.2"
+			}
+		}
+
+		resource "infoblox_ipv4_network_container" "parent" {
+			cidr = "10.13.0.0/16"
+		}`
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckNetworkDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: templates + `
+					resource "infoblox_ipv4_network" "static" {
+						cidr = "10.12.0.0/24"
+						template = infoblox_network_template.office.name
+						comment = "created out of the template"
+					}
+
+					resource "infoblox_ipv4_network" "allocated" {
+						parent_cidr = infoblox_ipv4_network_container.parent.cidr
+						allocate_prefix_len = 24
+						template = infoblox_network_template.office.name
+					}`,
+				Check: resource.ComposeTestCheckFunc(
+					validateNetwork(
+						"infoblox_ipv4_network.static",
+						&ibclient.Network{
+							NetviewName: "default",
+							Cidr:        "10.12.0.0/24",
+							Comment:     "created out of the template",
+						},
+					),
+					validateNetworkRanges("infoblox_ipv4_network.static", 1),
+					validateNetworkRanges("infoblox_ipv4_network.allocated", 1),
+					validateNetworkDHCP(
+						"infoblox_ipv4_network.static",
+						false,
+						&networkDHCP{
+							Options: []dhcpOption{
+								{Name: "domain-name-servers", Value: "10.12.0.2", UseOption: true},
+							},
+						},
+					),
+					resource.TestCheckResourceAttr("infoblox_ipv4_network.static", "options.#", "0"),
+				),
+			},
+			{
+				Config: templates + `
+					resource "infoblox_ipv4_network" "static" {
+						cidr = "10.12.0.0/24"
+						template = infoblox_network_template.office.name
+						comment = "created out of the template"
+						options {
+							name = "domain-name-servers"
+							value = "10.12.0.3"
+						}
+					}
+
+					resource "infoblox_ipv4_network" "allocated" {
+						parent_cidr = infoblox_ipv4_network_container.parent.cidr
+						allocate_prefix_len = 24
+						template = infoblox_network_template.office.name
+					}`,
+				Check: validateNetworkDHCP(
+					"infoblox_ipv4_network.static",
+					false,
+					&networkDHCP{
+						Options: []dhcpOption{
+							{Name: "domain-name-servers", Value: "10.12.0.3", UseOption: true},
+						},
+					},
+				),
+			},
+			{
+				Config: templates + `
+					resource "infoblox_network_template" "other" {
+						name = "tf-test-other"
+						netmask = 24
+					}
+
+					resource "infoblox_ipv4_network" "static" {
+						cidr = "10.12.0.0/24"
+						template = infoblox_network_template.other.name
+						comment = "created out of the template"
+						options {
+							name = "domain-name-servers"
+							value = "10.12.0.3"
+						}
+					}
+
+					resource "infoblox_ipv4_network" "allocated" {
+						parent_cidr = infoblox_ipv4_network_container.parent.cidr
+						allocate_prefix_len = 24
+						template = infoblox_network_template.office.name
+					}`,
+				ExpectError: updateNotAllowedErrorRegexp,
+			},
+		},
+	})
+}
+
+func TestBuildTemplatedNetworkDHCP(t *testing.T) {
+	dhcp := newNetworkDHCP(networkDHCP{
+		Members:    []dhcpMember{},
+		Options:    []dhcpOption{{Name: "routers", Value: "10.0.0.1", UseOption: true}},
+		UseOptions: true,
+	}, false)
+
+	res := buildTemplatedNetworkDHCP(dhcp, true, false)
+	if res.ObjectType() != "network" {
+		t.Errorf("unexpected object type: '%s'", res.ObjectType())
+	}
+	if !reflect.DeepEqual(res.Fields, map[string]interface{}{"options": dhcp.Options, "use_options": true}) {
+		t.Errorf("only 'options' are expected to be set, got '%+v'", res.Fields)
+	}
+
+	// An empty list is set explicitly, to remove the members of the network.
+	res = buildTemplatedNetworkDHCP(dhcp, false, true)
+	data, err := json.Marshal(res)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != `{"members":[]}` {
+		t.Errorf("unexpected JSON: %s", data)
+	}
+}
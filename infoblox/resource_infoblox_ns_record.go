@@ -0,0 +1,232 @@
+package infoblox
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+)
+
+func nsRecordAddressesSchemaElem() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"address": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The IP address of the name server.",
+			},
+			"auto_create_ptr": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "If set, a PTR-record for the address is created automatically.",
+			},
+		},
+	}
+}
+
+func convertZoneNameServersToInterface(servers []zoneNameServer) []map[string]interface{} {
+	res := make([]map[string]interface{}, 0, len(servers))
+	for _, srv := range servers {
+		res = append(res, map[string]interface{}{
+			"address":         srv.Address,
+			"auto_create_ptr": srv.AutoCreatePtr,
+		})
+	}
+
+	return res
+}
+
+func convertInterfaceToZoneNameServers(servers []interface{}) []zoneNameServer {
+	res := make([]zoneNameServer, 0, len(servers))
+	for _, srvInf := range servers {
+		srvMap := srvInf.(map[string]interface{})
+		res = append(res, zoneNameServer{
+			Address:       srvMap["address"].(string),
+			AutoCreatePtr: srvMap["auto_create_ptr"].(bool),
+		})
+	}
+
+	return res
+}
+
+func resourceNSRecord() *schema.Resource {
+	return &schema.Resource{
+		Create:   resourceNSRecordCreate,
+		Read:     resourceNSRecordGet,
+		Update:   resourceNSRecordUpdate,
+		Delete:   resourceNSRecordDelete,
+		Importer: &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			"dns_view": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     defaultDNSView,
+				Description: "DNS view which the zone does exist within.",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the zone (or the delegated subzone) which the NS-record is for.",
+			},
+			"nameserver": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The FQDN of the name server.",
+			},
+			"addresses": {
+				Type:        schema.TypeList,
+				Required:    true,
+				Description: "The list of IP addresses of the name server.",
+				Elem:        nsRecordAddressesSchemaElem(),
+			},
+			"ms_delegation_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "The name of the delegation for a Microsoft DNS server.",
+			},
+			"zone": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The zone which the record belongs to.",
+			},
+		},
+	}
+}
+
+func resourceNSRecordCreate(d *schema.ResourceData, m interface{}) error {
+	dnsView := d.Get("dns_view").(string)
+
+	name := d.Get("name").(string)
+	if name == "" {
+		return fmt.Errorf("'name' must not be empty")
+	}
+	nameserver := d.Get("nameserver").(string)
+	if nameserver == "" {
+		return fmt.Errorf("'nameserver' must not be empty")
+	}
+
+	addresses := convertInterfaceToZoneNameServers(d.Get("addresses").([]interface{}))
+	if len(addresses) == 0 {
+		return fmt.Errorf("at least one address must be defined in 'addresses'")
+	}
+	msDelegationName := d.Get("ms_delegation_name").(string)
+
+	rec := newRecordNS(recordNS{
+		Name:             name,
+		Nameserver:       nameserver,
+		Addresses:        addresses,
+		MsDelegationName: msDelegationName,
+		View:             dnsView,
+	})
+
+	connector := m.(ibclient.IBConnector)
+	ref, err := connector.CreateObject(rec)
+	if err != nil {
+		return fmt.Errorf("error creating NS-record: %w", err)
+	}
+	d.SetId(ref)
+
+	return nil
+}
+
+func resourceNSRecordGet(d *schema.ResourceData, m interface{}) error {
+	connector := m.(ibclient.IBConnector)
+
+	obj := newRecordNS(recordNS{})
+	if err := connector.GetObject(obj, d.Id(), ibclient.NewQueryParams(false, nil), obj); err != nil {
+		return fmt.Errorf("failed getting NS-record: %w", err)
+	}
+
+	if err := d.Set("dns_view", obj.View); err != nil {
+		return err
+	}
+	if err := d.Set("name", obj.Name); err != nil {
+		return err
+	}
+	if err := d.Set("nameserver", obj.Nameserver); err != nil {
+		return err
+	}
+	if err := d.Set("addresses", convertZoneNameServersToInterface(obj.Addresses)); err != nil {
+		return err
+	}
+	if err := d.Set("ms_delegation_name", obj.MsDelegationName); err != nil {
+		return err
+	}
+	if err := d.Set("zone", obj.Zone); err != nil {
+		return err
+	}
+
+	d.SetId(obj.Ref)
+
+	return nil
+}
+
+func resourceNSRecordUpdate(d *schema.ResourceData, m interface{}) error {
+	var updateSuccessful bool
+	defer func() {
+		// Reverting the state back, in case of a failure,
+		// otherwise Terraform will keep the values, which leaded to the failure,
+		// in the state file.
+		if !updateSuccessful {
+			prevDNSView, _ := d.GetChange("dns_view")
+			prevName, _ := d.GetChange("name")
+			prevNameserver, _ := d.GetChange("nameserver")
+			prevAddresses, _ := d.GetChange("addresses")
+			prevMsDelegationName, _ := d.GetChange("ms_delegation_name")
+
+			_ = d.Set("dns_view", prevDNSView.(string))
+			_ = d.Set("name", prevName.(string))
+			_ = d.Set("nameserver", prevNameserver.(string))
+			_ = d.Set("addresses", prevAddresses.([]interface{}))
+			_ = d.Set("ms_delegation_name", prevMsDelegationName.(string))
+		}
+	}()
+
+	if d.HasChange("dns_view") {
+		return fmt.Errorf("changing the value of 'dns_view' field is not allowed")
+	}
+	if d.HasChange("name") {
+		return fmt.Errorf("changing the value of 'name' field is not allowed")
+	}
+
+	nameserver := d.Get("nameserver").(string)
+	if nameserver == "" {
+		return fmt.Errorf("'nameserver' must not be empty")
+	}
+	addresses := convertInterfaceToZoneNameServers(d.Get("addresses").([]interface{}))
+	if len(addresses) == 0 {
+		return fmt.Errorf("at least one address must be defined in 'addresses'")
+	}
+	msDelegationName := d.Get("ms_delegation_name").(string)
+
+	rec := newRecordNS(recordNS{
+		Nameserver:       nameserver,
+		Addresses:        addresses,
+		MsDelegationName: msDelegationName,
+	})
+
+	connector := m.(ibclient.IBConnector)
+	ref, err := connector.UpdateObject(rec, d.Id())
+	if err != nil {
+		return fmt.Errorf("error updating NS-record: %w", err)
+	}
+	updateSuccessful = true
+	d.SetId(ref)
+
+	return nil
+}
+
+func resourceNSRecordDelete(d *schema.ResourceData, m interface{}) error {
+	connector := m.(ibclient.IBConnector)
+
+	if _, err := connector.DeleteObject(d.Id()); err != nil {
+		return fmt.Errorf("deletion of NS-record failed: %w", err)
+	}
+	d.SetId("")
+
+	return nil
+}
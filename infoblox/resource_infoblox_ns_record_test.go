@@ -0,0 +1,156 @@
+package infoblox
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+)
+
+func testAccCheckNSRecordDestroy(s *terraform.State) error {
+	meta := testAccProvider.Meta()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "infoblox_ns_record" {
+			continue
+		}
+		connector := meta.(ibclient.IBConnector)
+		rec := newRecordNS(recordNS{})
+		err := connector.GetObject(rec, rs.Primary.ID, ibclient.NewQueryParams(false, nil), rec)
+		if err == nil {
+			return fmt.Errorf("NS-record still exists")
+		}
+	}
+	return nil
+}
+
+func testAccNSRecordCompare(t *testing.T, resPath string, expectedRec *recordNS) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		res, found := s.RootModule().Resources[resPath]
+		if !found {
+			return fmt.Errorf("not found: %s", resPath)
+		}
+		if res.Primary.ID == "" {
+			return fmt.Errorf("ID is not set")
+		}
+		meta := testAccProvider.Meta()
+		connector := meta.(ibclient.IBConnector)
+
+		rec := newRecordNS(recordNS{})
+		if err := connector.GetObject(rec, res.Primary.ID, ibclient.NewQueryParams(false, nil), rec); err != nil {
+			return fmt.Errorf("NS-record not found: %s", err)
+		}
+
+		if rec.Name != expectedRec.Name {
+			return fmt.Errorf(
+				"'name' does not match: got '%s', expected '%s'",
+				rec.Name, expectedRec.Name)
+		}
+		if rec.Nameserver != expectedRec.Nameserver {
+			return fmt.Errorf(
+				"'nameserver' does not match: got '%s', expected '%s'",
+				rec.Nameserver, expectedRec.Nameserver)
+		}
+		if rec.View != expectedRec.View {
+			return fmt.Errorf(
+				"'dns_view' does not match: got '%s', expected '%s'",
+				rec.View, expectedRec.View)
+		}
+		if len(rec.Addresses) != len(expectedRec.Addresses) {
+			return fmt.Errorf(
+				"the number of addresses does not match: got '%d', expected '%d'",
+				len(rec.Addresses), len(expectedRec.Addresses))
+		}
+		for i, addr := range expectedRec.Addresses {
+			if rec.Addresses[i] != addr {
+				return fmt.Errorf(
+					"address does not match: got '%+v', expected '%+v'",
+					rec.Addresses[i], addr)
+			}
+		}
+		if rec.MsDelegationName != expectedRec.MsDelegationName {
+			return fmt.Errorf(
+				"'ms_delegation_name' does not match: got '%s', expected '%s'",
+				rec.MsDelegationName, expectedRec.MsDelegationName)
+		}
+		return nil
+	}
+}
+
+func TestAccResourceNSRecord(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckNSRecordDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "infoblox_ns_record" "foo"{
+						name = "test.com"
+						nameserver = "ns2.test.com"
+						addresses {
+							address = "10.0.0.53"
+						}
+					}`,
+				Check: resource.ComposeTestCheckFunc(
+					testAccNSRecordCompare(t, "infoblox_ns_record.foo", &recordNS{
+						Name:       "test.com",
+						Nameserver: "ns2.test.com",
+						View:       "default",
+						Addresses: []zoneNameServer{
+							{Address: "10.0.0.53", AutoCreatePtr: true},
+						},
+					}),
+				),
+			},
+			{
+				Config: `
+					resource "infoblox_ns_record" "foo"{
+						name = "test.com"
+						nameserver = "ns2.test.com"
+						addresses {
+							address = "10.0.0.53"
+							auto_create_ptr = false
+						}
+						addresses {
+							address = "10.0.0.54"
+						}
+						ms_delegation_name = "test"
+					}`,
+				Check: resource.ComposeTestCheckFunc(
+					testAccNSRecordCompare(t, "infoblox_ns_record.foo", &recordNS{
+						Name:       "test.com",
+						Nameserver: "ns2.test.com",
+						View:       "default",
+						Addresses: []zoneNameServer{
+							{Address: "10.0.0.53", AutoCreatePtr: false},
+							{Address: "10.0.0.54", AutoCreatePtr: true},
+						},
+						MsDelegationName: "test",
+					}),
+				),
+			},
+			{
+				ResourceName:      "infoblox_ns_record.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+
+			// negative test cases
+			{
+				Config: `
+					resource "infoblox_ns_record" "foo"{
+						name = "test2.com"
+						nameserver = "ns2.test.com"
+						addresses {
+							address = "10.0.0.53"
+						}
+					}`,
+				ExpectError: regexp.MustCompile("changing the value of 'name' field is not allowed"),
+			},
+		},
+	})
+}
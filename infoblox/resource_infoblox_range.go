@@ -0,0 +1,537 @@
+package infoblox
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+)
+
+// rangeKind describes the differences between IPv4 and IPv6 DHCP ranges.
+type rangeKind struct {
+	isIPv6      bool
+	description string
+}
+
+var (
+	rangeKindIPv4 = rangeKind{
+		isIPv6:      false,
+		description: "IPv4 range",
+	}
+	rangeKindIPv6 = rangeKind{
+		isIPv6:      true,
+		description: "IPv6 range",
+	}
+)
+
+func (kind rangeKind) objectType() string {
+	if kind.isIPv6 {
+		return "ipv6range"
+	}
+	return "range"
+}
+
+func (kind rangeKind) networkObjectType() string {
+	if kind.isIPv6 {
+		return "ipv6network"
+	}
+	return "network"
+}
+
+func (kind rangeKind) ipVersion() string {
+	if kind.isIPv6 {
+		return "IPv6"
+	}
+	return "IPv4"
+}
+
+func exclusionRangeSchemaElem() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"start_addr": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The first address of the excluded sub-range.",
+			},
+			"end_addr": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The last address of the excluded sub-range.",
+			},
+			"comment": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "A description of the excluded sub-range.",
+			},
+		},
+	}
+}
+
+func resourceRange(kind rangeKind) *schema.Resource {
+	ipVersion := kind.ipVersion()
+	res := &schema.Resource{
+		Importer: &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			"network_view": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     defaultNetView,
+				Description: "Network view which the range belongs to.",
+			},
+			"start_addr": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: fmt.Sprintf("The first %s address of the range.", ipVersion),
+			},
+			"end_addr": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: fmt.Sprintf("The last %s address of the range.", ipVersion),
+			},
+			"network": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The network (in CIDR format) which the range belongs to.",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "The name of the range.",
+			},
+			"member": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "The name of the grid member which serves the range.",
+			},
+			"exclude": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        exclusionRangeSchemaElem(),
+				Description: "The sub-ranges of addresses which are not leased to the clients.",
+			},
+			"disable": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "The flag which defines if the range is disabled.",
+			},
+			"comment": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "A description of the range.",
+			},
+			"ext_attrs": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "Extensible attributes of the range to be added/updated, as a map in JSON format.",
+			},
+		},
+	}
+
+	// Failover associations and DHCP options are not supported by NIOS for IPv6 ranges.
+	if !kind.isIPv6 {
+		res.Schema["failover_association"] = &schema.Schema{
+			Type:        schema.TypeString,
+			Optional:    true,
+			Default:     "",
+			Description: "The name of the failover association which serves the range.",
+		}
+		res.Schema["options"] = &schema.Schema{
+			Type:        schema.TypeList,
+			Optional:    true,
+			Elem:        dhcpOptionSchemaElem(),
+			Description: "DHCP options of the range.",
+		}
+	}
+
+	return res
+}
+
+func compareIPAddrs(a, b net.IP) int {
+	return bytes.Compare(a.To16(), b.To16())
+}
+
+// Checks that the addresses of the range belong to the same existing network, and returns the network.
+func getRangeNetwork(
+	connector ibclient.IBConnector, kind rangeKind, netView, startAddr, endAddr string) (string, error) {
+
+	startIP := net.ParseIP(startAddr)
+	if !isIPAddrOfVersion(startIP, kind.isIPv6) {
+		return "", fmt.Errorf("'%s' is not a valid %s address for 'start_addr'", startAddr, kind.ipVersion())
+	}
+	endIP := net.ParseIP(endAddr)
+	if !isIPAddrOfVersion(endIP, kind.isIPv6) {
+		return "", fmt.Errorf("'%s' is not a valid %s address for 'end_addr'", endAddr, kind.ipVersion())
+	}
+	if compareIPAddrs(startIP, endIP) > 0 {
+		return "", fmt.Errorf("'start_addr' must not be greater than 'end_addr'")
+	}
+
+	var networks []genericRecord
+	sf := map[string]string{
+		"network_view":     netView,
+		"contains_address": startAddr,
+	}
+	err := connector.GetObject(
+		newGenericRecord(kind.networkObjectType(), []string{"network"}, nil), "",
+		ibclient.NewQueryParams(false, sf), &networks)
+	if err != nil && !isNotFoundError(err) {
+		return "", fmt.Errorf("failed getting the network of the range: %w", err)
+	}
+	if len(networks) == 0 {
+		return "", fmt.Errorf(
+			"the address '%s' does not belong to any network of network view '%s'", startAddr, netView)
+	}
+
+	// The most specific network is chosen, if the networks are nested.
+	var ipNet *net.IPNet
+	for _, network := range networks {
+		cidr, _ := network.Fields["network"].(string)
+		_, candidate, err := net.ParseCIDR(cidr)
+		if err != nil {
+			continue
+		}
+		if ipNet == nil {
+			ipNet = candidate
+			continue
+		}
+		candidateBits, _ := candidate.Mask.Size()
+		bits, _ := ipNet.Mask.Size()
+		if candidateBits > bits {
+			ipNet = candidate
+		}
+	}
+	if ipNet == nil {
+		return "", fmt.Errorf("failed getting the network of the range '%s'-'%s'", startAddr, endAddr)
+	}
+	cidr := ipNet.String()
+	if !ipNet.Contains(endIP) {
+		return "", fmt.Errorf(
+			"the addresses '%s' and '%s' must belong to the same network, '%s'", startAddr, endAddr, cidr)
+	}
+
+	return cidr, nil
+}
+
+func convertInterfaceToExclusionRanges(items []interface{}) []exclusionRange {
+	res := make([]exclusionRange, 0, len(items))
+	for _, item := range items {
+		m := item.(map[string]interface{})
+		res = append(res, exclusionRange{
+			StartAddress: m["start_addr"].(string),
+			EndAddress:   m["end_addr"].(string),
+			Comment:      m["comment"].(string),
+		})
+	}
+
+	return res
+}
+
+func convertExclusionRangesToInterface(ranges []exclusionRange) []map[string]interface{} {
+	res := make([]map[string]interface{}, 0, len(ranges))
+	for _, r := range ranges {
+		res = append(res, map[string]interface{}{
+			"start_addr": r.StartAddress,
+			"end_addr":   r.EndAddress,
+			"comment":    r.Comment,
+		})
+	}
+
+	return res
+}
+
+// Builds a range object out of the resource's fields, except 'network_view'
+// which cannot be changed once the range is created.
+func buildRange(d *schema.ResourceData, kind rangeKind) (*dhcpRange, error) {
+	extAttrJSON := d.Get("ext_attrs").(string)
+	extAttrs := make(map[string]interface{})
+	if extAttrJSON != "" {
+		if err := json.Unmarshal([]byte(extAttrJSON), &extAttrs); err != nil {
+			return nil, fmt.Errorf("cannot process 'ext_attrs' field: %w", err)
+		}
+	}
+
+	r := dhcpRange{
+		StartAddr:             d.Get("start_addr").(string),
+		EndAddr:               d.Get("end_addr").(string),
+		Name:                  d.Get("name").(string),
+		ServerAssociationType: "NONE",
+		Exclude:               convertInterfaceToExclusionRanges(d.Get("exclude").([]interface{})),
+		Disable:               d.Get("disable").(bool),
+		Comment:               d.Get("comment").(string),
+		Ea:                    extAttrs,
+	}
+
+	member := d.Get("member").(string)
+	if member != "" {
+		r.ServerAssociationType = "MEMBER"
+		r.Member = &dhcpMember{Struct: "dhcpmember", Name: member}
+	}
+
+	if kind.isIPv6 {
+		return newDHCPRange(r, true), nil
+	}
+
+	failoverAssociation := d.Get("failover_association").(string)
+	if failoverAssociation != "" {
+		if member != "" {
+			return nil, fmt.Errorf("only one of 'member' and 'failover_association' may be defined")
+		}
+		r.ServerAssociationType = "FAILOVER"
+		r.FailoverAssociation = &failoverAssociation
+	}
+
+	options, err := convertInterfaceToDHCPOptions("options", d.Get("options").([]interface{}))
+	if err != nil {
+		return nil, err
+	}
+	useOptions := len(options) > 0
+	r.Options = &options
+	r.UseOptions = &useOptions
+
+	return newDHCPRange(r, false), nil
+}
+
+func resourceRangeCreate(d *schema.ResourceData, m interface{}, kind rangeKind) error {
+	r, err := buildRange(d, kind)
+	if err != nil {
+		return err
+	}
+	r.NetworkView = d.Get("network_view").(string)
+
+	connector := m.(ibclient.IBConnector)
+	if r.Network, err = getRangeNetwork(connector, kind, r.NetworkView, r.StartAddr, r.EndAddr); err != nil {
+		return err
+	}
+
+	ref, err := connector.CreateObject(r)
+	if err != nil {
+		return fmt.Errorf("error creating %s: %w", kind.description, err)
+	}
+	d.SetId(ref)
+
+	return resourceRangeRead(d, m, kind)
+}
+
+func resourceRangeRead(d *schema.ResourceData, m interface{}, kind rangeKind) error {
+	if !strings.HasPrefix(d.Id(), kind.objectType()+"/") {
+		return fmt.Errorf("reference '%s' for '%s' object has an invalid format", d.Id(), kind.objectType())
+	}
+
+	connector := m.(ibclient.IBConnector)
+
+	obj := newDHCPRange(dhcpRange{}, kind.isIPv6)
+	if err := connector.GetObject(obj, d.Id(), ibclient.NewQueryParams(false, nil), obj); err != nil {
+		return fmt.Errorf("failed getting %s: %w", kind.description, err)
+	}
+
+	if obj.Ea != nil && len(obj.Ea) > 0 {
+		// TODO: temporary scaffold, need to rework marshalling/unmarshalling of EAs
+		//       (avoiding additional layer of keys ("value" key)
+		eaMap := (map[string]interface{})(obj.Ea)
+		ea, err := json.Marshal(eaMap)
+		if err != nil {
+			return err
+		}
+		if err = d.Set("ext_attrs", string(ea)); err != nil {
+			return err
+		}
+	}
+
+	// The addresses are kept in the form they are defined in, if they are the same.
+	setAddr := func(field, actual string) error {
+		if sameIPAddrs(d.Get(field).(string), actual) {
+			return nil
+		}
+		return d.Set(field, actual)
+	}
+	if err := setAddr("start_addr", obj.StartAddr); err != nil {
+		return err
+	}
+	if err := setAddr("end_addr", obj.EndAddr); err != nil {
+		return err
+	}
+
+	stateExclude := convertInterfaceToExclusionRanges(d.Get("exclude").([]interface{}))
+	for i := range obj.Exclude {
+		if i < len(stateExclude) &&
+			sameIPAddrs(stateExclude[i].StartAddress, obj.Exclude[i].StartAddress) &&
+			sameIPAddrs(stateExclude[i].EndAddress, obj.Exclude[i].EndAddress) {
+			obj.Exclude[i].StartAddress = stateExclude[i].StartAddress
+			obj.Exclude[i].EndAddress = stateExclude[i].EndAddress
+		}
+	}
+	if err := d.Set("exclude", convertExclusionRangesToInterface(obj.Exclude)); err != nil {
+		return err
+	}
+
+	if err := d.Set("network_view", obj.NetworkView); err != nil {
+		return err
+	}
+	if err := d.Set("network", obj.Network); err != nil {
+		return err
+	}
+	if err := d.Set("name", obj.Name); err != nil {
+		return err
+	}
+	member := ""
+	if obj.ServerAssociationType == "MEMBER" && obj.Member != nil {
+		member = obj.Member.Name
+	}
+	if err := d.Set("member", member); err != nil {
+		return err
+	}
+	if err := d.Set("disable", obj.Disable); err != nil {
+		return err
+	}
+	if err := d.Set("comment", obj.Comment); err != nil {
+		return err
+	}
+
+	if !kind.isIPv6 {
+		failoverAssociation := ""
+		if obj.ServerAssociationType == "FAILOVER" && obj.FailoverAssociation != nil {
+			failoverAssociation = *obj.FailoverAssociation
+		}
+		if err := d.Set("failover_association", failoverAssociation); err != nil {
+			return err
+		}
+
+		stateOptions, err := convertInterfaceToDHCPOptions("options", d.Get("options").([]interface{}))
+		if err != nil {
+			return err
+		}
+		var actualOptions []dhcpOption
+		if obj.Options != nil {
+			actualOptions = *obj.Options
+		}
+		options := mergeDHCPOptions(stateOptions, actualOptions)
+		if err = d.Set("options", convertDHCPOptionsToInterface(options)); err != nil {
+			return err
+		}
+	}
+
+	d.SetId(obj.Ref)
+
+	return nil
+}
+
+func resourceRangeUpdate(d *schema.ResourceData, m interface{}, kind rangeKind) error {
+	var updateSuccessful bool
+	defer func() {
+		// Reverting the state back, in case of a failure,
+		// otherwise Terraform will keep the values, which leaded to the failure,
+		// in the state file.
+		if !updateSuccessful {
+			fields := []string{
+				"network_view", "start_addr", "end_addr", "name", "member", "exclude", "disable", "comment", "ext_attrs"}
+			if !kind.isIPv6 {
+				fields = append(fields, "failover_association", "options")
+			}
+			for _, field := range fields {
+				prevValue, _ := d.GetChange(field)
+				_ = d.Set(field, prevValue)
+			}
+		}
+	}()
+
+	if d.HasChange("network_view") {
+		return fmt.Errorf("changing the value of 'network_view' field is not allowed")
+	}
+
+	r, err := buildRange(d, kind)
+	if err != nil {
+		return err
+	}
+
+	connector := m.(ibclient.IBConnector)
+	if d.HasChanges("start_addr", "end_addr") {
+		if _, err = getRangeNetwork(
+			connector, kind, d.Get("network_view").(string), r.StartAddr, r.EndAddr); err != nil {
+			return err
+		}
+	}
+
+	ref, err := connector.UpdateObject(r, d.Id())
+	if err != nil {
+		return fmt.Errorf("error updating %s: %w", kind.description, err)
+	}
+	updateSuccessful = true
+	d.SetId(ref)
+
+	return resourceRangeRead(d, m, kind)
+}
+
+func resourceRangeDelete(d *schema.ResourceData, m interface{}, kind rangeKind) error {
+	connector := m.(ibclient.IBConnector)
+
+	if _, err := connector.DeleteObject(d.Id()); err != nil {
+		return fmt.Errorf("deletion of %s failed: %w", kind.description, err)
+	}
+	d.SetId("")
+
+	return nil
+}
+
+func resourceIPv4RangeCreate(d *schema.ResourceData, m interface{}) error {
+	return resourceRangeCreate(d, m, rangeKindIPv4)
+}
+
+func resourceIPv4RangeRead(d *schema.ResourceData, m interface{}) error {
+	return resourceRangeRead(d, m, rangeKindIPv4)
+}
+
+func resourceIPv4RangeUpdate(d *schema.ResourceData, m interface{}) error {
+	return resourceRangeUpdate(d, m, rangeKindIPv4)
+}
+
+func resourceIPv4RangeDelete(d *schema.ResourceData, m interface{}) error {
+	return resourceRangeDelete(d, m, rangeKindIPv4)
+}
+
+func resourceIPv4Range() *schema.Resource {
+	r := resourceRange(rangeKindIPv4)
+	r.Create = resourceIPv4RangeCreate
+	r.Read = resourceIPv4RangeRead
+	r.Update = resourceIPv4RangeUpdate
+	r.Delete = resourceIPv4RangeDelete
+
+	return r
+}
+
+func resourceIPv6RangeCreate(d *schema.ResourceData, m interface{}) error {
+	return resourceRangeCreate(d, m, rangeKindIPv6)
+}
+
+func resourceIPv6RangeRead(d *schema.ResourceData, m interface{}) error {
+	return resourceRangeRead(d, m, rangeKindIPv6)
+}
+
+func resourceIPv6RangeUpdate(d *schema.ResourceData, m interface{}) error {
+	return resourceRangeUpdate(d, m, rangeKindIPv6)
+}
+
+func resourceIPv6RangeDelete(d *schema.ResourceData, m interface{}) error {
+	return resourceRangeDelete(d, m, rangeKindIPv6)
+}
+
+func resourceIPv6Range() *schema.Resource {
+	r := resourceRange(rangeKindIPv6)
+	r.Create = resourceIPv6RangeCreate
+	r.Read = resourceIPv6RangeRead
+	r.Update = resourceIPv6RangeUpdate
+	r.Delete = resourceIPv6RangeDelete
+
+	return r
+}
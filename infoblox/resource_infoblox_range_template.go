@@ -0,0 +1,247 @@
+package infoblox
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+)
+
+func resourceRangeTemplate() *schema.Resource {
+	return &schema.Resource{
+		Create:   resourceRangeTemplateCreate,
+		Read:     resourceRangeTemplateRead,
+		Update:   resourceRangeTemplateUpdate,
+		Delete:   resourceRangeTemplateDelete,
+		Importer: &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the range template.",
+			},
+			"offset": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				Description: "The offset of the first address of the range from the network's address.",
+			},
+			"number_of_addresses": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				Description: "The number of the addresses of the range.",
+			},
+			"member": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "The name of the grid member which serves the ranges created out of the template.",
+			},
+			"failover_association": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "The name of the failover association which serves the ranges created out of the template.",
+			},
+			"options": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        dhcpOptionSchemaElem(),
+				Description: "DHCP options of the range template.",
+			},
+			"comment": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "A description of the range template.",
+			},
+			"ext_attrs": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "Extensible attributes of the range template to be added/updated, as a map in JSON format.",
+			},
+		},
+	}
+}
+
+func buildRangeTemplate(d *schema.ResourceData) (*rangeTemplate, error) {
+	extAttrJSON := d.Get("ext_attrs").(string)
+	extAttrs := make(map[string]interface{})
+	if extAttrJSON != "" {
+		if err := json.Unmarshal([]byte(extAttrJSON), &extAttrs); err != nil {
+			return nil, fmt.Errorf("cannot process 'ext_attrs' field: %w", err)
+		}
+	}
+
+	offset := d.Get("offset").(int)
+	if offset < 0 {
+		return nil, fmt.Errorf("'offset' must not be negative")
+	}
+	numberOfAddresses := d.Get("number_of_addresses").(int)
+	if numberOfAddresses <= 0 {
+		return nil, fmt.Errorf("'number_of_addresses' must be a positive number")
+	}
+
+	options, err := convertInterfaceToDHCPOptions("options", d.Get("options").([]interface{}))
+	if err != nil {
+		return nil, err
+	}
+
+	rt := rangeTemplate{
+		Name:                  d.Get("name").(string),
+		NumberOfAddresses:     uint32(numberOfAddresses),
+		Offset:                uint32(offset),
+		ServerAssociationType: "NONE",
+		Options:               options,
+		UseOptions:            len(options) > 0,
+		Comment:               d.Get("comment").(string),
+		Ea:                    extAttrs,
+	}
+
+	member := d.Get("member").(string)
+	failoverAssociation := d.Get("failover_association").(string)
+	if member != "" && failoverAssociation != "" {
+		return nil, fmt.Errorf("only one of 'member' and 'failover_association' may be defined")
+	}
+	if member != "" {
+		rt.ServerAssociationType = "MEMBER"
+		rt.Member = &dhcpMember{Struct: "dhcpmember", Name: member}
+	}
+	if failoverAssociation != "" {
+		rt.ServerAssociationType = "FAILOVER"
+		rt.FailoverAssociation = &failoverAssociation
+	}
+
+	return newRangeTemplate(rt), nil
+}
+
+func resourceRangeTemplateCreate(d *schema.ResourceData, m interface{}) error {
+	rt, err := buildRangeTemplate(d)
+	if err != nil {
+		return err
+	}
+
+	connector := m.(ibclient.IBConnector)
+	ref, err := connector.CreateObject(rt)
+	if err != nil {
+		return fmt.Errorf("creation of the range template '%s' failed: %w", rt.Name, err)
+	}
+	d.SetId(ref)
+
+	return resourceRangeTemplateRead(d, m)
+}
+
+func resourceRangeTemplateRead(d *schema.ResourceData, m interface{}) error {
+	if !strings.HasPrefix(d.Id(), "rangetemplate/") {
+		return fmt.Errorf("reference '%s' for 'rangetemplate' object has an invalid format", d.Id())
+	}
+
+	connector := m.(ibclient.IBConnector)
+
+	obj := newRangeTemplate(rangeTemplate{})
+	if err := connector.GetObject(obj, d.Id(), ibclient.NewQueryParams(false, nil), obj); err != nil {
+		return fmt.Errorf("failed getting the range template: %w", err)
+	}
+
+	if obj.Ea != nil && len(obj.Ea) > 0 {
+		// TODO: temporary scaffold, need to rework marshalling/unmarshalling of EAs
+		//       (avoiding additional layer of keys ("value" key)
+		eaMap := (map[string]interface{})(obj.Ea)
+		ea, err := json.Marshal(eaMap)
+		if err != nil {
+			return err
+		}
+		if err = d.Set("ext_attrs", string(ea)); err != nil {
+			return err
+		}
+	}
+
+	if err := d.Set("name", obj.Name); err != nil {
+		return err
+	}
+	if err := d.Set("offset", int(obj.Offset)); err != nil {
+		return err
+	}
+	if err := d.Set("number_of_addresses", int(obj.NumberOfAddresses)); err != nil {
+		return err
+	}
+
+	member := ""
+	if obj.ServerAssociationType == "MEMBER" && obj.Member != nil {
+		member = obj.Member.Name
+	}
+	if err := d.Set("member", member); err != nil {
+		return err
+	}
+	failoverAssociation := ""
+	if obj.ServerAssociationType == "FAILOVER" && obj.FailoverAssociation != nil {
+		failoverAssociation = *obj.FailoverAssociation
+	}
+	if err := d.Set("failover_association", failoverAssociation); err != nil {
+		return err
+	}
+
+	stateOptions, err := convertInterfaceToDHCPOptions("options", d.Get("options").([]interface{}))
+	if err != nil {
+		return err
+	}
+	if err = d.Set("options", convertDHCPOptionsToInterface(mergeDHCPOptions(stateOptions, obj.Options))); err != nil {
+		return err
+	}
+
+	if err = d.Set("comment", obj.Comment); err != nil {
+		return err
+	}
+
+	d.SetId(obj.Ref)
+
+	return nil
+}
+
+func resourceRangeTemplateUpdate(d *schema.ResourceData, m interface{}) error {
+	var updateSuccessful bool
+	defer func() {
+		// Reverting the state back, in case of a failure,
+		// otherwise Terraform will keep the values, which leaded to the failure,
+		// in the state file.
+		if !updateSuccessful {
+			fields := []string{
+				"name", "offset", "number_of_addresses", "member", "failover_association", "options",
+				"comment", "ext_attrs"}
+			for _, field := range fields {
+				prevValue, _ := d.GetChange(field)
+				_ = d.Set(field, prevValue)
+			}
+		}
+	}()
+
+	rt, err := buildRangeTemplate(d)
+	if err != nil {
+		return err
+	}
+
+	connector := m.(ibclient.IBConnector)
+	ref, err := connector.UpdateObject(rt, d.Id())
+	if err != nil {
+		return fmt.Errorf("update of the range template '%s' failed: %w", rt.Name, err)
+	}
+	updateSuccessful = true
+	d.SetId(ref)
+
+	return resourceRangeTemplateRead(d, m)
+}
+
+func resourceRangeTemplateDelete(d *schema.ResourceData, m interface{}) error {
+	connector := m.(ibclient.IBConnector)
+
+	if _, err := connector.DeleteObject(d.Id()); err != nil {
+		return fmt.Errorf("deletion of the range template failed: %w", err)
+	}
+	d.SetId("")
+
+	return nil
+}
@@ -0,0 +1,160 @@
+package infoblox
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+)
+
+func testAccCheckRangeTemplateDestroy(s *terraform.State) error {
+	connector := testAccProvider.Meta().(ibclient.IBConnector)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "infoblox_range_template" {
+			continue
+		}
+		obj := newRangeTemplate(rangeTemplate{})
+		err := connector.GetObject(obj, rs.Primary.ID, ibclient.NewQueryParams(false, nil), obj)
+		if err == nil {
+			return fmt.Errorf("range template still exists: %s", rs.Primary.ID)
+		}
+		if !isNotFoundError(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+func testAccRangeTemplateCompare(t *testing.T, resPath string, expected *rangeTemplate) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		res, found := s.RootModule().Resources[resPath]
+		if !found {
+			return fmt.Errorf("not found: %s", resPath)
+		}
+
+		connector := testAccProvider.Meta().(ibclient.IBConnector)
+		obj := newRangeTemplate(rangeTemplate{})
+		if err := connector.GetObject(obj, res.Primary.ID, ibclient.NewQueryParams(false, nil), obj); err != nil {
+			return err
+		}
+
+		if obj.Name != expected.Name {
+			return fmt.Errorf("'name' does not match: got '%s', expected '%s'", obj.Name, expected.Name)
+		}
+		if obj.Offset != expected.Offset {
+			return fmt.Errorf("'offset' does not match: got '%d', expected '%d'", obj.Offset, expected.Offset)
+		}
+		if obj.NumberOfAddresses != expected.NumberOfAddresses {
+			return fmt.Errorf(
+				"'number_of_addresses' does not match: got '%d', expected '%d'",
+				obj.NumberOfAddresses, expected.NumberOfAddresses)
+		}
+		if obj.ServerAssociationType != expected.ServerAssociationType {
+			return fmt.Errorf(
+				"'server_association_type' does not match: got '%s', expected '%s'",
+				obj.ServerAssociationType, expected.ServerAssociationType)
+		}
+		if expected.Member != nil && (obj.Member == nil || obj.Member.Name != expected.Member.Name) {
+			return fmt.Errorf("'member' does not match: got '%+v', expected '%+v'", obj.Member, expected.Member)
+		}
+		if obj.Comment != expected.Comment {
+			return fmt.Errorf("'comment' does not match: got '%s', expected '%s'", obj.Comment, expected.Comment)
+		}
+
+		options := mergeDHCPOptions(expected.Options, obj.Options)
+		if !reflect.DeepEqual(options, expected.Options) {
+			return fmt.Errorf("'options' do not match: got '%+v', expected '%+v'", options, expected.Options)
+		}
+
+		return validateEAs(obj.Ea, expected.Ea)
+	}
+}
+
+func TestAccResourceRangeTemplate(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckRangeTemplateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "infoblox_range_template" "foo" {
+						name = "clients"
+						offset = 100
+						number_of_addresses = 100
+					}`,
+				Check: testAccRangeTemplateCompare(t, "infoblox_range_template.foo", &rangeTemplate{
+					Name:                  "clients",
+					Offset:                100,
+					NumberOfAddresses:     100,
+					ServerAssociationType: "NONE",
+					Options:               []dhcpOption{},
+				}),
+			},
+			{
+				Config: `
+					resource "infoblox_range_template" "foo" {
+						name = "office-clients"
+						offset = 50
+						number_of_addresses = 150
+						member = "infoblox.localdomain"
+						options {
+							name = "dhcp-lease-time"
+							value = "3600"
+						}
+						comment = "the clients of the office networks"
+						ext_attrs = jsonencode({
+							"Location" = "Test location"
+						})
+					}`,
+				Check: testAccRangeTemplateCompare(t, "infoblox_range_template.foo", &rangeTemplate{
+					Name:                  "office-clients",
+					Offset:                50,
+					NumberOfAddresses:     150,
+					ServerAssociationType: "MEMBER",
+					Member:                &dhcpMember{Struct: "dhcpmember", Name: "infoblox.localdomain"},
+					Options: []dhcpOption{
+						{Name: "dhcp-lease-time", Value: "3600", UseOption: true},
+					},
+					Comment: "the clients of the office networks",
+					Ea: ibclient.EA{
+						"Location": "Test location",
+					},
+				}),
+			},
+			{
+				ResourceName:            "infoblox_range_template.foo",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"options"},
+			},
+
+			// negative test cases
+			{
+				Config: `
+					resource "infoblox_range_template" "foo" {
+						name = "office-clients"
+						offset = 50
+						number_of_addresses = 0
+					}`,
+				ExpectError: regexp.MustCompile("'number_of_addresses' must be a positive number"),
+			},
+			{
+				Config: `
+					resource "infoblox_range_template" "foo" {
+						name = "office-clients"
+						offset = 50
+						number_of_addresses = 150
+						member = "infoblox.localdomain"
+						failover_association = "failover1"
+					}`,
+				ExpectError: regexp.MustCompile("only one of 'member' and 'failover_association' may be defined"),
+			},
+		},
+	})
+}
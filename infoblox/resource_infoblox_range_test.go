@@ -0,0 +1,293 @@
+package infoblox
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+)
+
+func testAccCheckRangeDestroy(s *terraform.State) error {
+	meta := testAccProvider.Meta()
+
+	for _, rs := range s.RootModule().Resources {
+		var kind rangeKind
+		switch rs.Type {
+		case "infoblox_ipv4_range":
+			kind = rangeKindIPv4
+		case "infoblox_ipv6_range":
+			kind = rangeKindIPv6
+		default:
+			continue
+		}
+		connector := meta.(ibclient.IBConnector)
+		obj := newDHCPRange(dhcpRange{}, kind.isIPv6)
+		err := connector.GetObject(obj, rs.Primary.ID, ibclient.NewQueryParams(false, nil), obj)
+		if err == nil {
+			return fmt.Errorf("%s still exists: %s", kind.description, rs.Primary.ID)
+		}
+		if !isNotFoundError(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+func testAccRangeCompare(t *testing.T, resPath string, kind rangeKind, expected *dhcpRange) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		res, found := s.RootModule().Resources[resPath]
+		if !found {
+			return fmt.Errorf("not found: %s", resPath)
+		}
+
+		connector := testAccProvider.Meta().(ibclient.IBConnector)
+		obj := newDHCPRange(dhcpRange{}, kind.isIPv6)
+		if err := connector.GetObject(obj, res.Primary.ID, ibclient.NewQueryParams(false, nil), obj); err != nil {
+			return err
+		}
+
+		if !sameIPAddrs(obj.StartAddr, expected.StartAddr) || !sameIPAddrs(obj.EndAddr, expected.EndAddr) {
+			return fmt.Errorf(
+				"the addresses do not match: got '%s'-'%s', expected '%s'-'%s'",
+				obj.StartAddr, obj.EndAddr, expected.StartAddr, expected.EndAddr)
+		}
+		if obj.NetworkView != expected.NetworkView {
+			return fmt.Errorf(
+				"'network_view' does not match: got '%s', expected '%s'", obj.NetworkView, expected.NetworkView)
+		}
+		if obj.Network != expected.Network {
+			return fmt.Errorf("'network' does not match: got '%s', expected '%s'", obj.Network, expected.Network)
+		}
+		if obj.Name != expected.Name {
+			return fmt.Errorf("'name' does not match: got '%s', expected '%s'", obj.Name, expected.Name)
+		}
+		if obj.ServerAssociationType != expected.ServerAssociationType {
+			return fmt.Errorf(
+				"'server_association_type' does not match: got '%s', expected '%s'",
+				obj.ServerAssociationType, expected.ServerAssociationType)
+		}
+		if obj.Disable != expected.Disable {
+			return fmt.Errorf("'disable' does not match: got '%t', expected '%t'", obj.Disable, expected.Disable)
+		}
+		if obj.Comment != expected.Comment {
+			return fmt.Errorf("'comment' does not match: got '%s', expected '%s'", obj.Comment, expected.Comment)
+		}
+		if len(obj.Exclude)+len(expected.Exclude) > 0 && !reflect.DeepEqual(obj.Exclude, expected.Exclude) {
+			return fmt.Errorf("'exclude' does not match: got '%+v', expected '%+v'", obj.Exclude, expected.Exclude)
+		}
+		if expected.Options != nil {
+			var actualOptions []dhcpOption
+			if obj.Options != nil {
+				actualOptions = mergeDHCPOptions(*expected.Options, *obj.Options)
+			}
+			if !reflect.DeepEqual(actualOptions, *expected.Options) {
+				return fmt.Errorf("'options' do not match: got '%+v', expected '%+v'", actualOptions, *expected.Options)
+			}
+		}
+
+		return validateEAs(obj.Ea, expected.Ea)
+	}
+}
+
+func TestAccResourceIPv4Range(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckRangeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "infoblox_ipv4_network" "net" {
+						cidr = "10.5.0.0/24"
+					}
+
+					resource "infoblox_ipv4_range" "foo" {
+						start_addr = "10.5.0.100"
+						end_addr = "10.5.0.199"
+
+						depends_on = [infoblox_ipv4_network.net]
+					}`,
+				Check: resource.ComposeTestCheckFunc(
+					testAccRangeCompare(t, "infoblox_ipv4_range.foo", rangeKindIPv4, &dhcpRange{
+						NetworkView:           "default",
+						Network:               "10.5.0.0/24",
+						StartAddr:             "10.5.0.100",
+						EndAddr:               "10.5.0.199",
+						ServerAssociationType: "NONE",
+					}),
+					resource.TestCheckResourceAttr("infoblox_ipv4_range.foo", "network", "10.5.0.0/24"),
+				),
+			},
+			{
+				Config: `
+					resource "infoblox_ipv4_network" "net" {
+						cidr = "10.5.0.0/24"
+					}
+
+					resource "infoblox_ipv4_range" "foo" {
+						start_addr = "10.5.0.50"
+						end_addr = "10.5.0.199"
+						name = "clients"
+						exclude {
+							start_addr = "10.5.0.60"
+							end_addr = "10.5.0.69"
+							comment = "printers"
+						}
+						options {
+							name = "routers"
+							value = "10.5.0.1"
+						}
+						disable = true
+						comment = "DHCP scope of the office"
+						ext_attrs = jsonencode({
+							"Location" = "Test location"
+						})
+
+						depends_on = [infoblox_ipv4_network.net]
+					}`,
+				Check: resource.ComposeTestCheckFunc(
+					testAccRangeCompare(t, "infoblox_ipv4_range.foo", rangeKindIPv4, &dhcpRange{
+						NetworkView:           "default",
+						Network:               "10.5.0.0/24",
+						StartAddr:             "10.5.0.50",
+						EndAddr:               "10.5.0.199",
+						Name:                  "clients",
+						ServerAssociationType: "NONE",
+						Exclude: []exclusionRange{
+							{StartAddress: "10.5.0.60", EndAddress: "10.5.0.69", Comment: "printers"},
+						},
+						Options: &[]dhcpOption{
+							{Name: "routers", Value: "10.5.0.1", UseOption: true},
+						},
+						Disable: true,
+						Comment: "DHCP scope of the office",
+						Ea: ibclient.EA{
+							"Location": "Test location",
+						},
+					}),
+				),
+			},
+			{
+				ResourceName:            "infoblox_ipv4_range.foo",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"options"},
+			},
+
+			// negative test cases
+			{
+				Config: `
+					resource "infoblox_ipv4_network" "net" {
+						cidr = "10.5.0.0/24"
+					}
+
+					resource "infoblox_ipv4_range" "foo" {
+						start_addr = "10.5.0.50"
+						end_addr = "10.5.1.10"
+
+						depends_on = [infoblox_ipv4_network.net]
+					}`,
+				ExpectError: regexp.MustCompile(
+					"the addresses '10.5.0.50' and '10.5.1.10' must belong to the same network, '10.5.0.0/24'"),
+			},
+			{
+				Config: `
+					resource "infoblox_ipv4_network" "net" {
+						cidr = "10.5.0.0/24"
+					}
+
+					resource "infoblox_ipv4_range" "foo" {
+						start_addr = "10.5.0.199"
+						end_addr = "10.5.0.50"
+
+						depends_on = [infoblox_ipv4_network.net]
+					}`,
+				ExpectError: regexp.MustCompile("'start_addr' must not be greater than 'end_addr'"),
+			},
+			{
+				Config: `
+					resource "infoblox_ipv4_network" "net" {
+						cidr = "10.5.0.0/24"
+					}
+
+					resource "infoblox_ipv4_range" "foo" {
+						start_addr = "10.5.0.50"
+						end_addr = "10.5.0.199"
+						member = "infoblox.localdomain"
+						failover_association = "failover1"
+
+						depends_on = [infoblox_ipv4_network.net]
+					}`,
+				ExpectError: regexp.MustCompile("only one of 'member' and 'failover_association' may be defined"),
+			},
+			{
+				Config: `
+					resource "infoblox_ipv4_range" "bar" {
+						start_addr = "10.250.0.10"
+						end_addr = "10.250.0.20"
+					}`,
+				ExpectError: regexp.MustCompile(
+					"the address '10.250.0.10' does not belong to any network of network view 'default'"),
+			},
+		},
+	})
+}
+
+func TestAccResourceIPv6Range(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckRangeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "infoblox_ipv6_network" "net" {
+						cidr = "2001:db8:5::/64"
+					}
+
+					resource "infoblox_ipv6_range" "foo" {
+						start_addr = "2001:db8:5::100"
+						end_addr = "2001:db8:5::1ff"
+						exclude {
+							start_addr = "2001:db8:5::110"
+							end_addr = "2001:db8:5::11f"
+						}
+						comment = "DHCPv6 scope"
+
+						depends_on = [infoblox_ipv6_network.net]
+					}`,
+				Check: resource.ComposeTestCheckFunc(
+					testAccRangeCompare(t, "infoblox_ipv6_range.foo", rangeKindIPv6, &dhcpRange{
+						NetworkView:           "default",
+						Network:               "2001:db8:5::/64",
+						StartAddr:             "2001:db8:5::100",
+						EndAddr:               "2001:db8:5::1ff",
+						ServerAssociationType: "NONE",
+						Exclude: []exclusionRange{
+							{StartAddress: "2001:db8:5::110", EndAddress: "2001:db8:5::11f"},
+						},
+						Comment: "DHCPv6 scope",
+					}),
+				),
+			},
+			{
+				Config: `
+					resource "infoblox_ipv6_network" "net" {
+						cidr = "2001:db8:5::/64"
+					}
+
+					resource "infoblox_ipv6_range" "foo" {
+						start_addr = "10.5.0.50"
+						end_addr = "2001:db8:5::1ff"
+
+						depends_on = [infoblox_ipv6_network.net]
+					}`,
+				ExpectError: regexp.MustCompile("'10.5.0.50' is not a valid IPv6 address for 'start_addr'"),
+			},
+		},
+	})
+}
@@ -0,0 +1,672 @@
+package infoblox
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+)
+
+// recordSetKind describes a type of record sets:
+// a set of A-records or a set of AAAA-records of the same name.
+type recordSetKind struct {
+	addrKind    hostRecordAddrKind
+	description string
+}
+
+var (
+	recordSetKindA = recordSetKind{
+		addrKind: hostRecordAddrKind{
+			fieldName: "address",
+			isIPv6:    false,
+		},
+		description: "A-record",
+	}
+	recordSetKindAAAA = recordSetKind{
+		addrKind: hostRecordAddrKind{
+			fieldName: "address",
+			isIPv6:    true,
+		},
+		description: "AAAA-record",
+	}
+)
+
+func (rec *addressRecord) address() string {
+	if rec.Ipv6Addr != "" {
+		return rec.Ipv6Addr
+	}
+	return rec.Ipv4Addr
+}
+
+func (rec *addressRecord) setAddress(kind recordSetKind, addr string) {
+	if kind.addrKind.isIPv6 {
+		rec.Ipv6Addr = addr
+	} else {
+		rec.Ipv4Addr = addr
+	}
+}
+
+func recordSetAddrSchemaElem(kind recordSetKind) *schema.Resource {
+	ipVersion := "IPv4"
+	if kind.addrKind.isIPv6 {
+		ipVersion = "IPv6"
+	}
+
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"ip_addr": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: fmt.Sprintf("The %s address, for static allocation.", ipVersion),
+			},
+			"cidr": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "",
+				Description: fmt.Sprintf(
+					"The %s network (in CIDR format) to allocate the next available address from.", ipVersion),
+			},
+			"allocated_ip_addr": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The address of the record: either 'ip_addr' or the one allocated from 'cidr'.",
+			},
+		},
+	}
+}
+
+// The items of 'address' field are kept as host record's addresses,
+// to share the allocation and the merging logic with 'infoblox_host_record' resource.
+func convertInterfaceToRecordSetAddrs(items []interface{}) ([]*hostRecordAddr, error) {
+	res := make([]*hostRecordAddr, 0, len(items))
+	for _, item := range items {
+		if item == nil {
+			return nil, fmt.Errorf("exactly one of 'ip_addr' and 'cidr' must be defined for every item of 'address'")
+		}
+		m := item.(map[string]interface{})
+		addr := &hostRecordAddr{
+			ipAddr: m["ip_addr"].(string),
+			cidr:   m["cidr"].(string),
+		}
+		if allocated, found := m["allocated_ip_addr"]; found && allocated != nil {
+			addr.allocated = allocated.(string)
+		}
+		res = append(res, addr)
+	}
+
+	return res, nil
+}
+
+func convertRecordSetAddrsToInterface(addrs []*hostRecordAddr) []map[string]interface{} {
+	res := make([]map[string]interface{}, 0, len(addrs))
+	for _, addr := range addrs {
+		res = append(res, map[string]interface{}{
+			"ip_addr":           addr.ipAddr,
+			"cidr":              addr.cidr,
+			"allocated_ip_addr": addr.allocated,
+		})
+	}
+
+	return res
+}
+
+// The ID of a record set is the name of the records and their DNS view, separated by a slash.
+func recordSetID(fqdn, dnsView string) string {
+	return fmt.Sprintf("%s/%s", fqdn, dnsView)
+}
+
+func parseRecordSetID(id string) (string, string, error) {
+	parts := strings.SplitN(id, "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("invalid ID of a record set: '%s', expected '<fqdn>/<dns_view>'", id)
+	}
+
+	return parts[0], parts[1], nil
+}
+
+// Returns all the records of the given name within the DNS view, sorted by address.
+func getRecordSetRecords(
+	connector ibclient.IBConnector, kind recordSetKind, fqdn, dnsView string) ([]*addressRecord, error) {
+
+	var res []*addressRecord
+	sf := map[string]string{
+		"name": fqdn,
+		"view": dnsView,
+	}
+	err := connector.GetObject(
+		newAddressRecord(addressRecord{}, kind.addrKind.isIPv6), "", ibclient.NewQueryParams(false, sf), &res)
+	if err != nil && !isNotFoundError(err) {
+		return nil, fmt.Errorf(
+			"failed getting %ss with name '%s' in DNS view '%s': %w", kind.description, fqdn, dnsView, err)
+	}
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].address() < res[j].address()
+	})
+
+	return res, nil
+}
+
+// Splits the desired values of the address field into the ones which are
+// already present among the existing records and the ones to create records for.
+// The records which do not match any of the values are returned to be deleted.
+func planRecordSetChanges(existing []*addressRecord, values []string) (
+	kept []*addressRecord, toCreate []string, toDelete []*addressRecord) {
+
+	claimed := make(map[int]bool)
+	for _, value := range values {
+		found := false
+		for i, rec := range existing {
+			if !claimed[i] && sameIPAddrs(rec.address(), value) {
+				claimed[i] = true
+				kept = append(kept, rec)
+				found = true
+				break
+			}
+		}
+		if !found {
+			toCreate = append(toCreate, value)
+		}
+	}
+	for i, rec := range existing {
+		if !claimed[i] {
+			toDelete = append(toDelete, rec)
+		}
+	}
+
+	return kept, toCreate, toDelete
+}
+
+// Splits the existing records into the ones which are tracked by the state
+// (by the addresses of its items) and the ones which are not known to it yet.
+func splitTrackedRecordSetRecords(existing []*addressRecord, stateAddrs []*hostRecordAddr) (
+	tracked []*addressRecord, untracked []*addressRecord) {
+
+	values := make([]string, 0, len(stateAddrs))
+	for _, addr := range stateAddrs {
+		if addr.allocated != "" {
+			values = append(values, addr.allocated)
+		}
+	}
+	tracked, _, untracked = planRecordSetChanges(existing, values)
+
+	return tracked, untracked
+}
+
+func resourceRecordSet(kind recordSetKind) *schema.Resource {
+	return &schema.Resource{
+		Importer: &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			"fqdn": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: fmt.Sprintf("The name of every %s of the set, in FQDN format.", kind.description),
+			},
+			"dns_view": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     defaultDNSView,
+				Description: "DNS view which the zone of the records does exist within.",
+			},
+			"network_view": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     defaultNetView,
+				Description: "Network view to use when allocating an address from a network dynamically.",
+			},
+			"address": {
+				Type:     schema.TypeList,
+				Required: true,
+				Elem:     recordSetAddrSchemaElem(kind),
+				Description: fmt.Sprintf(
+					"The addresses of the set, a separate %s is created for every item.", kind.description),
+			},
+			"ttl": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     ttlUndef,
+				Description: fmt.Sprintf("TTL value of every %s of the set.", kind.description),
+			},
+			"comment": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: fmt.Sprintf("Description of every %s of the set.", kind.description),
+			},
+			"ext_attrs": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "",
+				Description: fmt.Sprintf(
+					"Extensible attributes of every %s of the set to be added/updated, as a map in JSON format.",
+					kind.description),
+			},
+		},
+	}
+}
+
+// Builds a record out of the fields which are shared by all the records of the set.
+func buildRecordSetRecord(d *schema.ResourceData, kind recordSetKind) (*addressRecord, error) {
+	fqdn := d.Get("fqdn").(string)
+	if fqdn == "" {
+		return nil, fmt.Errorf("'fqdn' must not be empty")
+	}
+
+	var ttl uint32
+	useTtl := false
+	tempTTL := d.Get("ttl").(int)
+	if tempTTL >= 0 {
+		useTtl = true
+		ttl = uint32(tempTTL)
+	} else if tempTTL != ttlUndef {
+		return nil, fmt.Errorf("TTL value must be 0 or higher")
+	}
+
+	comment := d.Get("comment").(string)
+
+	extAttrJSON := d.Get("ext_attrs").(string)
+	extAttrs := make(map[string]interface{})
+	if extAttrJSON != "" {
+		if err := json.Unmarshal([]byte(extAttrJSON), &extAttrs); err != nil {
+			return nil, fmt.Errorf("cannot process 'ext_attrs' field: %w", err)
+		}
+	}
+
+	return newAddressRecord(addressRecord{
+		Name:    fqdn,
+		Ttl:     ttl,
+		UseTtl:  useTtl,
+		Comment: comment,
+		Ea:      extAttrs,
+	}, kind.addrKind.isIPv6), nil
+}
+
+// Creates a record for every value. The addresses which are allocated from networks
+// are written back to 'addrs', in the order of the values.
+func createRecordSetRecords(
+	connector ibclient.IBConnector, kind recordSetKind, template *addressRecord, dnsView string,
+	values []string, addrs []*hostRecordAddr) error {
+
+	for _, value := range values {
+		rec := *template
+		rec.View = dnsView
+		rec.setAddress(kind, value)
+		ref, err := connector.CreateObject(&rec)
+		if err != nil {
+			return fmt.Errorf(
+				"creation of %s with name '%s' and address '%s' under DNS view '%s' failed: %w",
+				kind.description, rec.Name, value, dnsView, err)
+		}
+
+		if !strings.HasPrefix(value, "func:") {
+			continue
+		}
+		created := newAddressRecord(addressRecord{}, kind.addrKind.isIPv6)
+		if err = connector.GetObject(created, ref, ibclient.NewQueryParams(false, nil), created); err != nil {
+			return fmt.Errorf("failed getting %s: %w", kind.description, err)
+		}
+		for _, addr := range addrs {
+			if addr.allocated == "" {
+				addr.allocated = created.address()
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+func resourceRecordSetCreate(d *schema.ResourceData, m interface{}, kind recordSetKind) error {
+	template, err := buildRecordSetRecord(d, kind)
+	if err != nil {
+		return err
+	}
+	dnsView := d.Get("dns_view").(string)
+	netView := d.Get("network_view").(string)
+
+	addrs, err := convertInterfaceToRecordSetAddrs(d.Get("address").([]interface{}))
+	if err != nil {
+		return err
+	}
+	if len(addrs) == 0 {
+		return fmt.Errorf("at least one item of 'address' must be defined")
+	}
+	values, err := prepareHostRecordAddrs(kind.addrKind, netView, addrs, nil)
+	if err != nil {
+		return err
+	}
+
+	connector := m.(ibclient.IBConnector)
+	existing, err := getRecordSetRecords(connector, kind, template.Name, dnsView)
+	if err != nil {
+		return err
+	}
+	if len(existing) > 0 {
+		return fmt.Errorf(
+			"%ss with name '%s' already exist in DNS view '%s', they must be imported to be managed as a set",
+			kind.description, template.Name, dnsView)
+	}
+
+	// The ID does not depend on the records, so it is set in advance:
+	// the records created before a failure are still tracked by the resource.
+	d.SetId(recordSetID(template.Name, dnsView))
+
+	if err = createRecordSetRecords(connector, kind, template, dnsView, values, dynamicRecordSetAddrs(addrs)); err != nil {
+		return err
+	}
+
+	if err = d.Set("address", convertRecordSetAddrsToInterface(addrs)); err != nil {
+		return err
+	}
+
+	return resourceRecordSetRead(d, m, kind)
+}
+
+// Returns the items which are allocated from a network and do not have an address yet.
+func dynamicRecordSetAddrs(addrs []*hostRecordAddr) []*hostRecordAddr {
+	res := make([]*hostRecordAddr, 0, len(addrs))
+	for _, addr := range addrs {
+		if addr.ipAddr == "" && addr.allocated == "" {
+			res = append(res, addr)
+		}
+	}
+
+	return res
+}
+
+func resourceRecordSetRead(d *schema.ResourceData, m interface{}, kind recordSetKind) error {
+	fqdn, dnsView, err := parseRecordSetID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	connector := m.(ibclient.IBConnector)
+	recs, err := getRecordSetRecords(connector, kind, fqdn, dnsView)
+	if err != nil {
+		return err
+	}
+	if len(recs) == 0 {
+		d.SetId("")
+		return nil
+	}
+
+	// The shared fields of the set are taken from the first record
+	// which differs from the state, to show the drift of any of the records.
+	ttl := d.Get("ttl").(int)
+	comment := d.Get("comment").(string)
+	extAttrs := d.Get("ext_attrs").(string)
+	stateEAs, err := normalizeRecordSetEAs(extAttrs)
+	if err != nil {
+		return err
+	}
+	ttlFound, commentFound, eaFound := false, false, false
+	for _, rec := range recs {
+		recTTL := int(rec.Ttl)
+		if !rec.UseTtl {
+			recTTL = ttlUndef
+		}
+		if !ttlFound && recTTL != ttl {
+			ttl = recTTL
+			ttlFound = true
+		}
+		if !commentFound && rec.Comment != comment {
+			comment = rec.Comment
+			commentFound = true
+		}
+
+		recEAs := ""
+		if len(rec.Ea) > 0 {
+			// TODO: temporary scaffold, need to rework marshalling/unmarshalling of EAs
+			//       (avoiding additional layer of keys ("value" key)
+			ea, err := json.Marshal((map[string]interface{})(rec.Ea))
+			if err != nil {
+				return err
+			}
+			recEAs = string(ea)
+		}
+		if !eaFound && recEAs != stateEAs {
+			extAttrs = recEAs
+			eaFound = true
+		}
+	}
+
+	if err = d.Set("ttl", ttl); err != nil {
+		return err
+	}
+	if err = d.Set("comment", comment); err != nil {
+		return err
+	}
+	if err = d.Set("ext_attrs", extAttrs); err != nil {
+		return err
+	}
+	if err = d.Set("fqdn", fqdn); err != nil {
+		return err
+	}
+	if err = d.Set("dns_view", dnsView); err != nil {
+		return err
+	}
+
+	actualAddrs := make([]*hostRecordAddr, 0, len(recs))
+	for _, rec := range recs {
+		actualAddrs = append(actualAddrs, &hostRecordAddr{allocated: rec.address()})
+	}
+	stateAddrs, err := convertInterfaceToRecordSetAddrs(d.Get("address").([]interface{}))
+	if err != nil {
+		return err
+	}
+	addrs := mergeHostRecordAddrs(stateAddrs, actualAddrs)
+
+	return d.Set("address", convertRecordSetAddrsToInterface(addrs))
+}
+
+// Brings the JSON of extensible attributes to the form produced by json.Marshal, to compare it.
+func normalizeRecordSetEAs(extAttrJSON string) (string, error) {
+	if extAttrJSON == "" {
+		return "", nil
+	}
+	extAttrs := make(map[string]interface{})
+	if err := json.Unmarshal([]byte(extAttrJSON), &extAttrs); err != nil {
+		return "", fmt.Errorf("cannot process 'ext_attrs' field: %w", err)
+	}
+	if len(extAttrs) == 0 {
+		return "", nil
+	}
+	res, err := json.Marshal(extAttrs)
+	if err != nil {
+		return "", err
+	}
+
+	return string(res), nil
+}
+
+func resourceRecordSetUpdate(d *schema.ResourceData, m interface{}, kind recordSetKind) error {
+	var updateSuccessful bool
+	defer func() {
+		// Reverting the state back, in case of a failure,
+		// otherwise Terraform will keep the values, which leaded to the failure,
+		// in the state file.
+		if !updateSuccessful {
+			prevFQDN, _ := d.GetChange("fqdn")
+			prevDNSView, _ := d.GetChange("dns_view")
+			prevNetView, _ := d.GetChange("network_view")
+			prevAddrs, _ := d.GetChange("address")
+			prevTTL, _ := d.GetChange("ttl")
+			prevComment, _ := d.GetChange("comment")
+			prevEa, _ := d.GetChange("ext_attrs")
+
+			_ = d.Set("fqdn", prevFQDN.(string))
+			_ = d.Set("dns_view", prevDNSView.(string))
+			_ = d.Set("network_view", prevNetView.(string))
+			_ = d.Set("address", prevAddrs.([]interface{}))
+			_ = d.Set("ttl", prevTTL.(int))
+			_ = d.Set("comment", prevComment.(string))
+			_ = d.Set("ext_attrs", prevEa.(string))
+		}
+	}()
+
+	if d.HasChange("dns_view") {
+		return fmt.Errorf("changing the value of 'dns_view' field is not allowed")
+	}
+	prevFQDN, dnsView, err := parseRecordSetID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	template, err := buildRecordSetRecord(d, kind)
+	if err != nil {
+		return err
+	}
+	netView := d.Get("network_view").(string)
+
+	prevItems, _ := d.GetChange("address")
+	prevAddrs, err := convertInterfaceToRecordSetAddrs(prevItems.([]interface{}))
+	if err != nil {
+		return err
+	}
+	addrs, err := convertInterfaceToRecordSetAddrs(d.Get("address").([]interface{}))
+	if err != nil {
+		return err
+	}
+	if len(addrs) == 0 {
+		return fmt.Errorf("at least one item of 'address' must be defined")
+	}
+	values, err := prepareHostRecordAddrs(kind.addrKind, netView, addrs, prevAddrs)
+	if err != nil {
+		return err
+	}
+
+	connector := m.(ibclient.IBConnector)
+	existing, err := getRecordSetRecords(connector, kind, prevFQDN, dnsView)
+	if err != nil {
+		return err
+	}
+
+	// Only the records which are tracked by the state are changed or deleted.
+	// The ones which appeared since the last refresh are not known to the user yet.
+	tracked, untracked := splitTrackedRecordSetRecords(existing, prevAddrs)
+	if len(untracked) > 0 {
+		untrackedAddrs := make([]string, 0, len(untracked))
+		for _, rec := range untracked {
+			untrackedAddrs = append(untrackedAddrs, rec.address())
+		}
+		return fmt.Errorf(
+			"%ss with name '%s' and addresses %v in DNS view '%s' are not tracked by the record set, "+
+				"the state must be refreshed to take them into account",
+			kind.description, prevFQDN, untrackedAddrs, dnsView)
+	}
+	kept, toCreate, toDelete := planRecordSetChanges(tracked, values)
+
+	// The records are deleted first, to let their addresses be reused by the new ones.
+	for _, rec := range toDelete {
+		if _, err = connector.DeleteObject(rec.Ref); err != nil {
+			return fmt.Errorf("deletion of %s with address '%s' failed: %w", kind.description, rec.address(), err)
+		}
+	}
+	if d.HasChanges("fqdn", "ttl", "comment", "ext_attrs") {
+		for _, rec := range kept {
+			if _, err = connector.UpdateObject(template, rec.Ref); err != nil {
+				return fmt.Errorf("error updating %s with address '%s': %w", kind.description, rec.address(), err)
+			}
+		}
+	}
+
+	if err = createRecordSetRecords(connector, kind, template, dnsView, toCreate, dynamicRecordSetAddrs(addrs)); err != nil {
+		return err
+	}
+
+	updateSuccessful = true
+	d.SetId(recordSetID(template.Name, dnsView))
+
+	if err = d.Set("address", convertRecordSetAddrsToInterface(addrs)); err != nil {
+		return err
+	}
+
+	return resourceRecordSetRead(d, m, kind)
+}
+
+func resourceRecordSetDelete(d *schema.ResourceData, m interface{}, kind recordSetKind) error {
+	fqdn, dnsView, err := parseRecordSetID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	stateAddrs, err := convertInterfaceToRecordSetAddrs(d.Get("address").([]interface{}))
+	if err != nil {
+		return err
+	}
+
+	connector := m.(ibclient.IBConnector)
+	existing, err := getRecordSetRecords(connector, kind, fqdn, dnsView)
+	if err != nil {
+		return err
+	}
+
+	// Only the records of the addresses held in the state are deleted,
+	// the ones created outside of Terraform in the meantime are kept.
+	tracked, _ := splitTrackedRecordSetRecords(existing, stateAddrs)
+	for _, rec := range tracked {
+		if _, err = connector.DeleteObject(rec.Ref); err != nil {
+			return fmt.Errorf("deletion of %s with address '%s' failed: %w", kind.description, rec.address(), err)
+		}
+	}
+	d.SetId("")
+
+	return nil
+}
+
+func resourceARecordSetCreate(d *schema.ResourceData, m interface{}) error {
+	return resourceRecordSetCreate(d, m, recordSetKindA)
+}
+
+func resourceARecordSetRead(d *schema.ResourceData, m interface{}) error {
+	return resourceRecordSetRead(d, m, recordSetKindA)
+}
+
+func resourceARecordSetUpdate(d *schema.ResourceData, m interface{}) error {
+	return resourceRecordSetUpdate(d, m, recordSetKindA)
+}
+
+func resourceARecordSetDelete(d *schema.ResourceData, m interface{}) error {
+	return resourceRecordSetDelete(d, m, recordSetKindA)
+}
+
+func resourceARecordSet() *schema.Resource {
+	r := resourceRecordSet(recordSetKindA)
+	r.Create = resourceARecordSetCreate
+	r.Read = resourceARecordSetRead
+	r.Update = resourceARecordSetUpdate
+	r.Delete = resourceARecordSetDelete
+
+	return r
+}
+
+func resourceAAAARecordSetCreate(d *schema.ResourceData, m interface{}) error {
+	return resourceRecordSetCreate(d, m, recordSetKindAAAA)
+}
+
+func resourceAAAARecordSetRead(d *schema.ResourceData, m interface{}) error {
+	return resourceRecordSetRead(d, m, recordSetKindAAAA)
+}
+
+func resourceAAAARecordSetUpdate(d *schema.ResourceData, m interface{}) error {
+	return resourceRecordSetUpdate(d, m, recordSetKindAAAA)
+}
+
+func resourceAAAARecordSetDelete(d *schema.ResourceData, m interface{}) error {
+	return resourceRecordSetDelete(d, m, recordSetKindAAAA)
+}
+
+func resourceAAAARecordSet() *schema.Resource {
+	r := resourceRecordSet(recordSetKindAAAA)
+	r.Create = resourceAAAARecordSetCreate
+	r.Read = resourceAAAARecordSetRead
+	r.Update = resourceAAAARecordSetUpdate
+	r.Delete = resourceAAAARecordSetDelete
+
+	return r
+}
@@ -0,0 +1,303 @@
+package infoblox
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+)
+
+func testAccCheckRecordSetDestroy(s *terraform.State) error {
+	meta := testAccProvider.Meta()
+
+	for _, rs := range s.RootModule().Resources {
+		var kind recordSetKind
+		switch rs.Type {
+		case "infoblox_a_record_set":
+			kind = recordSetKindA
+		case "infoblox_aaaa_record_set":
+			kind = recordSetKindAAAA
+		default:
+			continue
+		}
+		fqdn, dnsView, err := parseRecordSetID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		recs, err := getRecordSetRecords(meta.(ibclient.IBConnector), kind, fqdn, dnsView)
+		if err != nil {
+			return err
+		}
+		if len(recs) > 0 {
+			return fmt.Errorf("%ss of the set '%s' still exist", kind.description, rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+// The expected addresses may be defined either as addresses or as networks, for the dynamically allocated ones.
+func testAccRecordSetCompare(
+	t *testing.T, resPath string, kind recordSetKind, expectedRec *addressRecord, expectedAddrs []string) resource.TestCheckFunc {
+
+	return func(s *terraform.State) error {
+		res, found := s.RootModule().Resources[resPath]
+		if !found {
+			return fmt.Errorf("not found: %s", resPath)
+		}
+		fqdn, dnsView, err := parseRecordSetID(res.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if fqdn != expectedRec.Name {
+			return fmt.Errorf("'fqdn' does not match: got '%s', expected '%s'", fqdn, expectedRec.Name)
+		}
+		if dnsView != expectedRec.View {
+			return fmt.Errorf("'dns_view' does not match: got '%s', expected '%s'", dnsView, expectedRec.View)
+		}
+
+		meta := testAccProvider.Meta()
+		recs, err := getRecordSetRecords(meta.(ibclient.IBConnector), kind, fqdn, dnsView)
+		if err != nil {
+			return err
+		}
+
+		addrs := make([]string, 0, len(recs))
+		for _, rec := range recs {
+			if rec.UseTtl != expectedRec.UseTtl {
+				return fmt.Errorf(
+					"'use_ttl' does not match: got '%t', expected '%t'",
+					rec.UseTtl, expectedRec.UseTtl)
+			}
+			if rec.UseTtl && rec.Ttl != expectedRec.Ttl {
+				return fmt.Errorf(
+					"'ttl' does not match: got '%d', expected '%d'",
+					rec.Ttl, expectedRec.Ttl)
+			}
+			if rec.Comment != expectedRec.Comment {
+				return fmt.Errorf(
+					"'comment' does not match: got '%s', expected '%s'",
+					rec.Comment, expectedRec.Comment)
+			}
+			if err = validateEAs(rec.Ea, expectedRec.Ea); err != nil {
+				return err
+			}
+			addrs = append(addrs, rec.address())
+		}
+
+		// The records are sorted by address, the order of the expected addresses must be the same.
+		return compareHostRecordAddrs("address", addrs, expectedAddrs)
+	}
+}
+
+func TestAccResourceARecordSet(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckRecordSetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "infoblox_a_record_set" "foo" {
+						fqdn = "rrset.test.com"
+						address {
+							ip_addr = "10.0.0.21"
+						}
+						address {
+							ip_addr = "10.0.0.22"
+						}
+					}`,
+				Check: resource.ComposeTestCheckFunc(
+					testAccRecordSetCompare(t, "infoblox_a_record_set.foo", recordSetKindA, &addressRecord{
+						Name: "rrset.test.com",
+						View: "default",
+					}, []string{"10.0.0.21", "10.0.0.22"}),
+					resource.TestCheckResourceAttr("infoblox_a_record_set.foo", "id", "rrset.test.com/default"),
+				),
+			},
+			{
+				Config: `
+					resource "infoblox_a_record_set" "foo" {
+						fqdn = "rrset.test.com"
+						address {
+							ip_addr = "10.0.0.22"
+						}
+						address {
+							ip_addr = "10.0.0.23"
+						}
+						address {
+							cidr = "10.1.0.0/24"
+						}
+						ttl = 300
+						comment = "round-robin endpoints"
+						ext_attrs = jsonencode({
+							"Location" = "Test location"
+						})
+					}`,
+				Check: resource.ComposeTestCheckFunc(
+					testAccRecordSetCompare(t, "infoblox_a_record_set.foo", recordSetKindA, &addressRecord{
+						Name:    "rrset.test.com",
+						View:    "default",
+						Ttl:     300,
+						UseTtl:  true,
+						Comment: "round-robin endpoints",
+						Ea: ibclient.EA{
+							"Location": "Test location",
+						},
+					}, []string{"10.0.0.22", "10.0.0.23", "10.1.0.0/24"}),
+				),
+			},
+			{
+				ResourceName:            "infoblox_a_record_set.foo",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"address"},
+			},
+			{
+				// the address allocated from the network must be kept
+				Config: `
+					resource "infoblox_a_record_set" "foo" {
+						fqdn = "rrset2.test.com"
+						address {
+							cidr = "10.1.0.0/24"
+						}
+					}`,
+				Check: resource.ComposeTestCheckFunc(
+					testAccRecordSetCompare(t, "infoblox_a_record_set.foo", recordSetKindA, &addressRecord{
+						Name: "rrset2.test.com",
+						View: "default",
+					}, []string{"10.1.0.0/24"}),
+					resource.TestCheckResourceAttr("infoblox_a_record_set.foo", "id", "rrset2.test.com/default"),
+				),
+			},
+
+			// negative test cases
+			{
+				Config: `
+					resource "infoblox_a_record_set" "foo" {
+						fqdn = "rrset2.test.com"
+						address {
+							ip_addr = "2001:db8::21"
+						}
+					}`,
+				ExpectError: regexp.MustCompile("'2001:db8::21' is not a valid value for 'ip_addr' of 'address'"),
+			},
+			{
+				Config: `
+					resource "infoblox_a_record_set" "foo" {
+						fqdn = "rrset2.test.com"
+						dns_view = "nondefault_view"
+						address {
+							cidr = "10.1.0.0/24"
+						}
+					}`,
+				ExpectError: regexp.MustCompile("changing the value of 'dns_view' field is not allowed"),
+			},
+		},
+	})
+}
+
+func TestAccResourceAAAARecordSet(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckRecordSetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "infoblox_aaaa_record_set" "foo" {
+						fqdn = "rrset6.test.com"
+						address {
+							ip_addr = "2001:db8::21"
+						}
+						address {
+							cidr = "2001:db8:1::/64"
+						}
+						ttl = 0
+					}`,
+				Check: resource.ComposeTestCheckFunc(
+					testAccRecordSetCompare(t, "infoblox_aaaa_record_set.foo", recordSetKindAAAA, &addressRecord{
+						Name:   "rrset6.test.com",
+						View:   "default",
+						UseTtl: true,
+					}, []string{"2001:db8:1::/64", "2001:db8::21"}),
+				),
+			},
+			{
+				Config: `
+					resource "infoblox_aaaa_record_set" "foo" {
+						fqdn = "rrset6.test.com"
+						address {
+							ip_addr = "2001:db8::22"
+						}
+					}`,
+				Check: resource.ComposeTestCheckFunc(
+					testAccRecordSetCompare(t, "infoblox_aaaa_record_set.foo", recordSetKindAAAA, &addressRecord{
+						Name: "rrset6.test.com",
+						View: "default",
+					}, []string{"2001:db8::22"}),
+				),
+			},
+		},
+	})
+}
+
+func TestPlanRecordSetChanges(t *testing.T) {
+	existing := []*addressRecord{
+		{Ref: "ref1", Ipv6Addr: "2001:db8::1"},
+		{Ref: "ref2", Ipv6Addr: "2001:db8::2"},
+		{Ref: "ref3", Ipv6Addr: "2001:db8::3"},
+	}
+	values := []string{"2001:db8:0::3", "2001:db8::4", "func:nextavailableip:2001:db8::/64,default", "2001:db8::1"}
+
+	kept, toCreate, toDelete := planRecordSetChanges(existing, values)
+
+	if len(kept) != 2 || kept[0].Ref != "ref3" || kept[1].Ref != "ref1" {
+		t.Errorf("unexpected records to keep: %+v", kept)
+	}
+	if len(toCreate) != 2 || toCreate[0] != "2001:db8::4" || toCreate[1] != values[2] {
+		t.Errorf("unexpected values to create records for: %v", toCreate)
+	}
+	if len(toDelete) != 1 || toDelete[0].Ref != "ref2" {
+		t.Errorf("unexpected records to delete: %+v", toDelete)
+	}
+}
+
+func TestSplitTrackedRecordSetRecords(t *testing.T) {
+	existing := []*addressRecord{
+		{Ref: "ref1", Ipv4Addr: "10.0.0.1"},
+		{Ref: "ref2", Ipv4Addr: "10.0.0.2"},
+		{Ref: "ref3", Ipv4Addr: "10.0.1.5"},
+	}
+	stateAddrs := []*hostRecordAddr{
+		{ipAddr: "10.0.0.1", allocated: "10.0.0.1"},
+		{cidr: "10.0.1.0/24", allocated: "10.0.1.5"},
+		{cidr: "10.0.2.0/24"}, // not allocated yet
+	}
+
+	tracked, untracked := splitTrackedRecordSetRecords(existing, stateAddrs)
+	if len(tracked) != 2 || tracked[0].Ref != "ref1" || tracked[1].Ref != "ref3" {
+		t.Errorf("unexpected tracked records: %+v", tracked)
+	}
+	if len(untracked) != 1 || untracked[0].Ref != "ref2" {
+		t.Errorf("unexpected untracked records: %+v", untracked)
+	}
+}
+
+func TestParseRecordSetID(t *testing.T) {
+	fqdn, dnsView, err := parseRecordSetID(recordSetID("www.test.com", "internal/view"))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if fqdn != "www.test.com" || dnsView != "internal/view" {
+		t.Errorf("unexpected result: '%s', '%s'", fqdn, dnsView)
+	}
+
+	for _, id := range []string{"www.test.com", "/default", "www.test.com/"} {
+		if _, _, err = parseRecordSetID(id); err == nil {
+			t.Errorf("an error is expected for ID '%s'", id)
+		}
+	}
+}
@@ -0,0 +1,345 @@
+package infoblox
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+)
+
+func resourceRPZAddressRule(isIPv6 bool) *schema.Resource {
+	ipAddrField, recType := "ip_addr", "A"
+	if isIPv6 {
+		ipAddrField, recType = "ipv6_addr", "AAAA"
+	}
+
+	return &schema.Resource{
+		Delete:   resourceRPZAddressRuleDelete,
+		Importer: &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The domain name which the rule applies to, relative to the zone; may be a wildcard name like '*.example.com'.",
+			},
+			ipAddrField: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: fmt.Sprintf("The IP address which is returned in the %s-record substituting the answer.", recType),
+			},
+			"rp_zone": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The response policy zone which the rule belongs to.",
+			},
+			"dns_view": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     defaultDNSView,
+				Description: "DNS view which the zone does exist within.",
+			},
+			"ttl": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     ttlUndef,
+				Description: "TTL value for the rule.",
+			},
+			"comment": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "Description of the rule.",
+			},
+			"ext_attrs": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "Extensible attributes of the rule to be added/updated, as a map in JSON format.",
+			},
+		},
+	}
+}
+
+func getRPZAddressRuleIPAddr(d *schema.ResourceData, isIPv6 bool) (string, error) {
+	ipAddrField := "ip_addr"
+	if isIPv6 {
+		ipAddrField = "ipv6_addr"
+	}
+
+	ipAddr := d.Get(ipAddrField).(string)
+	if !isIPAddrOfVersion(net.ParseIP(ipAddr), isIPv6) {
+		if isIPv6 {
+			return "", fmt.Errorf("'%s' must be a valid IPv6 address", ipAddrField)
+		}
+		return "", fmt.Errorf("'%s' must be a valid IPv4 address", ipAddrField)
+	}
+
+	return ipAddr, nil
+}
+
+func resourceRPZAddressRuleCreate(d *schema.ResourceData, m interface{}, isIPv6 bool) error {
+	name := d.Get("name").(string)
+	if name == "" {
+		return fmt.Errorf("'name' must not be empty")
+	}
+	rpZone := d.Get("rp_zone").(string)
+	if rpZone == "" {
+		return fmt.Errorf("'rp_zone' must not be empty")
+	}
+	dnsView := d.Get("dns_view").(string)
+
+	ipAddr, err := getRPZAddressRuleIPAddr(d, isIPv6)
+	if err != nil {
+		return err
+	}
+
+	var ttl uint32
+	useTtl := false
+	tempTTL := d.Get("ttl").(int)
+	if tempTTL >= 0 {
+		useTtl = true
+		ttl = uint32(tempTTL)
+	} else if tempTTL != ttlUndef {
+		return fmt.Errorf("TTL value must be 0 or higher")
+	}
+
+	comment := d.Get("comment").(string)
+
+	extAttrJSON := d.Get("ext_attrs").(string)
+	extAttrs := make(map[string]interface{})
+	if extAttrJSON != "" {
+		if err := json.Unmarshal([]byte(extAttrJSON), &extAttrs); err != nil {
+			return fmt.Errorf("cannot process 'ext_attrs' field: %w", err)
+		}
+	}
+
+	rec := recordRPZAddress{
+		Name:    fmt.Sprintf("%s.%s", name, rpZone),
+		RpZone:  rpZone,
+		View:    dnsView,
+		Ttl:     ttl,
+		UseTtl:  useTtl,
+		Comment: comment,
+		Ea:      extAttrs,
+	}
+	if isIPv6 {
+		rec.Ipv6Addr = ipAddr
+	} else {
+		rec.Ipv4Addr = ipAddr
+	}
+
+	connector := m.(ibclient.IBConnector)
+	ref, err := connector.CreateObject(newRecordRPZAddress(rec, isIPv6))
+	if err != nil {
+		return fmt.Errorf("creation of RPZ substitution rule in zone '%s' failed: %w", rpZone, err)
+	}
+	d.SetId(ref)
+
+	return nil
+}
+
+func resourceRPZAddressRuleRead(d *schema.ResourceData, m interface{}, isIPv6 bool) error {
+	connector := m.(ibclient.IBConnector)
+
+	obj := newRecordRPZAddress(recordRPZAddress{}, isIPv6)
+	if err := connector.GetObject(obj, d.Id(), ibclient.NewQueryParams(false, nil), obj); err != nil {
+		return fmt.Errorf("failed getting RPZ substitution rule: %w", err)
+	}
+
+	ttl := int(obj.Ttl)
+	if !obj.UseTtl {
+		ttl = ttlUndef
+	}
+	if err := d.Set("ttl", ttl); err != nil {
+		return err
+	}
+
+	if obj.Ea != nil && len(obj.Ea) > 0 {
+		// TODO: temporary scaffold, need to rework marshalling/unmarshalling of EAs
+		//       (avoiding additional layer of keys ("value" key)
+		eaMap := (map[string]interface{})(obj.Ea)
+		ea, err := json.Marshal(eaMap)
+		if err != nil {
+			return err
+		}
+		if err = d.Set("ext_attrs", string(ea)); err != nil {
+			return err
+		}
+	}
+
+	if isIPv6 {
+		if err := d.Set("ipv6_addr", obj.Ipv6Addr); err != nil {
+			return err
+		}
+	} else {
+		if err := d.Set("ip_addr", obj.Ipv4Addr); err != nil {
+			return err
+		}
+	}
+	if err := d.Set("name", strings.TrimSuffix(obj.Name, "."+obj.RpZone)); err != nil {
+		return err
+	}
+	if err := d.Set("rp_zone", obj.RpZone); err != nil {
+		return err
+	}
+	if err := d.Set("dns_view", obj.View); err != nil {
+		return err
+	}
+	if err := d.Set("comment", obj.Comment); err != nil {
+		return err
+	}
+
+	d.SetId(obj.Ref)
+
+	return nil
+}
+
+func resourceRPZAddressRuleUpdate(d *schema.ResourceData, m interface{}, isIPv6 bool) error {
+	ipAddrField := "ip_addr"
+	if isIPv6 {
+		ipAddrField = "ipv6_addr"
+	}
+
+	var updateSuccessful bool
+	defer func() {
+		// Reverting the state back, in case of a failure,
+		// otherwise Terraform will keep the values, which leaded to the failure,
+		// in the state file.
+		if !updateSuccessful {
+			prevName, _ := d.GetChange("name")
+			prevIPAddr, _ := d.GetChange(ipAddrField)
+			prevRpZone, _ := d.GetChange("rp_zone")
+			prevDNSView, _ := d.GetChange("dns_view")
+			prevTTL, _ := d.GetChange("ttl")
+			prevComment, _ := d.GetChange("comment")
+			prevEa, _ := d.GetChange("ext_attrs")
+
+			_ = d.Set("name", prevName.(string))
+			_ = d.Set(ipAddrField, prevIPAddr.(string))
+			_ = d.Set("rp_zone", prevRpZone.(string))
+			_ = d.Set("dns_view", prevDNSView.(string))
+			_ = d.Set("ttl", prevTTL.(int))
+			_ = d.Set("comment", prevComment.(string))
+			_ = d.Set("ext_attrs", prevEa.(string))
+		}
+	}()
+
+	if d.HasChange("rp_zone") {
+		return fmt.Errorf("changing the value of 'rp_zone' field is not allowed")
+	}
+	if d.HasChange("dns_view") {
+		return fmt.Errorf("changing the value of 'dns_view' field is not allowed")
+	}
+
+	name := d.Get("name").(string)
+	if name == "" {
+		return fmt.Errorf("'name' must not be empty")
+	}
+	rpZone := d.Get("rp_zone").(string)
+
+	ipAddr, err := getRPZAddressRuleIPAddr(d, isIPv6)
+	if err != nil {
+		return err
+	}
+
+	var ttl uint32
+	useTtl := false
+	tempTTL := d.Get("ttl").(int)
+	if tempTTL >= 0 {
+		useTtl = true
+		ttl = uint32(tempTTL)
+	} else if tempTTL != ttlUndef {
+		return fmt.Errorf("TTL value must be 0 or higher")
+	}
+
+	comment := d.Get("comment").(string)
+
+	extAttrJSON := d.Get("ext_attrs").(string)
+	extAttrs := make(map[string]interface{})
+	if extAttrJSON != "" {
+		if err := json.Unmarshal([]byte(extAttrJSON), &extAttrs); err != nil {
+			return fmt.Errorf("cannot process 'ext_attrs' field: %w", err)
+		}
+	}
+
+	rec := recordRPZAddress{
+		Name:    fmt.Sprintf("%s.%s", name, rpZone),
+		Ttl:     ttl,
+		UseTtl:  useTtl,
+		Comment: comment,
+		Ea:      extAttrs,
+	}
+	if isIPv6 {
+		rec.Ipv6Addr = ipAddr
+	} else {
+		rec.Ipv4Addr = ipAddr
+	}
+
+	connector := m.(ibclient.IBConnector)
+	ref, err := connector.UpdateObject(newRecordRPZAddress(rec, isIPv6), d.Id())
+	if err != nil {
+		return fmt.Errorf("error updating RPZ substitution rule: %w", err)
+	}
+	updateSuccessful = true
+	d.SetId(ref)
+
+	return nil
+}
+
+func resourceRPZAddressRuleDelete(d *schema.ResourceData, m interface{}) error {
+	connector := m.(ibclient.IBConnector)
+
+	if _, err := connector.DeleteObject(d.Id()); err != nil {
+		return fmt.Errorf("deletion of RPZ substitution rule failed: %w", err)
+	}
+	d.SetId("")
+
+	return nil
+}
+
+func resourceRPZRuleACreate(d *schema.ResourceData, m interface{}) error {
+	return resourceRPZAddressRuleCreate(d, m, false)
+}
+
+func resourceRPZRuleARead(d *schema.ResourceData, m interface{}) error {
+	return resourceRPZAddressRuleRead(d, m, false)
+}
+
+func resourceRPZRuleAUpdate(d *schema.ResourceData, m interface{}) error {
+	return resourceRPZAddressRuleUpdate(d, m, false)
+}
+
+func resourceRPZRuleA() *schema.Resource {
+	r := resourceRPZAddressRule(false)
+	r.Create = resourceRPZRuleACreate
+	r.Read = resourceRPZRuleARead
+	r.Update = resourceRPZRuleAUpdate
+
+	return r
+}
+
+func resourceRPZRuleAAAACreate(d *schema.ResourceData, m interface{}) error {
+	return resourceRPZAddressRuleCreate(d, m, true)
+}
+
+func resourceRPZRuleAAAARead(d *schema.ResourceData, m interface{}) error {
+	return resourceRPZAddressRuleRead(d, m, true)
+}
+
+func resourceRPZRuleAAAAUpdate(d *schema.ResourceData, m interface{}) error {
+	return resourceRPZAddressRuleUpdate(d, m, true)
+}
+
+func resourceRPZRuleAAAA() *schema.Resource {
+	r := resourceRPZAddressRule(true)
+	r.Create = resourceRPZRuleAAAACreate
+	r.Read = resourceRPZRuleAAAARead
+	r.Update = resourceRPZRuleAAAAUpdate
+
+	return r
+}
@@ -0,0 +1,151 @@
+package infoblox
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+)
+
+func testAccCheckRPZAddressRuleDestroy(s *terraform.State) error {
+	meta := testAccProvider.Meta()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "infoblox_rpz_rule_a" && rs.Type != "infoblox_rpz_rule_aaaa" {
+			continue
+		}
+		connector := meta.(ibclient.IBConnector)
+		rec := newRecordRPZAddress(recordRPZAddress{}, rs.Type == "infoblox_rpz_rule_aaaa")
+		err := connector.GetObject(rec, rs.Primary.ID, ibclient.NewQueryParams(false, nil), rec)
+		if err == nil {
+			return fmt.Errorf("RPZ substitution rule still exists")
+		}
+	}
+	return nil
+}
+
+func testAccRPZAddressRuleCompare(
+	t *testing.T, resPath string, isIPv6 bool, expectedRec *recordRPZAddress) resource.TestCheckFunc {
+
+	return func(s *terraform.State) error {
+		res, found := s.RootModule().Resources[resPath]
+		if !found {
+			return fmt.Errorf("not found: %s", resPath)
+		}
+		if res.Primary.ID == "" {
+			return fmt.Errorf("ID is not set")
+		}
+		meta := testAccProvider.Meta()
+		connector := meta.(ibclient.IBConnector)
+
+		rec := newRecordRPZAddress(recordRPZAddress{}, isIPv6)
+		if err := connector.GetObject(rec, res.Primary.ID, ibclient.NewQueryParams(false, nil), rec); err != nil {
+			return fmt.Errorf("RPZ substitution rule not found: %s", err)
+		}
+
+		if rec.Name != expectedRec.Name {
+			return fmt.Errorf(
+				"'name' does not match: got '%s', expected '%s'",
+				rec.Name, expectedRec.Name)
+		}
+		if rec.Ipv4Addr != expectedRec.Ipv4Addr {
+			return fmt.Errorf(
+				"'ipv4addr' does not match: got '%s', expected '%s'",
+				rec.Ipv4Addr, expectedRec.Ipv4Addr)
+		}
+		if rec.Ipv6Addr != expectedRec.Ipv6Addr {
+			return fmt.Errorf(
+				"'ipv6addr' does not match: got '%s', expected '%s'",
+				rec.Ipv6Addr, expectedRec.Ipv6Addr)
+		}
+		if rec.RpZone != expectedRec.RpZone {
+			return fmt.Errorf(
+				"'rp_zone' does not match: got '%s', expected '%s'",
+				rec.RpZone, expectedRec.RpZone)
+		}
+		if rec.Comment != expectedRec.Comment {
+			return fmt.Errorf(
+				"'comment' does not match: got '%s', expected '%s'",
+				rec.Comment, expectedRec.Comment)
+		}
+		return validateEAs(rec.Ea, expectedRec.Ea)
+	}
+}
+
+func TestAccResourceRPZRuleAddress(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckRPZAddressRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRPZZoneConfig + `
+					resource "infoblox_rpz_rule_a" "foo"{
+						name = "bad.example.com"
+						ip_addr = "10.20.0.1"
+						rp_zone = infoblox_zone_rp.rpz.fqdn
+					}
+					resource "infoblox_rpz_rule_aaaa" "foo"{
+						name = "bad.example.com"
+						ipv6_addr = "2001:db8::1"
+						rp_zone = infoblox_zone_rp.rpz.fqdn
+					}`,
+				Check: resource.ComposeTestCheckFunc(
+					testAccRPZAddressRuleCompare(t, "infoblox_rpz_rule_a.foo", false, &recordRPZAddress{
+						Name:     "bad.example.com.rpz.test.com",
+						Ipv4Addr: "10.20.0.1",
+						RpZone:   "rpz.test.com",
+					}),
+					testAccRPZAddressRuleCompare(t, "infoblox_rpz_rule_aaaa.foo", true, &recordRPZAddress{
+						Name:     "bad.example.com.rpz.test.com",
+						Ipv6Addr: "2001:db8::1",
+						RpZone:   "rpz.test.com",
+					}),
+				),
+			},
+			{
+				Config: testAccRPZZoneConfig + `
+					resource "infoblox_rpz_rule_a" "foo"{
+						name = "bad.example.com"
+						ip_addr = "10.20.0.2"
+						rp_zone = infoblox_zone_rp.rpz.fqdn
+						ttl = 60
+						comment = "test comment 1"
+						ext_attrs = jsonencode({
+							"Location" = "Los Angeles"
+						})
+					}
+					resource "infoblox_rpz_rule_aaaa" "foo"{
+						name = "bad.example.com"
+						ipv6_addr = "2001:db8::1"
+						rp_zone = infoblox_zone_rp.rpz.fqdn
+					}`,
+				Check: resource.ComposeTestCheckFunc(
+					testAccRPZAddressRuleCompare(t, "infoblox_rpz_rule_a.foo", false, &recordRPZAddress{
+						Name:     "bad.example.com.rpz.test.com",
+						Ipv4Addr: "10.20.0.2",
+						RpZone:   "rpz.test.com",
+						Comment:  "test comment 1",
+						Ea: ibclient.EA{
+							"Location": "Los Angeles",
+						},
+					}),
+				),
+			},
+
+			// negative test cases
+			{
+				Config: testAccRPZZoneConfig + `
+					resource "infoblox_rpz_rule_a" "foo"{
+						name = "bad.example.com"
+						ip_addr = "2001:db8::2"
+						rp_zone = infoblox_zone_rp.rpz.fqdn
+					}`,
+				ExpectError: regexp.MustCompile("'ip_addr' must be a valid IPv4 address"),
+			},
+		},
+	})
+}
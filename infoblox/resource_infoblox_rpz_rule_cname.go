@@ -0,0 +1,493 @@
+package infoblox
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+)
+
+// rpzCNameRuleKind describes a kind of RPZ rules which are represented
+// by CNAME-like WAPI objects: the trigger (what the rule matches) is encoded
+// in the record's name and the action is encoded in the canonical name.
+type rpzCNameRuleKind struct {
+	objectType string
+
+	// The name of the resource's field which contains the trigger.
+	triggerField string
+	// The label which is put between the trigger and the zone's name
+	// in the record's name, if any.
+	triggerLabel string
+	// If true, a PASSTHRU rule has the trigger as the canonical name,
+	// otherwise 'rpz-passthru' is used.
+	passthruByName  bool
+	allowSubstitute bool
+
+	description string
+}
+
+const rpzPassthruCanonical = "rpz-passthru"
+
+var (
+	rpzRuleKindQName = rpzCNameRuleKind{
+		objectType:      "record:rpz:cname",
+		triggerField:    "name",
+		passthruByName:  true,
+		allowSubstitute: true,
+		description:     "RPZ domain name rule",
+	}
+	rpzRuleKindClientIP = rpzCNameRuleKind{
+		objectType:   "record:rpz:cname:clientipaddress",
+		triggerField: "client_ip",
+		description:  "RPZ client IP address rule",
+	}
+	rpzRuleKindNSDName = rpzCNameRuleKind{
+		objectType:   "record:rpz:cname",
+		triggerField: "nameserver",
+		triggerLabel: "rpz-nsdname",
+		description:  "RPZ name server name rule",
+	}
+	rpzRuleKindNSIP = rpzCNameRuleKind{
+		objectType:   "record:rpz:cname:ipaddress",
+		triggerField: "nameserver_ip",
+		triggerLabel: "rpz-nsip",
+		description:  "RPZ name server IP address rule",
+	}
+)
+
+// Composes the record's name from the rule's trigger and the zone's name.
+func (kind rpzCNameRuleKind) recordName(trigger, rpZone string) string {
+	if kind.triggerLabel == "" {
+		return fmt.Sprintf("%s.%s", trigger, rpZone)
+	}
+	return fmt.Sprintf("%s.%s.%s", trigger, kind.triggerLabel, rpZone)
+}
+
+func (kind rpzCNameRuleKind) trigger(recordName, rpZone string) string {
+	suffix := "." + rpZone
+	if kind.triggerLabel != "" {
+		suffix = "." + kind.triggerLabel + suffix
+	}
+	return strings.TrimSuffix(recordName, suffix)
+}
+
+func (kind rpzCNameRuleKind) canonical(action, trigger, substituteName string) (string, error) {
+	if action != "SUBSTITUTE" && substituteName != "" {
+		return "", fmt.Errorf("'substitute_name' may be set only for 'SUBSTITUTE' value of 'action'")
+	}
+
+	switch action {
+	case "NXDOMAIN":
+		return "", nil
+	case "NODATA":
+		return "*", nil
+	case "PASSTHRU":
+		if kind.passthruByName {
+			return trigger, nil
+		}
+		return rpzPassthruCanonical, nil
+	case "SUBSTITUTE":
+		if !kind.allowSubstitute {
+			break
+		}
+		if substituteName == "" {
+			return "", fmt.Errorf("'substitute_name' must not be empty for 'SUBSTITUTE' value of 'action'")
+		}
+		return substituteName, nil
+	}
+
+	if kind.allowSubstitute {
+		return "", fmt.Errorf("'action' must be one of 'NXDOMAIN', 'NODATA', 'PASSTHRU' or 'SUBSTITUTE'")
+	}
+	return "", fmt.Errorf("'action' must be one of 'NXDOMAIN', 'NODATA' or 'PASSTHRU'")
+}
+
+func (kind rpzCNameRuleKind) action(canonical, trigger string) (action string, substituteName string) {
+	switch {
+	case canonical == "":
+		return "NXDOMAIN", ""
+	case canonical == "*":
+		return "NODATA", ""
+	case canonical == rpzPassthruCanonical, kind.passthruByName && canonical == trigger:
+		return "PASSTHRU", ""
+	}
+
+	return "SUBSTITUTE", canonical
+}
+
+func resourceRPZCNameRule(kind rpzCNameRuleKind, triggerDescription string) *schema.Resource {
+	actionDescription := "The action of the rule: 'NXDOMAIN' (the default), 'NODATA' or 'PASSTHRU'."
+	if kind.allowSubstitute {
+		actionDescription = "The action of the rule: 'NXDOMAIN' (the default), 'NODATA', 'PASSTHRU' or 'SUBSTITUTE'."
+	}
+
+	res := &schema.Resource{
+		Delete:   resourceRPZCNameRuleDelete,
+		Importer: &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			kind.triggerField: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: triggerDescription,
+			},
+			"rp_zone": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The response policy zone which the rule belongs to.",
+			},
+			"dns_view": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     defaultDNSView,
+				Description: "DNS view which the zone does exist within.",
+			},
+			"action": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "NXDOMAIN",
+				Description: actionDescription,
+			},
+			"ttl": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     ttlUndef,
+				Description: "TTL value for the rule.",
+			},
+			"comment": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "Description of the rule.",
+			},
+			"ext_attrs": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "Extensible attributes of the rule to be added/updated, as a map in JSON format.",
+			},
+		},
+	}
+	if kind.allowSubstitute {
+		res.Schema["substitute_name"] = &schema.Schema{
+			Type:        schema.TypeString,
+			Optional:    true,
+			Default:     "",
+			Description: "The domain name which the answer is substituted with, for the 'SUBSTITUTE' action.",
+		}
+	}
+
+	return res
+}
+
+func getRPZRuleSubstituteName(d *schema.ResourceData, kind rpzCNameRuleKind) string {
+	if !kind.allowSubstitute {
+		return ""
+	}
+	return d.Get("substitute_name").(string)
+}
+
+func resourceRPZCNameRuleCreate(d *schema.ResourceData, m interface{}, kind rpzCNameRuleKind) error {
+	trigger := d.Get(kind.triggerField).(string)
+	if trigger == "" {
+		return fmt.Errorf("'%s' must not be empty", kind.triggerField)
+	}
+	rpZone := d.Get("rp_zone").(string)
+	if rpZone == "" {
+		return fmt.Errorf("'rp_zone' must not be empty")
+	}
+	dnsView := d.Get("dns_view").(string)
+
+	canonical, err := kind.canonical(d.Get("action").(string), trigger, getRPZRuleSubstituteName(d, kind))
+	if err != nil {
+		return err
+	}
+
+	var ttl uint32
+	useTtl := false
+	tempTTL := d.Get("ttl").(int)
+	if tempTTL >= 0 {
+		useTtl = true
+		ttl = uint32(tempTTL)
+	} else if tempTTL != ttlUndef {
+		return fmt.Errorf("TTL value must be 0 or higher")
+	}
+
+	comment := d.Get("comment").(string)
+
+	extAttrJSON := d.Get("ext_attrs").(string)
+	extAttrs := make(map[string]interface{})
+	if extAttrJSON != "" {
+		if err := json.Unmarshal([]byte(extAttrJSON), &extAttrs); err != nil {
+			return fmt.Errorf("cannot process 'ext_attrs' field: %w", err)
+		}
+	}
+
+	rec := newRecordRPZCName(kind.objectType, recordRPZCName{
+		Name:      kind.recordName(trigger, rpZone),
+		Canonical: canonical,
+		RpZone:    rpZone,
+		View:      dnsView,
+		Ttl:       ttl,
+		UseTtl:    useTtl,
+		Comment:   comment,
+		Ea:        extAttrs,
+	})
+
+	connector := m.(ibclient.IBConnector)
+	ref, err := connector.CreateObject(rec)
+	if err != nil {
+		return fmt.Errorf("creation of %s in zone '%s' failed: %w", kind.description, rpZone, err)
+	}
+	d.SetId(ref)
+
+	return nil
+}
+
+func resourceRPZCNameRuleRead(d *schema.ResourceData, m interface{}, kind rpzCNameRuleKind) error {
+	connector := m.(ibclient.IBConnector)
+
+	obj := newRecordRPZCName(kind.objectType, recordRPZCName{})
+	if err := connector.GetObject(obj, d.Id(), ibclient.NewQueryParams(false, nil), obj); err != nil {
+		return fmt.Errorf("failed getting %s: %w", kind.description, err)
+	}
+
+	ttl := int(obj.Ttl)
+	if !obj.UseTtl {
+		ttl = ttlUndef
+	}
+	if err := d.Set("ttl", ttl); err != nil {
+		return err
+	}
+
+	if obj.Ea != nil && len(obj.Ea) > 0 {
+		// TODO: temporary scaffold, need to rework marshalling/unmarshalling of EAs
+		//       (avoiding additional layer of keys ("value" key)
+		eaMap := (map[string]interface{})(obj.Ea)
+		ea, err := json.Marshal(eaMap)
+		if err != nil {
+			return err
+		}
+		if err = d.Set("ext_attrs", string(ea)); err != nil {
+			return err
+		}
+	}
+
+	trigger := kind.trigger(obj.Name, obj.RpZone)
+	action, substituteName := kind.action(obj.Canonical, trigger)
+
+	if err := d.Set(kind.triggerField, trigger); err != nil {
+		return err
+	}
+	if err := d.Set("rp_zone", obj.RpZone); err != nil {
+		return err
+	}
+	if err := d.Set("dns_view", obj.View); err != nil {
+		return err
+	}
+	if err := d.Set("action", action); err != nil {
+		return err
+	}
+	if kind.allowSubstitute {
+		if err := d.Set("substitute_name", substituteName); err != nil {
+			return err
+		}
+	}
+	if err := d.Set("comment", obj.Comment); err != nil {
+		return err
+	}
+
+	d.SetId(obj.Ref)
+
+	return nil
+}
+
+func resourceRPZCNameRuleUpdate(d *schema.ResourceData, m interface{}, kind rpzCNameRuleKind) error {
+	var updateSuccessful bool
+	defer func() {
+		// Reverting the state back, in case of a failure,
+		// otherwise Terraform will keep the values, which leaded to the failure,
+		// in the state file.
+		if !updateSuccessful {
+			prevTrigger, _ := d.GetChange(kind.triggerField)
+			prevRpZone, _ := d.GetChange("rp_zone")
+			prevDNSView, _ := d.GetChange("dns_view")
+			prevAction, _ := d.GetChange("action")
+			prevTTL, _ := d.GetChange("ttl")
+			prevComment, _ := d.GetChange("comment")
+			prevEa, _ := d.GetChange("ext_attrs")
+
+			_ = d.Set(kind.triggerField, prevTrigger.(string))
+			_ = d.Set("rp_zone", prevRpZone.(string))
+			_ = d.Set("dns_view", prevDNSView.(string))
+			_ = d.Set("action", prevAction.(string))
+			_ = d.Set("ttl", prevTTL.(int))
+			_ = d.Set("comment", prevComment.(string))
+			_ = d.Set("ext_attrs", prevEa.(string))
+
+			if kind.allowSubstitute {
+				prevSubstituteName, _ := d.GetChange("substitute_name")
+				_ = d.Set("substitute_name", prevSubstituteName.(string))
+			}
+		}
+	}()
+
+	if d.HasChange("rp_zone") {
+		return fmt.Errorf("changing the value of 'rp_zone' field is not allowed")
+	}
+	if d.HasChange("dns_view") {
+		return fmt.Errorf("changing the value of 'dns_view' field is not allowed")
+	}
+
+	trigger := d.Get(kind.triggerField).(string)
+	if trigger == "" {
+		return fmt.Errorf("'%s' must not be empty", kind.triggerField)
+	}
+	rpZone := d.Get("rp_zone").(string)
+
+	canonical, err := kind.canonical(d.Get("action").(string), trigger, getRPZRuleSubstituteName(d, kind))
+	if err != nil {
+		return err
+	}
+
+	var ttl uint32
+	useTtl := false
+	tempTTL := d.Get("ttl").(int)
+	if tempTTL >= 0 {
+		useTtl = true
+		ttl = uint32(tempTTL)
+	} else if tempTTL != ttlUndef {
+		return fmt.Errorf("TTL value must be 0 or higher")
+	}
+
+	comment := d.Get("comment").(string)
+
+	extAttrJSON := d.Get("ext_attrs").(string)
+	extAttrs := make(map[string]interface{})
+	if extAttrJSON != "" {
+		if err := json.Unmarshal([]byte(extAttrJSON), &extAttrs); err != nil {
+			return fmt.Errorf("cannot process 'ext_attrs' field: %w", err)
+		}
+	}
+
+	rec := newRecordRPZCName(kind.objectType, recordRPZCName{
+		Name:      kind.recordName(trigger, rpZone),
+		Canonical: canonical,
+		Ttl:       ttl,
+		UseTtl:    useTtl,
+		Comment:   comment,
+		Ea:        extAttrs,
+	})
+
+	connector := m.(ibclient.IBConnector)
+	ref, err := connector.UpdateObject(rec, d.Id())
+	if err != nil {
+		return fmt.Errorf("error updating %s: %w", kind.description, err)
+	}
+	updateSuccessful = true
+	d.SetId(ref)
+
+	return nil
+}
+
+func resourceRPZCNameRuleDelete(d *schema.ResourceData, m interface{}) error {
+	connector := m.(ibclient.IBConnector)
+
+	if _, err := connector.DeleteObject(d.Id()); err != nil {
+		return fmt.Errorf("deletion of the RPZ rule failed: %w", err)
+	}
+	d.SetId("")
+
+	return nil
+}
+
+func resourceRPZRuleCNameCreate(d *schema.ResourceData, m interface{}) error {
+	return resourceRPZCNameRuleCreate(d, m, rpzRuleKindQName)
+}
+
+func resourceRPZRuleCNameRead(d *schema.ResourceData, m interface{}) error {
+	return resourceRPZCNameRuleRead(d, m, rpzRuleKindQName)
+}
+
+func resourceRPZRuleCNameUpdate(d *schema.ResourceData, m interface{}) error {
+	return resourceRPZCNameRuleUpdate(d, m, rpzRuleKindQName)
+}
+
+func resourceRPZRuleCName() *schema.Resource {
+	r := resourceRPZCNameRule(rpzRuleKindQName,
+		"The domain name which the rule applies to, relative to the zone; may be a wildcard name like '*.example.com'.")
+	r.Create = resourceRPZRuleCNameCreate
+	r.Read = resourceRPZRuleCNameRead
+	r.Update = resourceRPZRuleCNameUpdate
+
+	return r
+}
+
+func resourceRPZRuleClientIPCreate(d *schema.ResourceData, m interface{}) error {
+	return resourceRPZCNameRuleCreate(d, m, rpzRuleKindClientIP)
+}
+
+func resourceRPZRuleClientIPRead(d *schema.ResourceData, m interface{}) error {
+	return resourceRPZCNameRuleRead(d, m, rpzRuleKindClientIP)
+}
+
+func resourceRPZRuleClientIPUpdate(d *schema.ResourceData, m interface{}) error {
+	return resourceRPZCNameRuleUpdate(d, m, rpzRuleKindClientIP)
+}
+
+func resourceRPZRuleClientIP() *schema.Resource {
+	r := resourceRPZCNameRule(rpzRuleKindClientIP,
+		"The IP address or the network (in CIDR format) of the clients which the rule applies to.")
+	r.Create = resourceRPZRuleClientIPCreate
+	r.Read = resourceRPZRuleClientIPRead
+	r.Update = resourceRPZRuleClientIPUpdate
+
+	return r
+}
+
+func resourceRPZRuleNSDNameCreate(d *schema.ResourceData, m interface{}) error {
+	return resourceRPZCNameRuleCreate(d, m, rpzRuleKindNSDName)
+}
+
+func resourceRPZRuleNSDNameRead(d *schema.ResourceData, m interface{}) error {
+	return resourceRPZCNameRuleRead(d, m, rpzRuleKindNSDName)
+}
+
+func resourceRPZRuleNSDNameUpdate(d *schema.ResourceData, m interface{}) error {
+	return resourceRPZCNameRuleUpdate(d, m, rpzRuleKindNSDName)
+}
+
+func resourceRPZRuleNSDName() *schema.Resource {
+	r := resourceRPZCNameRule(rpzRuleKindNSDName,
+		"The name of the authoritative name server which the rule applies to.")
+	r.Create = resourceRPZRuleNSDNameCreate
+	r.Read = resourceRPZRuleNSDNameRead
+	r.Update = resourceRPZRuleNSDNameUpdate
+
+	return r
+}
+
+func resourceRPZRuleNSIPCreate(d *schema.ResourceData, m interface{}) error {
+	return resourceRPZCNameRuleCreate(d, m, rpzRuleKindNSIP)
+}
+
+func resourceRPZRuleNSIPRead(d *schema.ResourceData, m interface{}) error {
+	return resourceRPZCNameRuleRead(d, m, rpzRuleKindNSIP)
+}
+
+func resourceRPZRuleNSIPUpdate(d *schema.ResourceData, m interface{}) error {
+	return resourceRPZCNameRuleUpdate(d, m, rpzRuleKindNSIP)
+}
+
+func resourceRPZRuleNSIP() *schema.Resource {
+	r := resourceRPZCNameRule(rpzRuleKindNSIP,
+		"The IP address or the network (in CIDR format) of the authoritative name servers which the rule applies to.")
+	r.Create = resourceRPZRuleNSIPCreate
+	r.Read = resourceRPZRuleNSIPRead
+	r.Update = resourceRPZRuleNSIPUpdate
+
+	return r
+}
@@ -0,0 +1,262 @@
+package infoblox
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+)
+
+var rpzRuleKindsByResourceType = map[string]rpzCNameRuleKind{
+	"infoblox_rpz_rule_cname":     rpzRuleKindQName,
+	"infoblox_rpz_rule_client_ip": rpzRuleKindClientIP,
+	"infoblox_rpz_rule_nsdname":   rpzRuleKindNSDName,
+	"infoblox_rpz_rule_nsip":      rpzRuleKindNSIP,
+}
+
+func testAccCheckRPZCNameRuleDestroy(s *terraform.State) error {
+	meta := testAccProvider.Meta()
+
+	for _, rs := range s.RootModule().Resources {
+		kind, found := rpzRuleKindsByResourceType[rs.Type]
+		if !found {
+			continue
+		}
+		connector := meta.(ibclient.IBConnector)
+		rec := newRecordRPZCName(kind.objectType, recordRPZCName{})
+		err := connector.GetObject(rec, rs.Primary.ID, ibclient.NewQueryParams(false, nil), rec)
+		if err == nil {
+			return fmt.Errorf("RPZ rule still exists")
+		}
+	}
+	return nil
+}
+
+func testAccRPZCNameRuleCompare(
+	t *testing.T, resPath string, kind rpzCNameRuleKind, expectedRec *recordRPZCName) resource.TestCheckFunc {
+
+	return func(s *terraform.State) error {
+		res, found := s.RootModule().Resources[resPath]
+		if !found {
+			return fmt.Errorf("not found: %s", resPath)
+		}
+		if res.Primary.ID == "" {
+			return fmt.Errorf("ID is not set")
+		}
+		meta := testAccProvider.Meta()
+		connector := meta.(ibclient.IBConnector)
+
+		rec := newRecordRPZCName(kind.objectType, recordRPZCName{})
+		if err := connector.GetObject(rec, res.Primary.ID, ibclient.NewQueryParams(false, nil), rec); err != nil {
+			return fmt.Errorf("RPZ rule not found: %s", err)
+		}
+
+		if rec.Name != expectedRec.Name {
+			return fmt.Errorf(
+				"'name' does not match: got '%s', expected '%s'",
+				rec.Name, expectedRec.Name)
+		}
+		if rec.Canonical != expectedRec.Canonical {
+			return fmt.Errorf(
+				"'canonical' does not match: got '%s', expected '%s'",
+				rec.Canonical, expectedRec.Canonical)
+		}
+		if rec.RpZone != expectedRec.RpZone {
+			return fmt.Errorf(
+				"'rp_zone' does not match: got '%s', expected '%s'",
+				rec.RpZone, expectedRec.RpZone)
+		}
+		if rec.UseTtl != expectedRec.UseTtl {
+			return fmt.Errorf(
+				"TTL usage does not match: got '%t', expected '%t'",
+				rec.UseTtl, expectedRec.UseTtl)
+		}
+		if rec.UseTtl && rec.Ttl != expectedRec.Ttl {
+			return fmt.Errorf(
+				"'ttl' does not match: got '%d', expected '%d'",
+				rec.Ttl, expectedRec.Ttl)
+		}
+		if rec.Comment != expectedRec.Comment {
+			return fmt.Errorf(
+				"'comment' does not match: got '%s', expected '%s'",
+				rec.Comment, expectedRec.Comment)
+		}
+		return validateEAs(rec.Ea, expectedRec.Ea)
+	}
+}
+
+const testAccRPZZoneConfig = `
+resource "infoblox_zone_rp" "rpz" {
+	fqdn = "rpz.test.com"
+	grid_primary {
+		name = "infoblox.localdomain"
+	}
+}
+`
+
+func TestAccResourceRPZRuleCName(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckRPZCNameRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRPZZoneConfig + `
+					resource "infoblox_rpz_rule_cname" "foo"{
+						name = "bad.example.com"
+						rp_zone = infoblox_zone_rp.rpz.fqdn
+					}`,
+				Check: resource.ComposeTestCheckFunc(
+					testAccRPZCNameRuleCompare(t, "infoblox_rpz_rule_cname.foo", rpzRuleKindQName, &recordRPZCName{
+						Name:      "bad.example.com.rpz.test.com",
+						Canonical: "",
+						RpZone:    "rpz.test.com",
+					}),
+					resource.TestCheckResourceAttr("infoblox_rpz_rule_cname.foo", "action", "NXDOMAIN"),
+				),
+			},
+			{
+				Config: testAccRPZZoneConfig + `
+					resource "infoblox_rpz_rule_cname" "foo"{
+						name = "*.bad.example.com"
+						rp_zone = infoblox_zone_rp.rpz.fqdn
+						action = "PASSTHRU"
+						ttl = 300
+						comment = "test comment 1"
+						ext_attrs = jsonencode({
+							"Location" = "Los Angeles"
+						})
+					}`,
+				Check: resource.ComposeTestCheckFunc(
+					testAccRPZCNameRuleCompare(t, "infoblox_rpz_rule_cname.foo", rpzRuleKindQName, &recordRPZCName{
+						Name:      "*.bad.example.com.rpz.test.com",
+						Canonical: "*.bad.example.com",
+						RpZone:    "rpz.test.com",
+						Ttl:       300,
+						UseTtl:    true,
+						Comment:   "test comment 1",
+						Ea: ibclient.EA{
+							"Location": "Los Angeles",
+						},
+					}),
+					resource.TestCheckResourceAttr("infoblox_rpz_rule_cname.foo", "action", "PASSTHRU"),
+				),
+			},
+			{
+				Config: testAccRPZZoneConfig + `
+					resource "infoblox_rpz_rule_cname" "foo"{
+						name = "*.bad.example.com"
+						rp_zone = infoblox_zone_rp.rpz.fqdn
+						action = "SUBSTITUTE"
+						substitute_name = "walled-garden.test.com"
+					}`,
+				Check: resource.ComposeTestCheckFunc(
+					testAccRPZCNameRuleCompare(t, "infoblox_rpz_rule_cname.foo", rpzRuleKindQName, &recordRPZCName{
+						Name:      "*.bad.example.com.rpz.test.com",
+						Canonical: "walled-garden.test.com",
+						RpZone:    "rpz.test.com",
+					}),
+				),
+			},
+
+			// negative test cases
+			{
+				Config: testAccRPZZoneConfig + `
+					resource "infoblox_rpz_rule_cname" "foo"{
+						name = "*.bad.example.com"
+						rp_zone = infoblox_zone_rp.rpz.fqdn
+						action = "NODATA"
+						substitute_name = "walled-garden.test.com"
+					}`,
+				ExpectError: regexp.MustCompile("'substitute_name' may be set only for 'SUBSTITUTE' value of 'action'"),
+			},
+		},
+	})
+}
+
+func TestAccResourceRPZRuleClientIP(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckRPZCNameRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRPZZoneConfig + `
+					resource "infoblox_rpz_rule_client_ip" "foo"{
+						client_ip = "10.0.0.0/24"
+						rp_zone = infoblox_zone_rp.rpz.fqdn
+						action = "NODATA"
+					}`,
+				Check: resource.ComposeTestCheckFunc(
+					testAccRPZCNameRuleCompare(t, "infoblox_rpz_rule_client_ip.foo", rpzRuleKindClientIP, &recordRPZCName{
+						Name:      "10.0.0.0/24.rpz.test.com",
+						Canonical: "*",
+						RpZone:    "rpz.test.com",
+					}),
+				),
+			},
+			{
+				Config: testAccRPZZoneConfig + `
+					resource "infoblox_rpz_rule_client_ip" "foo"{
+						client_ip = "10.0.0.0/24"
+						rp_zone = infoblox_zone_rp.rpz.fqdn
+						action = "PASSTHRU"
+					}`,
+				Check: resource.ComposeTestCheckFunc(
+					testAccRPZCNameRuleCompare(t, "infoblox_rpz_rule_client_ip.foo", rpzRuleKindClientIP, &recordRPZCName{
+						Name:      "10.0.0.0/24.rpz.test.com",
+						Canonical: "rpz-passthru",
+						RpZone:    "rpz.test.com",
+					}),
+				),
+			},
+
+			// negative test cases
+			{
+				Config: testAccRPZZoneConfig + `
+					resource "infoblox_rpz_rule_client_ip" "foo"{
+						client_ip = "10.0.0.0/24"
+						rp_zone = infoblox_zone_rp.rpz.fqdn
+						action = "SUBSTITUTE"
+					}`,
+				ExpectError: regexp.MustCompile("'action' must be one of 'NXDOMAIN', 'NODATA' or 'PASSTHRU'"),
+			},
+		},
+	})
+}
+
+func TestAccResourceRPZRuleNSDName(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckRPZCNameRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRPZZoneConfig + `
+					resource "infoblox_rpz_rule_nsdname" "foo"{
+						nameserver = "ns1.bad.example.com"
+						rp_zone = infoblox_zone_rp.rpz.fqdn
+					}
+					resource "infoblox_rpz_rule_nsip" "foo"{
+						nameserver_ip = "10.10.0.53"
+						rp_zone = infoblox_zone_rp.rpz.fqdn
+					}`,
+				Check: resource.ComposeTestCheckFunc(
+					testAccRPZCNameRuleCompare(t, "infoblox_rpz_rule_nsdname.foo", rpzRuleKindNSDName, &recordRPZCName{
+						Name:      "ns1.bad.example.com.rpz-nsdname.rpz.test.com",
+						Canonical: "",
+						RpZone:    "rpz.test.com",
+					}),
+					testAccRPZCNameRuleCompare(t, "infoblox_rpz_rule_nsip.foo", rpzRuleKindNSIP, &recordRPZCName{
+						Name:      "10.10.0.53.rpz-nsip.rpz.test.com",
+						Canonical: "",
+						RpZone:    "rpz.test.com",
+					}),
+				),
+			},
+		},
+	})
+}
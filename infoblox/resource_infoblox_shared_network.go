@@ -0,0 +1,402 @@
+package infoblox
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+)
+
+// sharedNetworkKind describes the differences between IPv4 and IPv6 shared networks.
+type sharedNetworkKind struct {
+	isIPv6      bool
+	description string
+}
+
+var (
+	sharedNetworkKindIPv4 = sharedNetworkKind{
+		isIPv6:      false,
+		description: "IPv4 shared network",
+	}
+	sharedNetworkKindIPv6 = sharedNetworkKind{
+		isIPv6:      true,
+		description: "IPv6 shared network",
+	}
+)
+
+func (kind sharedNetworkKind) objectType() string {
+	if kind.isIPv6 {
+		return "ipv6sharednetwork"
+	}
+	return "sharednetwork"
+}
+
+func (kind sharedNetworkKind) networkObjectType() string {
+	if kind.isIPv6 {
+		return "ipv6network"
+	}
+	return "network"
+}
+
+func (kind sharedNetworkKind) ipVersion() string {
+	if kind.isIPv6 {
+		return "IPv6"
+	}
+	return "IPv4"
+}
+
+func resourceSharedNetwork(kind sharedNetworkKind) *schema.Resource {
+	return &schema.Resource{
+		Importer: &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the shared network.",
+			},
+			"network_view": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     defaultNetView,
+				Description: "Network view which the shared network belongs to.",
+			},
+			"networks": {
+				Type:     schema.TypeList,
+				Required: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Description: fmt.Sprintf(
+					"The %s networks of the shared network, either in CIDR format or as references to '%s' objects.",
+					kind.ipVersion(), kind.networkObjectType()),
+			},
+			"options": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        dhcpOptionSchemaElem(),
+				Description: "DHCP options of the shared network.",
+			},
+			"comment": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "A description of the shared network.",
+			},
+			"ext_attrs": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "Extensible attributes of the shared network to be added/updated, as a map in JSON format.",
+			},
+		},
+	}
+}
+
+// Returns the canonical form of the network's CIDR, or an empty string
+// if the value is not a valid network of the given IP version.
+func normalizeNetworkCIDR(cidr string, isIPv6 bool) string {
+	ip, ipNet, err := net.ParseCIDR(cidr)
+	if err != nil || !isIPAddrOfVersion(ip, isIPv6) || !ip.Equal(ipNet.IP) {
+		return ""
+	}
+
+	return ipNet.String()
+}
+
+// Converts the items of 'networks' field to the references of the network objects.
+func getSharedNetworkRefs(
+	connector ibclient.IBConnector, kind sharedNetworkKind, netView string, items []interface{}) ([]networkRef, error) {
+
+	if len(items) == 0 {
+		return nil, fmt.Errorf("at least one network must be defined in 'networks'")
+	}
+
+	res := make([]networkRef, 0, len(items))
+	for _, item := range items {
+		value, _ := item.(string)
+		if strings.HasPrefix(value, kind.networkObjectType()+"/") {
+			res = append(res, networkRef{Ref: value})
+			continue
+		}
+
+		cidr := normalizeNetworkCIDR(value, kind.isIPv6)
+		if cidr == "" {
+			return nil, fmt.Errorf(
+				"'%s' is neither a valid %s network in CIDR format nor a reference to '%s' object",
+				value, kind.ipVersion(), kind.networkObjectType())
+		}
+
+		var networks []genericRecord
+		sf := map[string]string{
+			"network_view": netView,
+			"network":      cidr,
+		}
+		err := connector.GetObject(
+			newGenericRecord(kind.networkObjectType(), []string{"network"}, nil), "",
+			ibclient.NewQueryParams(false, sf), &networks)
+		if err != nil && !isNotFoundError(err) {
+			return nil, fmt.Errorf("failed getting the network '%s': %w", cidr, err)
+		}
+		if len(networks) == 0 {
+			return nil, fmt.Errorf("the network '%s' does not exist in network view '%s'", cidr, netView)
+		}
+		res = append(res, networkRef{Ref: networks[0].Ref})
+	}
+
+	return res, nil
+}
+
+// Brings the networks of the state in accordance with the actual networks of a shared network.
+// The networks keep the form they are defined in (in CIDR format or as references);
+// the networks which are added on NIOS side are appended in CIDR format.
+// 'cidrs' maps the references of the actual networks to their CIDRs.
+func mergeSharedNetworkItems(
+	stateItems []interface{}, actual []networkRef, cidrs map[string]string, isIPv6 bool) []interface{} {
+
+	matched := make(map[int]bool)
+	res := make([]interface{}, 0, len(actual))
+	for _, item := range stateItems {
+		value, _ := item.(string)
+		cidr := normalizeNetworkCIDR(value, isIPv6)
+		for j, act := range actual {
+			if matched[j] {
+				continue
+			}
+			if value == act.Ref || (cidr != "" && cidr == cidrs[act.Ref]) {
+				matched[j] = true
+				res = append(res, value)
+				break
+			}
+		}
+	}
+	for j, act := range actual {
+		if matched[j] {
+			continue
+		}
+		if cidr, found := cidrs[act.Ref]; found {
+			res = append(res, cidr)
+		} else {
+			res = append(res, act.Ref)
+		}
+	}
+
+	return res
+}
+
+// Builds a shared network object out of the resource's fields, except 'network_view'
+// which cannot be changed once the shared network is created.
+func buildSharedNetwork(
+	d *schema.ResourceData, connector ibclient.IBConnector, kind sharedNetworkKind) (*sharedNetwork, error) {
+
+	extAttrJSON := d.Get("ext_attrs").(string)
+	extAttrs := make(map[string]interface{})
+	if extAttrJSON != "" {
+		if err := json.Unmarshal([]byte(extAttrJSON), &extAttrs); err != nil {
+			return nil, fmt.Errorf("cannot process 'ext_attrs' field: %w", err)
+		}
+	}
+
+	options, err := convertInterfaceToDHCPOptions("options", d.Get("options").([]interface{}))
+	if err != nil {
+		return nil, err
+	}
+
+	networks, err := getSharedNetworkRefs(
+		connector, kind, d.Get("network_view").(string), d.Get("networks").([]interface{}))
+	if err != nil {
+		return nil, err
+	}
+
+	sn := sharedNetwork{
+		Name:       d.Get("name").(string),
+		Networks:   networks,
+		Options:    options,
+		UseOptions: len(options) > 0,
+		Comment:    d.Get("comment").(string),
+		Ea:         extAttrs,
+	}
+
+	return newSharedNetwork(sn, kind.isIPv6), nil
+}
+
+func resourceSharedNetworkCreate(d *schema.ResourceData, m interface{}, kind sharedNetworkKind) error {
+	connector := m.(ibclient.IBConnector)
+
+	sn, err := buildSharedNetwork(d, connector, kind)
+	if err != nil {
+		return err
+	}
+	sn.NetworkView = d.Get("network_view").(string)
+
+	ref, err := connector.CreateObject(sn)
+	if err != nil {
+		return fmt.Errorf("error creating %s: %w", kind.description, err)
+	}
+	d.SetId(ref)
+
+	return resourceSharedNetworkRead(d, m, kind)
+}
+
+func resourceSharedNetworkRead(d *schema.ResourceData, m interface{}, kind sharedNetworkKind) error {
+	if !strings.HasPrefix(d.Id(), kind.objectType()+"/") {
+		return fmt.Errorf("reference '%s' for '%s' object has an invalid format", d.Id(), kind.objectType())
+	}
+
+	connector := m.(ibclient.IBConnector)
+
+	obj := newSharedNetwork(sharedNetwork{}, kind.isIPv6)
+	if err := connector.GetObject(obj, d.Id(), ibclient.NewQueryParams(false, nil), obj); err != nil {
+		return fmt.Errorf("failed getting %s: %w", kind.description, err)
+	}
+
+	if obj.Ea != nil && len(obj.Ea) > 0 {
+		// TODO: temporary scaffold, need to rework marshalling/unmarshalling of EAs
+		//       (avoiding additional layer of keys ("value" key)
+		eaMap := (map[string]interface{})(obj.Ea)
+		ea, err := json.Marshal(eaMap)
+		if err != nil {
+			return err
+		}
+		if err = d.Set("ext_attrs", string(ea)); err != nil {
+			return err
+		}
+	}
+
+	cidrs := make(map[string]string, len(obj.Networks))
+	for _, network := range obj.Networks {
+		rec := newGenericRecord(kind.networkObjectType(), []string{"network"}, nil)
+		if err := connector.GetObject(rec, network.Ref, ibclient.NewQueryParams(false, nil), rec); err != nil {
+			return fmt.Errorf("failed getting the network '%s' of %s: %w", network.Ref, kind.description, err)
+		}
+		if cidr, _ := rec.Fields["network"].(string); cidr != "" {
+			cidrs[network.Ref] = cidr
+		}
+	}
+	networks := mergeSharedNetworkItems(d.Get("networks").([]interface{}), obj.Networks, cidrs, kind.isIPv6)
+	if err := d.Set("networks", networks); err != nil {
+		return err
+	}
+
+	stateOptions, err := convertInterfaceToDHCPOptions("options", d.Get("options").([]interface{}))
+	if err != nil {
+		return err
+	}
+	if err = d.Set("options", convertDHCPOptionsToInterface(mergeDHCPOptions(stateOptions, obj.Options))); err != nil {
+		return err
+	}
+
+	if err = d.Set("name", obj.Name); err != nil {
+		return err
+	}
+	if err = d.Set("network_view", obj.NetworkView); err != nil {
+		return err
+	}
+	if err = d.Set("comment", obj.Comment); err != nil {
+		return err
+	}
+
+	d.SetId(obj.Ref)
+
+	return nil
+}
+
+func resourceSharedNetworkUpdate(d *schema.ResourceData, m interface{}, kind sharedNetworkKind) error {
+	var updateSuccessful bool
+	defer func() {
+		// Reverting the state back, in case of a failure,
+		// otherwise Terraform will keep the values, which leaded to the failure,
+		// in the state file.
+		if !updateSuccessful {
+			for _, field := range []string{"name", "network_view", "networks", "options", "comment", "ext_attrs"} {
+				prevValue, _ := d.GetChange(field)
+				_ = d.Set(field, prevValue)
+			}
+		}
+	}()
+
+	if d.HasChange("network_view") {
+		return fmt.Errorf("changing the value of 'network_view' field is not allowed")
+	}
+
+	connector := m.(ibclient.IBConnector)
+
+	sn, err := buildSharedNetwork(d, connector, kind)
+	if err != nil {
+		return err
+	}
+
+	ref, err := connector.UpdateObject(sn, d.Id())
+	if err != nil {
+		return fmt.Errorf("error updating %s: %w", kind.description, err)
+	}
+	updateSuccessful = true
+	d.SetId(ref)
+
+	return resourceSharedNetworkRead(d, m, kind)
+}
+
+func resourceSharedNetworkDelete(d *schema.ResourceData, m interface{}, kind sharedNetworkKind) error {
+	connector := m.(ibclient.IBConnector)
+
+	if _, err := connector.DeleteObject(d.Id()); err != nil {
+		return fmt.Errorf("deletion of %s failed: %w", kind.description, err)
+	}
+	d.SetId("")
+
+	return nil
+}
+
+func resourceIPv4SharedNetworkCreate(d *schema.ResourceData, m interface{}) error {
+	return resourceSharedNetworkCreate(d, m, sharedNetworkKindIPv4)
+}
+
+func resourceIPv4SharedNetworkRead(d *schema.ResourceData, m interface{}) error {
+	return resourceSharedNetworkRead(d, m, sharedNetworkKindIPv4)
+}
+
+func resourceIPv4SharedNetworkUpdate(d *schema.ResourceData, m interface{}) error {
+	return resourceSharedNetworkUpdate(d, m, sharedNetworkKindIPv4)
+}
+
+func resourceIPv4SharedNetworkDelete(d *schema.ResourceData, m interface{}) error {
+	return resourceSharedNetworkDelete(d, m, sharedNetworkKindIPv4)
+}
+
+func resourceIPv4SharedNetwork() *schema.Resource {
+	sn := resourceSharedNetwork(sharedNetworkKindIPv4)
+	sn.Create = resourceIPv4SharedNetworkCreate
+	sn.Read = resourceIPv4SharedNetworkRead
+	sn.Update = resourceIPv4SharedNetworkUpdate
+	sn.Delete = resourceIPv4SharedNetworkDelete
+
+	return sn
+}
+
+func resourceIPv6SharedNetworkCreate(d *schema.ResourceData, m interface{}) error {
+	return resourceSharedNetworkCreate(d, m, sharedNetworkKindIPv6)
+}
+
+func resourceIPv6SharedNetworkRead(d *schema.ResourceData, m interface{}) error {
+	return resourceSharedNetworkRead(d, m, sharedNetworkKindIPv6)
+}
+
+func resourceIPv6SharedNetworkUpdate(d *schema.ResourceData, m interface{}) error {
+	return resourceSharedNetworkUpdate(d, m, sharedNetworkKindIPv6)
+}
+
+func resourceIPv6SharedNetworkDelete(d *schema.ResourceData, m interface{}) error {
+	return resourceSharedNetworkDelete(d, m, sharedNetworkKindIPv6)
+}
+
+func resourceIPv6SharedNetwork() *schema.Resource {
+	sn := resourceSharedNetwork(sharedNetworkKindIPv6)
+	sn.Create = resourceIPv6SharedNetworkCreate
+	sn.Read = resourceIPv6SharedNetworkRead
+	sn.Update = resourceIPv6SharedNetworkUpdate
+	sn.Delete = resourceIPv6SharedNetworkDelete
+
+	return sn
+}
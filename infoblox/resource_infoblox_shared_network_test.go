@@ -0,0 +1,270 @@
+package infoblox
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+)
+
+func testAccCheckSharedNetworkDestroy(s *terraform.State) error {
+	meta := testAccProvider.Meta()
+
+	for _, rs := range s.RootModule().Resources {
+		var kind sharedNetworkKind
+		switch rs.Type {
+		case "infoblox_ipv4_shared_network":
+			kind = sharedNetworkKindIPv4
+		case "infoblox_ipv6_shared_network":
+			kind = sharedNetworkKindIPv6
+		default:
+			continue
+		}
+		connector := meta.(ibclient.IBConnector)
+		obj := newSharedNetwork(sharedNetwork{}, kind.isIPv6)
+		err := connector.GetObject(obj, rs.Primary.ID, ibclient.NewQueryParams(false, nil), obj)
+		if err == nil {
+			return fmt.Errorf("%s still exists: %s", kind.description, rs.Primary.ID)
+		}
+		if !isNotFoundError(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+// The networks of the shared network are compared by their CIDRs.
+func testAccSharedNetworkCompare(
+	t *testing.T, resPath string, kind sharedNetworkKind, expected *sharedNetwork, expectedCIDRs []string) resource.TestCheckFunc {
+
+	return func(s *terraform.State) error {
+		res, found := s.RootModule().Resources[resPath]
+		if !found {
+			return fmt.Errorf("not found: %s", resPath)
+		}
+
+		connector := testAccProvider.Meta().(ibclient.IBConnector)
+		obj := newSharedNetwork(sharedNetwork{}, kind.isIPv6)
+		if err := connector.GetObject(obj, res.Primary.ID, ibclient.NewQueryParams(false, nil), obj); err != nil {
+			return err
+		}
+
+		if obj.Name != expected.Name {
+			return fmt.Errorf("'name' does not match: got '%s', expected '%s'", obj.Name, expected.Name)
+		}
+		if obj.NetworkView != expected.NetworkView {
+			return fmt.Errorf(
+				"'network_view' does not match: got '%s', expected '%s'", obj.NetworkView, expected.NetworkView)
+		}
+		if obj.Comment != expected.Comment {
+			return fmt.Errorf("'comment' does not match: got '%s', expected '%s'", obj.Comment, expected.Comment)
+		}
+
+		cidrs := make([]string, 0, len(obj.Networks))
+		for _, network := range obj.Networks {
+			rec := newGenericRecord(kind.networkObjectType(), []string{"network"}, nil)
+			if err := connector.GetObject(rec, network.Ref, ibclient.NewQueryParams(false, nil), rec); err != nil {
+				return err
+			}
+			cidr, _ := rec.Fields["network"].(string)
+			cidrs = append(cidrs, cidr)
+		}
+		if !reflect.DeepEqual(cidrs, expectedCIDRs) {
+			return fmt.Errorf("'networks' do not match: got '%v', expected '%v'", cidrs, expectedCIDRs)
+		}
+
+		options := mergeDHCPOptions(expected.Options, obj.Options)
+		if !reflect.DeepEqual(options, expected.Options) {
+			return fmt.Errorf("'options' do not match: got '%+v', expected '%+v'", options, expected.Options)
+		}
+
+		return validateEAs(obj.Ea, expected.Ea)
+	}
+}
+
+func TestAccResourceIPv4SharedNetwork(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSharedNetworkDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "infoblox_ipv4_network" "net1" {
+						cidr = "10.6.0.0/24"
+					}
+
+					resource "infoblox_ipv4_network" "net2" {
+						cidr = "10.6.1.0/24"
+					}
+
+					resource "infoblox_ipv4_shared_network" "foo" {
+						name = "vlan6"
+						networks = [infoblox_ipv4_network.net1.id, "10.6.1.0/24"]
+
+						depends_on = [infoblox_ipv4_network.net2]
+					}`,
+				Check: resource.ComposeTestCheckFunc(
+					testAccSharedNetworkCompare(t, "infoblox_ipv4_shared_network.foo", sharedNetworkKindIPv4,
+						&sharedNetwork{
+							Name:        "vlan6",
+							NetworkView: "default",
+							Options:     []dhcpOption{},
+						},
+						[]string{"10.6.0.0/24", "10.6.1.0/24"}),
+					resource.TestCheckResourceAttr("infoblox_ipv4_shared_network.foo", "networks.1", "10.6.1.0/24"),
+				),
+			},
+			{
+				Config: `
+					resource "infoblox_ipv4_network" "net1" {
+						cidr = "10.6.0.0/24"
+					}
+
+					resource "infoblox_ipv4_network" "net2" {
+						cidr = "10.6.1.0/24"
+					}
+
+					resource "infoblox_ipv4_network" "net3" {
+						cidr = "10.6.2.0/24"
+					}
+
+					resource "infoblox_ipv4_shared_network" "foo" {
+						name = "vlan6-office"
+						networks = ["10.6.0.0/24", "10.6.1.0/24", infoblox_ipv4_network.net3.id]
+						options {
+							name = "domain-name"
+							value = "office.example.com"
+						}
+						comment = "the networks of VLAN 6"
+						ext_attrs = jsonencode({
+							"Location" = "Test location"
+						})
+
+						depends_on = [infoblox_ipv4_network.net1, infoblox_ipv4_network.net2]
+					}`,
+				Check: testAccSharedNetworkCompare(t, "infoblox_ipv4_shared_network.foo", sharedNetworkKindIPv4,
+					&sharedNetwork{
+						Name:        "vlan6-office",
+						NetworkView: "default",
+						Options: []dhcpOption{
+							{Name: "domain-name", Value: "office.example.com", UseOption: true},
+						},
+						Comment: "the networks of VLAN 6",
+						Ea: ibclient.EA{
+							"Location": "Test location",
+						},
+					},
+					[]string{"10.6.0.0/24", "10.6.1.0/24", "10.6.2.0/24"}),
+			},
+			{
+				ResourceName:            "infoblox_ipv4_shared_network.foo",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"networks", "options"},
+			},
+
+			// negative test cases
+			{
+				Config: `
+					resource "infoblox_ipv4_shared_network" "foo" {
+						name = "vlan6"
+						networks = ["10.250.0.0/24"]
+					}`,
+				ExpectError: regexp.MustCompile("the network '10.250.0.0/24' does not exist in network view 'default'"),
+			},
+			{
+				Config: `
+					resource "infoblox_ipv4_shared_network" "foo" {
+						name = "vlan6"
+						networks = ["2001:db8:6::/64"]
+					}`,
+				ExpectError: regexp.MustCompile(
+					"'2001:db8:6::/64' is neither a valid IPv4 network in CIDR format nor a reference to 'network' object"),
+			},
+		},
+	})
+}
+
+func TestAccResourceIPv6SharedNetwork(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSharedNetworkDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "infoblox_ipv6_network" "net1" {
+						cidr = "2001:db8:6::/64"
+					}
+
+					resource "infoblox_ipv6_network" "net2" {
+						cidr = "2001:db8:7::/64"
+					}
+
+					resource "infoblox_ipv6_shared_network" "foo" {
+						name = "vlan6"
+						networks = [infoblox_ipv6_network.net1.id, infoblox_ipv6_network.net2.id]
+						comment = "the IPv6 networks of VLAN 6"
+					}`,
+				Check: testAccSharedNetworkCompare(t, "infoblox_ipv6_shared_network.foo", sharedNetworkKindIPv6,
+					&sharedNetwork{
+						Name:        "vlan6",
+						NetworkView: "default",
+						Options:     []dhcpOption{},
+						Comment:     "the IPv6 networks of VLAN 6",
+					},
+					[]string{"2001:db8:6::/64", "2001:db8:7::/64"}),
+			},
+		},
+	})
+}
+
+func TestMergeSharedNetworkItems(t *testing.T) {
+	actual := []networkRef{
+		{Ref: "network/ZG5zLm5ldHdvcmskMTAuMC4wLjAvMjQvMA:10.0.0.0/24/default"},
+		{Ref: "network/ZG5zLm5ldHdvcmskMTAuMC4xLjAvMjQvMA:10.0.1.0/24/default"},
+		{Ref: "network/ZG5zLm5ldHdvcmskMTAuMC4yLjAvMjQvMA:10.0.2.0/24/default"},
+	}
+	cidrs := map[string]string{
+		actual[0].Ref: "10.0.0.0/24",
+		actual[1].Ref: "10.0.1.0/24",
+		actual[2].Ref: "10.0.2.0/24",
+	}
+	stateItems := []interface{}{
+		"10.0.1.0/24",
+		actual[0].Ref,
+		"10.0.5.0/24", // removed on NIOS side
+	}
+
+	expected := []interface{}{"10.0.1.0/24", actual[0].Ref, "10.0.2.0/24"}
+	res := mergeSharedNetworkItems(stateItems, actual, cidrs, false)
+	if !reflect.DeepEqual(res, expected) {
+		t.Errorf("got '%v', expected '%v'", res, expected)
+	}
+}
+
+func TestNormalizeNetworkCIDR(t *testing.T) {
+	testCases := []struct {
+		cidr     string
+		isIPv6   bool
+		expected string
+	}{
+		{"10.0.0.0/24", false, "10.0.0.0/24"},
+		{"10.0.0.1/24", false, ""},
+		{"10.0.0.0/24", true, ""},
+		{"2001:0db8:0000::/64", true, "2001:db8::/64"},
+		{"2001:db8::/64", false, ""},
+		{"network/ZG5zLm5ldHdvcmskMTAuMC4wLjAvMjQvMA:10.0.0.0/24/default", false, ""},
+	}
+
+	for _, tc := range testCases {
+		if res := normalizeNetworkCIDR(tc.cidr, tc.isIPv6); res != tc.expected {
+			t.Errorf("'%s' (IPv6: %t): got '%s', expected '%s'", tc.cidr, tc.isIPv6, res, tc.expected)
+		}
+	}
+}
@@ -0,0 +1,483 @@
+package infoblox
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+)
+
+// sharedRecordField describes a type-specific field of a shared record.
+type sharedRecordField struct {
+	// The name of the resource's field.
+	name string
+	// The name of the field of the WAPI object.
+	wapiName string
+	// If true, the field is an integer in the range 0-65535,
+	// otherwise it is a non-empty string.
+	isInt       bool
+	description string
+}
+
+// sharedRecordKind describes a type of shared records.
+type sharedRecordKind struct {
+	objectType  string
+	fields      []sharedRecordField
+	description string
+}
+
+var (
+	sharedRecordKindA = sharedRecordKind{
+		objectType: "sharedrecord:a",
+		fields: []sharedRecordField{
+			{name: "ip_addr", wapiName: "ipv4addr", description: "The IPv4 address of the record."},
+		},
+		description: "shared A-record",
+	}
+	sharedRecordKindAAAA = sharedRecordKind{
+		objectType: "sharedrecord:aaaa",
+		fields: []sharedRecordField{
+			{name: "ipv6_addr", wapiName: "ipv6addr", description: "The IPv6 address of the record."},
+		},
+		description: "shared AAAA-record",
+	}
+	sharedRecordKindCName = sharedRecordKind{
+		objectType: "sharedrecord:cname",
+		fields: []sharedRecordField{
+			{name: "canonical", wapiName: "canonical", description: "The canonical name of the record."},
+		},
+		description: "shared CNAME-record",
+	}
+	sharedRecordKindMX = sharedRecordKind{
+		objectType: "sharedrecord:mx",
+		fields: []sharedRecordField{
+			{name: "mail_exchanger", wapiName: "mail_exchanger", description: "The FQDN of the mail server."},
+			{name: "preference", wapiName: "preference", isInt: true, description: "The preference (0-65535) of the record."},
+		},
+		description: "shared MX-record",
+	}
+	sharedRecordKindSRV = sharedRecordKind{
+		objectType: "sharedrecord:srv",
+		fields: []sharedRecordField{
+			{name: "priority", wapiName: "priority", isInt: true, description: "The priority (0-65535) of the record."},
+			{name: "weight", wapiName: "weight", isInt: true, description: "The weight (0-65535) of the record."},
+			{name: "port", wapiName: "port", isInt: true, description: "The port (0-65535) of the service."},
+			{name: "target", wapiName: "target", description: "The FQDN of the host which provides the service."},
+		},
+		description: "shared SRV-record",
+	}
+	sharedRecordKindTXT = sharedRecordKind{
+		objectType: "sharedrecord:txt",
+		fields: []sharedRecordField{
+			{name: "text", wapiName: "text", description: "The text of the record."},
+		},
+		description: "shared TXT-record",
+	}
+)
+
+func (kind sharedRecordKind) returnFields() []string {
+	res := []string{"name", "shared_record_group", "ttl", "use_ttl", "comment", "extattrs"}
+	for _, field := range kind.fields {
+		res = append(res, field.wapiName)
+	}
+
+	return res
+}
+
+func (rec *sharedRecord) stringField(wapiName string) *string {
+	switch wapiName {
+	case "ipv4addr":
+		return &rec.Ipv4Addr
+	case "ipv6addr":
+		return &rec.Ipv6Addr
+	case "canonical":
+		return &rec.Canonical
+	case "mail_exchanger":
+		return &rec.MailExchanger
+	case "target":
+		return &rec.Target
+	case "text":
+		return &rec.Text
+	}
+	panic(fmt.Sprintf("unknown string field of a shared record: '%s'", wapiName))
+}
+
+func (rec *sharedRecord) intField(wapiName string) **uint32 {
+	switch wapiName {
+	case "preference":
+		return &rec.Preference
+	case "priority":
+		return &rec.Priority
+	case "weight":
+		return &rec.Weight
+	case "port":
+		return &rec.Port
+	}
+	panic(fmt.Sprintf("unknown integer field of a shared record: '%s'", wapiName))
+}
+
+func resourceSharedRecord(kind sharedRecordKind) *schema.Resource {
+	res := &schema.Resource{
+		Delete:   resourceSharedRecordDelete,
+		Importer: &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			"shared_record_group": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the shared record group which the record belongs to.",
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "",
+				Description: "The name of the record, relative to the zones which the group is associated with;" +
+					" empty value means the apex of the zones.",
+			},
+			"ttl": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     ttlUndef,
+				Description: "TTL value for the record.",
+			},
+			"comment": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "Description of the record.",
+			},
+			"ext_attrs": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "Extensible attributes of the record to be added/updated, as a map in JSON format.",
+			},
+		},
+	}
+	for _, field := range kind.fields {
+		fieldType := schema.TypeString
+		if field.isInt {
+			fieldType = schema.TypeInt
+		}
+		res.Schema[field.name] = &schema.Schema{
+			Type:        fieldType,
+			Required:    true,
+			Description: field.description,
+		}
+	}
+
+	return res
+}
+
+// Builds a shared record object out of the resource's fields,
+// except 'shared_record_group' which cannot be changed once the record is created.
+func buildSharedRecord(d *schema.ResourceData, kind sharedRecordKind) (*sharedRecord, error) {
+	rec := newSharedRecord(kind.objectType, kind.returnFields(), sharedRecord{
+		Name: d.Get("name").(string),
+	})
+
+	for _, field := range kind.fields {
+		if field.isInt {
+			tempInt := d.Get(field.name).(int)
+			if err := ibclient.CheckIntRange(field.name, tempInt, 0, 65535); err != nil {
+				return nil, err
+			}
+			val := uint32(tempInt)
+			*rec.intField(field.wapiName) = &val
+			continue
+		}
+
+		val := d.Get(field.name).(string)
+		if val == "" {
+			return nil, fmt.Errorf("'%s' must not be empty", field.name)
+		}
+		*rec.stringField(field.wapiName) = val
+	}
+
+	tempTTL := d.Get("ttl").(int)
+	if tempTTL >= 0 {
+		rec.UseTtl = true
+		rec.Ttl = uint32(tempTTL)
+	} else if tempTTL != ttlUndef {
+		return nil, fmt.Errorf("TTL value must be 0 or higher")
+	}
+
+	rec.Comment = d.Get("comment").(string)
+
+	extAttrJSON := d.Get("ext_attrs").(string)
+	extAttrs := make(map[string]interface{})
+	if extAttrJSON != "" {
+		if err := json.Unmarshal([]byte(extAttrJSON), &extAttrs); err != nil {
+			return nil, fmt.Errorf("cannot process 'ext_attrs' field: %w", err)
+		}
+	}
+	rec.Ea = extAttrs
+
+	return rec, nil
+}
+
+func resourceSharedRecordCreate(d *schema.ResourceData, m interface{}, kind sharedRecordKind) error {
+	group := d.Get("shared_record_group").(string)
+	if group == "" {
+		return fmt.Errorf("'shared_record_group' must not be empty")
+	}
+
+	rec, err := buildSharedRecord(d, kind)
+	if err != nil {
+		return err
+	}
+	rec.SharedRecordGroup = group
+
+	connector := m.(ibclient.IBConnector)
+	ref, err := connector.CreateObject(rec)
+	if err != nil {
+		return fmt.Errorf("creation of %s in group '%s' failed: %w", kind.description, group, err)
+	}
+	d.SetId(ref)
+
+	return nil
+}
+
+func resourceSharedRecordRead(d *schema.ResourceData, m interface{}, kind sharedRecordKind) error {
+	connector := m.(ibclient.IBConnector)
+
+	obj := newSharedRecord(kind.objectType, kind.returnFields(), sharedRecord{})
+	if err := connector.GetObject(obj, d.Id(), ibclient.NewQueryParams(false, nil), obj); err != nil {
+		return fmt.Errorf("failed getting %s: %w", kind.description, err)
+	}
+
+	ttl := int(obj.Ttl)
+	if !obj.UseTtl {
+		ttl = ttlUndef
+	}
+	if err := d.Set("ttl", ttl); err != nil {
+		return err
+	}
+
+	if obj.Ea != nil && len(obj.Ea) > 0 {
+		// TODO: temporary scaffold, need to rework marshalling/unmarshalling of EAs
+		//       (avoiding additional layer of keys ("value" key)
+		eaMap := (map[string]interface{})(obj.Ea)
+		ea, err := json.Marshal(eaMap)
+		if err != nil {
+			return err
+		}
+		if err = d.Set("ext_attrs", string(ea)); err != nil {
+			return err
+		}
+	}
+
+	if err := d.Set("comment", obj.Comment); err != nil {
+		return err
+	}
+	if err := d.Set("shared_record_group", obj.SharedRecordGroup); err != nil {
+		return err
+	}
+	if err := d.Set("name", obj.Name); err != nil {
+		return err
+	}
+	for _, field := range kind.fields {
+		var val interface{}
+		if field.isInt {
+			intVal := 0
+			if ptr := *obj.intField(field.wapiName); ptr != nil {
+				intVal = int(*ptr)
+			}
+			val = intVal
+		} else {
+			val = *obj.stringField(field.wapiName)
+		}
+		if err := d.Set(field.name, val); err != nil {
+			return err
+		}
+	}
+
+	d.SetId(obj.Ref)
+
+	return nil
+}
+
+func resourceSharedRecordUpdate(d *schema.ResourceData, m interface{}, kind sharedRecordKind) error {
+	var updateSuccessful bool
+	defer func() {
+		// Reverting the state back, in case of a failure,
+		// otherwise Terraform will keep the values, which leaded to the failure,
+		// in the state file.
+		if !updateSuccessful {
+			prevGroup, _ := d.GetChange("shared_record_group")
+			prevName, _ := d.GetChange("name")
+			prevTTL, _ := d.GetChange("ttl")
+			prevComment, _ := d.GetChange("comment")
+			prevEa, _ := d.GetChange("ext_attrs")
+
+			_ = d.Set("shared_record_group", prevGroup.(string))
+			_ = d.Set("name", prevName.(string))
+			_ = d.Set("ttl", prevTTL.(int))
+			_ = d.Set("comment", prevComment.(string))
+			_ = d.Set("ext_attrs", prevEa.(string))
+
+			for _, field := range kind.fields {
+				prevVal, _ := d.GetChange(field.name)
+				_ = d.Set(field.name, prevVal)
+			}
+		}
+	}()
+
+	if d.HasChange("shared_record_group") {
+		return fmt.Errorf("changing the value of 'shared_record_group' field is not allowed")
+	}
+
+	rec, err := buildSharedRecord(d, kind)
+	if err != nil {
+		return err
+	}
+
+	connector := m.(ibclient.IBConnector)
+	ref, err := connector.UpdateObject(rec, d.Id())
+	if err != nil {
+		return fmt.Errorf("error updating %s: %w", kind.description, err)
+	}
+	updateSuccessful = true
+	d.SetId(ref)
+
+	return nil
+}
+
+func resourceSharedRecordDelete(d *schema.ResourceData, m interface{}) error {
+	connector := m.(ibclient.IBConnector)
+
+	if _, err := connector.DeleteObject(d.Id()); err != nil {
+		return fmt.Errorf("deletion of the shared record failed: %w", err)
+	}
+	d.SetId("")
+
+	return nil
+}
+
+func resourceSharedRecordACreate(d *schema.ResourceData, m interface{}) error {
+	return resourceSharedRecordCreate(d, m, sharedRecordKindA)
+}
+
+func resourceSharedRecordARead(d *schema.ResourceData, m interface{}) error {
+	return resourceSharedRecordRead(d, m, sharedRecordKindA)
+}
+
+func resourceSharedRecordAUpdate(d *schema.ResourceData, m interface{}) error {
+	return resourceSharedRecordUpdate(d, m, sharedRecordKindA)
+}
+
+func resourceSharedRecordA() *schema.Resource {
+	r := resourceSharedRecord(sharedRecordKindA)
+	r.Create = resourceSharedRecordACreate
+	r.Read = resourceSharedRecordARead
+	r.Update = resourceSharedRecordAUpdate
+
+	return r
+}
+
+func resourceSharedRecordAAAACreate(d *schema.ResourceData, m interface{}) error {
+	return resourceSharedRecordCreate(d, m, sharedRecordKindAAAA)
+}
+
+func resourceSharedRecordAAAARead(d *schema.ResourceData, m interface{}) error {
+	return resourceSharedRecordRead(d, m, sharedRecordKindAAAA)
+}
+
+func resourceSharedRecordAAAAUpdate(d *schema.ResourceData, m interface{}) error {
+	return resourceSharedRecordUpdate(d, m, sharedRecordKindAAAA)
+}
+
+func resourceSharedRecordAAAA() *schema.Resource {
+	r := resourceSharedRecord(sharedRecordKindAAAA)
+	r.Create = resourceSharedRecordAAAACreate
+	r.Read = resourceSharedRecordAAAARead
+	r.Update = resourceSharedRecordAAAAUpdate
+
+	return r
+}
+
+func resourceSharedRecordCNameCreate(d *schema.ResourceData, m interface{}) error {
+	return resourceSharedRecordCreate(d, m, sharedRecordKindCName)
+}
+
+func resourceSharedRecordCNameRead(d *schema.ResourceData, m interface{}) error {
+	return resourceSharedRecordRead(d, m, sharedRecordKindCName)
+}
+
+func resourceSharedRecordCNameUpdate(d *schema.ResourceData, m interface{}) error {
+	return resourceSharedRecordUpdate(d, m, sharedRecordKindCName)
+}
+
+func resourceSharedRecordCName() *schema.Resource {
+	r := resourceSharedRecord(sharedRecordKindCName)
+	r.Create = resourceSharedRecordCNameCreate
+	r.Read = resourceSharedRecordCNameRead
+	r.Update = resourceSharedRecordCNameUpdate
+
+	return r
+}
+
+func resourceSharedRecordMXCreate(d *schema.ResourceData, m interface{}) error {
+	return resourceSharedRecordCreate(d, m, sharedRecordKindMX)
+}
+
+func resourceSharedRecordMXRead(d *schema.ResourceData, m interface{}) error {
+	return resourceSharedRecordRead(d, m, sharedRecordKindMX)
+}
+
+func resourceSharedRecordMXUpdate(d *schema.ResourceData, m interface{}) error {
+	return resourceSharedRecordUpdate(d, m, sharedRecordKindMX)
+}
+
+func resourceSharedRecordMX() *schema.Resource {
+	r := resourceSharedRecord(sharedRecordKindMX)
+	r.Create = resourceSharedRecordMXCreate
+	r.Read = resourceSharedRecordMXRead
+	r.Update = resourceSharedRecordMXUpdate
+
+	return r
+}
+
+func resourceSharedRecordSRVCreate(d *schema.ResourceData, m interface{}) error {
+	return resourceSharedRecordCreate(d, m, sharedRecordKindSRV)
+}
+
+func resourceSharedRecordSRVRead(d *schema.ResourceData, m interface{}) error {
+	return resourceSharedRecordRead(d, m, sharedRecordKindSRV)
+}
+
+func resourceSharedRecordSRVUpdate(d *schema.ResourceData, m interface{}) error {
+	return resourceSharedRecordUpdate(d, m, sharedRecordKindSRV)
+}
+
+func resourceSharedRecordSRV() *schema.Resource {
+	r := resourceSharedRecord(sharedRecordKindSRV)
+	r.Create = resourceSharedRecordSRVCreate
+	r.Read = resourceSharedRecordSRVRead
+	r.Update = resourceSharedRecordSRVUpdate
+
+	return r
+}
+
+func resourceSharedRecordTXTCreate(d *schema.ResourceData, m interface{}) error {
+	return resourceSharedRecordCreate(d, m, sharedRecordKindTXT)
+}
+
+func resourceSharedRecordTXTRead(d *schema.ResourceData, m interface{}) error {
+	return resourceSharedRecordRead(d, m, sharedRecordKindTXT)
+}
+
+func resourceSharedRecordTXTUpdate(d *schema.ResourceData, m interface{}) error {
+	return resourceSharedRecordUpdate(d, m, sharedRecordKindTXT)
+}
+
+func resourceSharedRecordTXT() *schema.Resource {
+	r := resourceSharedRecord(sharedRecordKindTXT)
+	r.Create = resourceSharedRecordTXTCreate
+	r.Read = resourceSharedRecordTXTRead
+	r.Update = resourceSharedRecordTXTUpdate
+
+	return r
+}
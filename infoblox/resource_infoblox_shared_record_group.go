@@ -0,0 +1,221 @@
+package infoblox
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+)
+
+func zoneAssociationSchemaElem() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"fqdn": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the zone.",
+			},
+			"view": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     defaultDNSView,
+				Description: "DNS view which the zone does exist within.",
+			},
+		},
+	}
+}
+
+func convertZoneAssociationsToInterface(zones []zoneAssociation) []map[string]interface{} {
+	res := make([]map[string]interface{}, 0, len(zones))
+	for _, zone := range zones {
+		res = append(res, map[string]interface{}{
+			"fqdn": zone.Fqdn,
+			"view": zone.View,
+		})
+	}
+
+	return res
+}
+
+func convertInterfaceToZoneAssociations(zones []interface{}) []zoneAssociation {
+	res := make([]zoneAssociation, 0, len(zones))
+	for _, zoneInf := range zones {
+		zoneMap := zoneInf.(map[string]interface{})
+		res = append(res, zoneAssociation{
+			Fqdn: zoneMap["fqdn"].(string),
+			View: zoneMap["view"].(string),
+		})
+	}
+
+	return res
+}
+
+func resourceSharedRecordGroup() *schema.Resource {
+	return &schema.Resource{
+		Create:   resourceSharedRecordGroupCreate,
+		Read:     resourceSharedRecordGroupRead,
+		Update:   resourceSharedRecordGroupUpdate,
+		Delete:   resourceSharedRecordGroupDelete,
+		Importer: &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the shared record group.",
+			},
+			"zone_associations": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "The list of zones which the shared records of the group are published in.",
+				Elem:        zoneAssociationSchemaElem(),
+			},
+			"comment": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "Description of the shared record group.",
+			},
+			"ext_attrs": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "Extensible attributes of the shared record group to be added/updated, as a map in JSON format.",
+			},
+		},
+	}
+}
+
+func resourceSharedRecordGroupCreate(d *schema.ResourceData, m interface{}) error {
+	name := d.Get("name").(string)
+	if name == "" {
+		return fmt.Errorf("'name' must not be empty")
+	}
+	zones := convertInterfaceToZoneAssociations(d.Get("zone_associations").([]interface{}))
+	comment := d.Get("comment").(string)
+
+	extAttrJSON := d.Get("ext_attrs").(string)
+	extAttrs := make(map[string]interface{})
+	if extAttrJSON != "" {
+		if err := json.Unmarshal([]byte(extAttrJSON), &extAttrs); err != nil {
+			return fmt.Errorf("cannot process 'ext_attrs' field: %w", err)
+		}
+	}
+
+	group := newSharedRecordGroup(sharedRecordGroup{
+		Name:             name,
+		ZoneAssociations: zones,
+		Comment:          comment,
+		Ea:               extAttrs,
+	})
+
+	connector := m.(ibclient.IBConnector)
+	ref, err := connector.CreateObject(group)
+	if err != nil {
+		return fmt.Errorf("creation of the shared record group '%s' failed: %w", name, err)
+	}
+	d.SetId(ref)
+
+	return nil
+}
+
+func resourceSharedRecordGroupRead(d *schema.ResourceData, m interface{}) error {
+	connector := m.(ibclient.IBConnector)
+
+	obj := newSharedRecordGroup(sharedRecordGroup{})
+	if err := connector.GetObject(obj, d.Id(), ibclient.NewQueryParams(false, nil), obj); err != nil {
+		return fmt.Errorf("failed getting the shared record group: %w", err)
+	}
+
+	if obj.Ea != nil && len(obj.Ea) > 0 {
+		// TODO: temporary scaffold, need to rework marshalling/unmarshalling of EAs
+		//       (avoiding additional layer of keys ("value" key)
+		eaMap := (map[string]interface{})(obj.Ea)
+		ea, err := json.Marshal(eaMap)
+		if err != nil {
+			return err
+		}
+		if err = d.Set("ext_attrs", string(ea)); err != nil {
+			return err
+		}
+	}
+
+	if err := d.Set("name", obj.Name); err != nil {
+		return err
+	}
+	if err := d.Set("zone_associations", convertZoneAssociationsToInterface(obj.ZoneAssociations)); err != nil {
+		return err
+	}
+	if err := d.Set("comment", obj.Comment); err != nil {
+		return err
+	}
+
+	d.SetId(obj.Ref)
+
+	return nil
+}
+
+func resourceSharedRecordGroupUpdate(d *schema.ResourceData, m interface{}) error {
+	var updateSuccessful bool
+	defer func() {
+		// Reverting the state back, in case of a failure,
+		// otherwise Terraform will keep the values, which leaded to the failure,
+		// in the state file.
+		if !updateSuccessful {
+			prevName, _ := d.GetChange("name")
+			prevZones, _ := d.GetChange("zone_associations")
+			prevComment, _ := d.GetChange("comment")
+			prevEa, _ := d.GetChange("ext_attrs")
+
+			_ = d.Set("name", prevName.(string))
+			_ = d.Set("zone_associations", prevZones.([]interface{}))
+			_ = d.Set("comment", prevComment.(string))
+			_ = d.Set("ext_attrs", prevEa.(string))
+		}
+	}()
+
+	name := d.Get("name").(string)
+	if name == "" {
+		return fmt.Errorf("'name' must not be empty")
+	}
+	zones := convertInterfaceToZoneAssociations(d.Get("zone_associations").([]interface{}))
+	comment := d.Get("comment").(string)
+
+	extAttrJSON := d.Get("ext_attrs").(string)
+	extAttrs := make(map[string]interface{})
+	if extAttrJSON != "" {
+		if err := json.Unmarshal([]byte(extAttrJSON), &extAttrs); err != nil {
+			return fmt.Errorf("cannot process 'ext_attrs' field: %w", err)
+		}
+	}
+
+	group := newSharedRecordGroup(sharedRecordGroup{
+		Name:             name,
+		ZoneAssociations: zones,
+		Comment:          comment,
+		Ea:               extAttrs,
+	})
+
+	connector := m.(ibclient.IBConnector)
+	ref, err := connector.UpdateObject(group, d.Id())
+	if err != nil {
+		return fmt.Errorf("error updating the shared record group: %w", err)
+	}
+	updateSuccessful = true
+	d.SetId(ref)
+
+	return nil
+}
+
+func resourceSharedRecordGroupDelete(d *schema.ResourceData, m interface{}) error {
+	connector := m.(ibclient.IBConnector)
+
+	if _, err := connector.DeleteObject(d.Id()); err != nil {
+		return fmt.Errorf("deletion of the shared record group failed: %w", err)
+	}
+	d.SetId("")
+
+	return nil
+}
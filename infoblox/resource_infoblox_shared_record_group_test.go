@@ -0,0 +1,134 @@
+package infoblox
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+)
+
+func testAccCheckSharedRecordGroupDestroy(s *terraform.State) error {
+	meta := testAccProvider.Meta()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "infoblox_shared_record_group" {
+			continue
+		}
+		connector := meta.(ibclient.IBConnector)
+		group := newSharedRecordGroup(sharedRecordGroup{})
+		err := connector.GetObject(group, rs.Primary.ID, ibclient.NewQueryParams(false, nil), group)
+		if err == nil {
+			return fmt.Errorf("shared record group still exists")
+		}
+	}
+	return nil
+}
+
+func testAccSharedRecordGroupCompare(
+	t *testing.T, resPath string, expectedGroup *sharedRecordGroup) resource.TestCheckFunc {
+
+	return func(s *terraform.State) error {
+		res, found := s.RootModule().Resources[resPath]
+		if !found {
+			return fmt.Errorf("not found: %s", resPath)
+		}
+		if res.Primary.ID == "" {
+			return fmt.Errorf("ID is not set")
+		}
+		meta := testAccProvider.Meta()
+		connector := meta.(ibclient.IBConnector)
+
+		group := newSharedRecordGroup(sharedRecordGroup{})
+		if err := connector.GetObject(group, res.Primary.ID, ibclient.NewQueryParams(false, nil), group); err != nil {
+			return fmt.Errorf("shared record group not found: %s", err)
+		}
+
+		if group.Name != expectedGroup.Name {
+			return fmt.Errorf(
+				"'name' does not match: got '%s', expected '%s'",
+				group.Name, expectedGroup.Name)
+		}
+		if len(group.ZoneAssociations) != len(expectedGroup.ZoneAssociations) {
+			return fmt.Errorf(
+				"the number of associated zones does not match: got '%d', expected '%d'",
+				len(group.ZoneAssociations), len(expectedGroup.ZoneAssociations))
+		}
+		for i, zone := range expectedGroup.ZoneAssociations {
+			if group.ZoneAssociations[i] != zone {
+				return fmt.Errorf(
+					"associated zone does not match: got '%+v', expected '%+v'",
+					group.ZoneAssociations[i], zone)
+			}
+		}
+		if group.Comment != expectedGroup.Comment {
+			return fmt.Errorf(
+				"'comment' does not match: got '%s', expected '%s'",
+				group.Comment, expectedGroup.Comment)
+		}
+		return validateEAs(group.Ea, expectedGroup.Ea)
+	}
+}
+
+func TestAccResourceSharedRecordGroup(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSharedRecordGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "infoblox_shared_record_group" "foo"{
+						name = "common-records"
+						zone_associations {
+							fqdn = "test.com"
+						}
+					}`,
+				Check: resource.ComposeTestCheckFunc(
+					testAccSharedRecordGroupCompare(t, "infoblox_shared_record_group.foo", &sharedRecordGroup{
+						Name: "common-records",
+						ZoneAssociations: []zoneAssociation{
+							{Fqdn: "test.com", View: "default"},
+						},
+					}),
+				),
+			},
+			{
+				Config: `
+					resource "infoblox_shared_record_group" "foo"{
+						name = "common-records-2"
+						zone_associations {
+							fqdn = "test.com"
+						}
+						zone_associations {
+							fqdn = "test.com"
+							view = "nondefault_view"
+						}
+						comment = "test comment 1"
+						ext_attrs = jsonencode({
+							"Location" = "Los Angeles"
+						})
+					}`,
+				Check: resource.ComposeTestCheckFunc(
+					testAccSharedRecordGroupCompare(t, "infoblox_shared_record_group.foo", &sharedRecordGroup{
+						Name: "common-records-2",
+						ZoneAssociations: []zoneAssociation{
+							{Fqdn: "test.com", View: "default"},
+							{Fqdn: "test.com", View: "nondefault_view"},
+						},
+						Comment: "test comment 1",
+						Ea: ibclient.EA{
+							"Location": "Los Angeles",
+						},
+					}),
+				),
+			},
+			{
+				ResourceName:      "infoblox_shared_record_group.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
@@ -0,0 +1,252 @@
+package infoblox
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+)
+
+var sharedRecordKindsByResourceType = map[string]sharedRecordKind{
+	"infoblox_shared_record_a":     sharedRecordKindA,
+	"infoblox_shared_record_aaaa":  sharedRecordKindAAAA,
+	"infoblox_shared_record_cname": sharedRecordKindCName,
+	"infoblox_shared_record_mx":    sharedRecordKindMX,
+	"infoblox_shared_record_srv":   sharedRecordKindSRV,
+	"infoblox_shared_record_txt":   sharedRecordKindTXT,
+}
+
+const testAccSharedRecordGroupConfig = `
+	resource "infoblox_shared_record_group" "group"{
+		name = "shared-records-acctest"
+		zone_associations {
+			fqdn = "test.com"
+		}
+	}
+`
+
+func testAccCheckSharedRecordDestroy(s *terraform.State) error {
+	meta := testAccProvider.Meta()
+
+	for _, rs := range s.RootModule().Resources {
+		kind, found := sharedRecordKindsByResourceType[rs.Type]
+		if !found {
+			continue
+		}
+		connector := meta.(ibclient.IBConnector)
+		rec := newSharedRecord(kind.objectType, kind.returnFields(), sharedRecord{})
+		err := connector.GetObject(rec, rs.Primary.ID, ibclient.NewQueryParams(false, nil), rec)
+		if err == nil {
+			return fmt.Errorf("shared record still exists")
+		}
+	}
+	return nil
+}
+
+func testAccSharedRecordCompare(
+	t *testing.T, resPath string, kind sharedRecordKind, expectedRec *sharedRecord) resource.TestCheckFunc {
+
+	return func(s *terraform.State) error {
+		res, found := s.RootModule().Resources[resPath]
+		if !found {
+			return fmt.Errorf("not found: %s", resPath)
+		}
+		if res.Primary.ID == "" {
+			return fmt.Errorf("ID is not set")
+		}
+		meta := testAccProvider.Meta()
+		connector := meta.(ibclient.IBConnector)
+
+		rec := newSharedRecord(kind.objectType, kind.returnFields(), sharedRecord{})
+		if err := connector.GetObject(rec, res.Primary.ID, ibclient.NewQueryParams(false, nil), rec); err != nil {
+			return fmt.Errorf("shared record not found: %s", err)
+		}
+
+		if rec.Name != expectedRec.Name {
+			return fmt.Errorf(
+				"'name' does not match: got '%s', expected '%s'",
+				rec.Name, expectedRec.Name)
+		}
+		if rec.SharedRecordGroup != expectedRec.SharedRecordGroup {
+			return fmt.Errorf(
+				"'shared_record_group' does not match: got '%s', expected '%s'",
+				rec.SharedRecordGroup, expectedRec.SharedRecordGroup)
+		}
+		for _, field := range kind.fields {
+			if field.isInt {
+				actual, expected := *rec.intField(field.wapiName), *expectedRec.intField(field.wapiName)
+				if actual == nil || expected == nil || *actual != *expected {
+					return fmt.Errorf("'%s' does not match", field.name)
+				}
+				continue
+			}
+			actual, expected := *rec.stringField(field.wapiName), *expectedRec.stringField(field.wapiName)
+			if actual != expected {
+				return fmt.Errorf(
+					"'%s' does not match: got '%s', expected '%s'",
+					field.name, actual, expected)
+			}
+		}
+		if rec.UseTtl != expectedRec.UseTtl {
+			return fmt.Errorf(
+				"TTL usage does not match: got '%t', expected '%t'",
+				rec.UseTtl, expectedRec.UseTtl)
+		}
+		if rec.UseTtl && rec.Ttl != expectedRec.Ttl {
+			return fmt.Errorf(
+				"'ttl' does not match: got '%d', expected '%d'",
+				rec.Ttl, expectedRec.Ttl)
+		}
+		if rec.Comment != expectedRec.Comment {
+			return fmt.Errorf(
+				"'comment' does not match: got '%s', expected '%s'",
+				rec.Comment, expectedRec.Comment)
+		}
+		return validateEAs(rec.Ea, expectedRec.Ea)
+	}
+}
+
+func TestAccResourceSharedRecord(t *testing.T) {
+	preference := uint32(10)
+	priority := uint32(5)
+	weight := uint32(20)
+	port := uint32(5060)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSharedRecordDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSharedRecordGroupConfig + `
+					resource "infoblox_shared_record_a" "a"{
+						shared_record_group = infoblox_shared_record_group.group.name
+						name = "anycast"
+						ip_addr = "10.0.0.53"
+					}
+
+					resource "infoblox_shared_record_aaaa" "aaaa"{
+						shared_record_group = infoblox_shared_record_group.group.name
+						name = "anycast"
+						ipv6_addr = "2001:db8::53"
+					}
+
+					resource "infoblox_shared_record_cname" "cname"{
+						shared_record_group = infoblox_shared_record_group.group.name
+						name = "autodiscover"
+						canonical = "autodiscover.outlook.com"
+					}
+
+					resource "infoblox_shared_record_mx" "mx"{
+						shared_record_group = infoblox_shared_record_group.group.name
+						mail_exchanger = "mx1.mail-provider.com"
+						preference = 10
+					}
+
+					resource "infoblox_shared_record_srv" "srv"{
+						shared_record_group = infoblox_shared_record_group.group.name
+						name = "_sip._udp"
+						priority = 5
+						weight = 20
+						port = 5060
+						target = "sip.test.com"
+					}
+
+					resource "infoblox_shared_record_txt" "txt"{
+						shared_record_group = infoblox_shared_record_group.group.name
+						text = "v=spf1 include:mail-provider.com -all"
+						ttl = 300
+						comment = "test comment 1"
+						ext_attrs = jsonencode({
+							"Location" = "Los Angeles"
+						})
+					}`,
+				Check: resource.ComposeTestCheckFunc(
+					testAccSharedRecordCompare(t, "infoblox_shared_record_a.a", sharedRecordKindA, &sharedRecord{
+						Name:              "anycast",
+						SharedRecordGroup: "shared-records-acctest",
+						Ipv4Addr:          "10.0.0.53",
+					}),
+					testAccSharedRecordCompare(t, "infoblox_shared_record_aaaa.aaaa", sharedRecordKindAAAA, &sharedRecord{
+						Name:              "anycast",
+						SharedRecordGroup: "shared-records-acctest",
+						Ipv6Addr:          "2001:db8::53",
+					}),
+					testAccSharedRecordCompare(t, "infoblox_shared_record_cname.cname", sharedRecordKindCName, &sharedRecord{
+						Name:              "autodiscover",
+						SharedRecordGroup: "shared-records-acctest",
+						Canonical:         "autodiscover.outlook.com",
+					}),
+					testAccSharedRecordCompare(t, "infoblox_shared_record_mx.mx", sharedRecordKindMX, &sharedRecord{
+						SharedRecordGroup: "shared-records-acctest",
+						MailExchanger:     "mx1.mail-provider.com",
+						Preference:        &preference,
+					}),
+					testAccSharedRecordCompare(t, "infoblox_shared_record_srv.srv", sharedRecordKindSRV, &sharedRecord{
+						Name:              "_sip._udp",
+						SharedRecordGroup: "shared-records-acctest",
+						Priority:          &priority,
+						Weight:            &weight,
+						Port:              &port,
+						Target:            "sip.test.com",
+					}),
+					testAccSharedRecordCompare(t, "infoblox_shared_record_txt.txt", sharedRecordKindTXT, &sharedRecord{
+						SharedRecordGroup: "shared-records-acctest",
+						Text:              "v=spf1 include:mail-provider.com -all",
+						Ttl:               300,
+						UseTtl:            true,
+						Comment:           "test comment 1",
+						Ea: ibclient.EA{
+							"Location": "Los Angeles",
+						},
+					}),
+				),
+			},
+			{
+				Config: testAccSharedRecordGroupConfig + `
+					resource "infoblox_shared_record_a" "a"{
+						shared_record_group = infoblox_shared_record_group.group.name
+						name = "anycast"
+						ip_addr = "10.0.0.54"
+						comment = "moved to a new address"
+					}`,
+				Check: resource.ComposeTestCheckFunc(
+					testAccSharedRecordCompare(t, "infoblox_shared_record_a.a", sharedRecordKindA, &sharedRecord{
+						Name:              "anycast",
+						SharedRecordGroup: "shared-records-acctest",
+						Ipv4Addr:          "10.0.0.54",
+						Comment:           "moved to a new address",
+					}),
+				),
+			},
+			{
+				ResourceName:      "infoblox_shared_record_a.a",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+
+			// negative test cases
+			{
+				Config: testAccSharedRecordGroupConfig + `
+					resource "infoblox_shared_record_a" "a"{
+						shared_record_group = "another-group"
+						name = "anycast"
+						ip_addr = "10.0.0.54"
+					}`,
+				ExpectError: regexp.MustCompile("changing the value of 'shared_record_group' field is not allowed"),
+			},
+			{
+				Config: testAccSharedRecordGroupConfig + `
+					resource "infoblox_shared_record_mx" "mx"{
+						shared_record_group = infoblox_shared_record_group.group.name
+						mail_exchanger = "mx1.mail-provider.com"
+						preference = 70000
+					}`,
+				ExpectError: regexp.MustCompile("preference"),
+			},
+		},
+	})
+}
@@ -3,10 +3,115 @@ package infoblox
 import (
 	"encoding/json"
 	"fmt"
+	"strings"
+	"unicode/utf8"
+
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
 )
 
+// The maximum length of a single character-string of a TXT-record, in bytes.
+const txtRecordMaxStringLen = 255
+
+// Splits the text into character-strings which are not longer than allowed,
+// without breaking multibyte characters. A text which is not valid UTF-8
+// may not have a character boundary within the limit, then it is cut at the limit.
+func splitTXTRecordText(text string) []string {
+	var res []string
+	for len(text) > txtRecordMaxStringLen {
+		end := txtRecordMaxStringLen
+		for end > 0 && !utf8.RuneStart(text[end]) {
+			end--
+		}
+		if end == 0 {
+			end = txtRecordMaxStringLen
+		}
+		res = append(res, text[:end])
+		text = text[end:]
+	}
+
+	return append(res, text)
+}
+
+// Renders the character-strings in the form NIOS expects for several strings: quoted and separated by spaces.
+func formatTXTRecordStrings(strs []string) string {
+	quoted := make([]string, 0, len(strs))
+	for _, s := range strs {
+		quoted = append(quoted, quoteZoneFileString(s))
+	}
+
+	return strings.Join(quoted, " ")
+}
+
+// Splits the value of 'text' field of a TXT-record into character-strings.
+// A value which is not a sequence of quoted strings is a single character-string.
+func parseTXTRecordText(text string) []string {
+	if !strings.HasPrefix(text, `"`) {
+		return []string{text}
+	}
+	tokens, err := tokenizeZoneFileLine(text)
+	if err != nil || len(tokens) == 0 {
+		return []string{text}
+	}
+	res := make([]string, 0, len(tokens))
+	for _, token := range tokens {
+		if !token.quoted {
+			return []string{text}
+		}
+		res = append(res, token.value)
+	}
+
+	return res
+}
+
+// Returns the value of 'text' field of the TXT-record to send to NIOS,
+// either out of 'text' or out of 'text_strings' field.
+func getTXTRecordText(d *schema.ResourceData) (string, error) {
+	text := d.Get("text").(string)
+	items := d.Get("text_strings").([]interface{})
+	if text != "" && len(items) > 0 {
+		return "", fmt.Errorf("only one of 'text' and 'text_strings' values is allowed to be defined")
+	}
+
+	if len(items) > 0 {
+		strs := make([]string, 0, len(items))
+		for _, item := range items {
+			str, _ := item.(string)
+			if str == "" || len(str) > txtRecordMaxStringLen {
+				return "", fmt.Errorf(
+					"every item of 'text_strings' must be a non-empty string up to %d characters long",
+					txtRecordMaxStringLen)
+			}
+			strs = append(strs, str)
+		}
+		return formatTXTRecordStrings(strs), nil
+	}
+
+	if text == "" {
+		return "", fmt.Errorf("either of 'text' and 'text_strings' values is required")
+	}
+	// A text which is quoted already is considered to be split by the user.
+	if len(text) > txtRecordMaxStringLen && !strings.HasPrefix(text, `"`) {
+		return formatTXTRecordStrings(splitTXTRecordText(text)), nil
+	}
+
+	return text, nil
+}
+
+// Sets either 'text' or 'text_strings' field, depending on which one is used for the resource,
+// normalizing the value of NIOS to the form it is defined in.
+func setTXTRecordText(d *schema.ResourceData, wapiText string) error {
+	strs := parseTXTRecordText(wapiText)
+	if len(d.Get("text_strings").([]interface{})) > 0 {
+		return d.Set("text_strings", strs)
+	}
+	if wapiText == d.Get("text").(string) {
+		return nil
+	}
+
+	return d.Set("text", strings.Join(strs, ""))
+}
+
 func resourceTXTRecord() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceTXTRecordCreate,
@@ -30,7 +135,13 @@ func resourceTXTRecord() *schema.Resource {
 			"text": {
 				Type:        schema.TypeString,
 				Optional:    true,
-				Description: "Data to be associated with TXT_Record.",
+				Description: "Data to be associated with TXT_Record. A value longer than 255 characters is split into several character-strings automatically.",
+			},
+			"text_strings": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Data to be associated with TXT_Record, as a list of character-strings up to 255 characters each. An alternative to 'text' field.",
 			},
 			"ttl": {
 				Type:        schema.TypeInt,
@@ -57,9 +168,9 @@ func resourceTXTRecord() *schema.Resource {
 func resourceTXTRecordCreate(d *schema.ResourceData, m interface{}) error {
 	dnsView := d.Get("dns_view").(string)
 	fqdn := d.Get("fqdn").(string)
-	text := d.Get("text").(string)
-	if text == "" {
-		return fmt.Errorf("empty 'text' value is not allowed")
+	text, err := getTXTRecordText(d)
+	if err != nil {
+		return err
 	}
 
 	var ttl uint32
@@ -125,7 +236,7 @@ func resourceTXTRecordGet(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("failed getting TXT-Record: %s", err)
 	}
 
-	if err = d.Set("text", obj.Text); err != nil {
+	if err = setTXTRecordText(d, obj.Text); err != nil {
 		return err
 	}
 
@@ -175,6 +286,7 @@ func resourceTXTRecordUpdate(d *schema.ResourceData, m interface{}) error {
 			prevDNSView, _ := d.GetChange("dns_view")
 			prevFQDN, _ := d.GetChange("fqdn")
 			prevTEXT, _ := d.GetChange("text")
+			prevTextStrings, _ := d.GetChange("text_strings")
 			prevTTL, _ := d.GetChange("ttl")
 			prevComment, _ := d.GetChange("comment")
 			prevEa, _ := d.GetChange("ext_attrs")
@@ -182,6 +294,7 @@ func resourceTXTRecordUpdate(d *schema.ResourceData, m interface{}) error {
 			_ = d.Set("dns_view", prevDNSView.(string))
 			_ = d.Set("fqdn", prevFQDN.(string))
 			_ = d.Set("text", prevTEXT.(string))
+			_ = d.Set("text_strings", prevTextStrings.([]interface{}))
 			_ = d.Set("ttl", prevTTL.(int))
 			_ = d.Set("comment", prevComment.(string))
 			_ = d.Set("ext_attrs", prevEa.(string))
@@ -192,9 +305,9 @@ func resourceTXTRecordUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("changing the value of 'dns_view' field is not allowed")
 	}
 
-	text := d.Get("text").(string)
-	if text == "" {
-		return fmt.Errorf("empty 'text' value is not allowed")
+	text, err := getTXTRecordText(d)
+	if err != nil {
+		return err
 	}
 
 	fqdn := d.Get("fqdn").(string)
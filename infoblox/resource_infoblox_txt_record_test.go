@@ -3,6 +3,7 @@ package infoblox
 import (
 	"fmt"
 	"regexp"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -151,8 +152,54 @@ func TestAccResourceTXTRecord(t *testing.T) {
 					}),
 				),
 			},
+			{
+				Config: fmt.Sprintf(`
+					resource "infoblox_txt_record" "foo2" {
+						fqdn = "name3.test.com"
+						text = "%s"
+						dns_view = "nondefault_view"
+					}`, testAccTXTRecordLongText),
+				Check: resource.ComposeTestCheckFunc(
+					testAccTXTRecordCompare(t, "infoblox_txt_record.foo2", &ibclient.RecordTXT{
+						Text: formatTXTRecordStrings([]string{
+							testAccTXTRecordLongText[:txtRecordMaxStringLen],
+							testAccTXTRecordLongText[txtRecordMaxStringLen:],
+						}),
+						Name: "name3.test.com",
+						View: "nondefault_view",
+					}),
+					resource.TestCheckResourceAttr("infoblox_txt_record.foo2", "text", testAccTXTRecordLongText),
+				),
+			},
+			{
+				Config: `
+					resource "infoblox_txt_record" "foo2" {
+						fqdn = "name3.test.com"
+						text_strings = ["v=DKIM1; k=rsa; p=MIIBIjANBgkqhkiG9w0BAQEFAAOCAQ8A", "MIIBCgKCAQEAwm4p \"quoted\""]
+						dns_view = "nondefault_view"
+					}`,
+				Check: resource.ComposeTestCheckFunc(
+					testAccTXTRecordCompare(t, "infoblox_txt_record.foo2", &ibclient.RecordTXT{
+						Text: `"v=DKIM1; k=rsa; p=MIIBIjANBgkqhkiG9w0BAQEFAAOCAQ8A" "MIIBCgKCAQEAwm4p \"quoted\""`,
+						Name: "name3.test.com",
+						View: "nondefault_view",
+					}),
+					resource.TestCheckResourceAttr("infoblox_txt_record.foo2", "text_strings.#", "2"),
+					resource.TestCheckResourceAttr("infoblox_txt_record.foo2", "text", ""),
+				),
+			},
 
 			// negative test cases
+			{
+				Config: `
+					resource "infoblox_txt_record" "foo2" {
+						fqdn = "name3.test.com"
+						text = "this is a text record"
+						text_strings = ["this is a text record"]
+						dns_view = "nondefault_view"
+					}`,
+				ExpectError: regexp.MustCompile("only one of 'text' and 'text_strings' values is allowed to be defined"),
+			},
 			{
 				Config: fmt.Sprintf(`
 					resource "infoblox_txt_record" "foo2" {
@@ -167,3 +214,35 @@ func TestAccResourceTXTRecord(t *testing.T) {
 		},
 	})
 }
+
+// A text which is longer than a single character-string of a TXT-record.
+var testAccTXTRecordLongText = "v=DKIM1; k=rsa; p=" + strings.Repeat("MIIBIjANBgkqhkiG9w0BAQEFAAOCAQ8A", 10)
+
+func TestTXTRecordText(t *testing.T) {
+	strs := splitTXTRecordText(strings.Repeat("a", 254) + "ü" + "b")
+	if len(strs) != 2 || strs[0] != strings.Repeat("a", 254) || strs[1] != "üb" {
+		t.Errorf("a multibyte character must not be split: %q", strs)
+	}
+	if strs = splitTXTRecordText("short"); len(strs) != 1 || strs[0] != "short" {
+		t.Errorf("a short text must not be split: %q", strs)
+	}
+	invalid := strings.Repeat("\x80", 300)
+	if strs = splitTXTRecordText(invalid); len(strs) != 2 || len(strs[0]) != 255 || strs[0]+strs[1] != invalid {
+		t.Errorf("a text without character boundaries must be cut at the limit: %q", strs)
+	}
+
+	text := formatTXTRecordStrings([]string{"v=spf1 include:a.test.com", `say "hi"`})
+	if text != `"v=spf1 include:a.test.com" "say \"hi\""` {
+		t.Errorf("unexpected formatted text: %s", text)
+	}
+	strs = parseTXTRecordText(text)
+	if len(strs) != 2 || strs[0] != "v=spf1 include:a.test.com" || strs[1] != `say "hi"` {
+		t.Errorf("unexpected parsed strings: %q", strs)
+	}
+
+	for _, text := range []string{"plain text", `"unbalanced`, `"quoted" unquoted`} {
+		if strs = parseTXTRecordText(text); len(strs) != 1 || strs[0] != text {
+			t.Errorf("'%s' must be a single character-string, got %q", text, strs)
+		}
+	}
+}
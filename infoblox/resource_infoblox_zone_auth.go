@@ -0,0 +1,501 @@
+package infoblox
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+)
+
+var zoneAuthSoaTimerFields = []string{
+	"soa_default_ttl", "soa_expire", "soa_negative_ttl", "soa_refresh", "soa_retry"}
+
+func gridPrimarySchemaElem() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the grid member.",
+			},
+			"stealth": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "If set, the name server is not listed in NS-records and in the SOA-record of the zone.",
+			},
+		},
+	}
+}
+
+func gridSecondariesSchemaElem() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the grid member.",
+			},
+			"stealth": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "If set, the name server is not listed in NS-records and in the SOA-record of the zone.",
+			},
+			"grid_replicate": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "If set, the zone data is replicated using grid replication, otherwise zone transfers are used.",
+			},
+			"lead": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "If set, the member sends zone transfers to other secondary name servers.",
+			},
+		},
+	}
+}
+
+func resourceZoneAuth() *schema.Resource {
+	return &schema.Resource{
+		Create:   resourceZoneAuthCreate,
+		Read:     resourceZoneAuthRead,
+		Update:   resourceZoneAuthUpdate,
+		Delete:   resourceZoneAuthDelete,
+		Importer: &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			"fqdn": {
+				Type:     schema.TypeString,
+				Required: true,
+				Description: "The name of the zone. For a forward zone this is an FQDN;" +
+					" for a reverse zone this is a network address in CIDR format.",
+			},
+			"view": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     defaultDNSView,
+				Description: "DNS view which the zone does exist within.",
+			},
+			"zone_format": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "FORWARD",
+				Description: "The format of the zone: 'FORWARD', 'IPV4' or 'IPV6'.",
+			},
+			"ns_group": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "The name server group which serves DNS for the zone. Must not be set together with 'grid_primary'.",
+			},
+			"grid_primary": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "The list of grid members which are primary name servers for the zone.",
+				Elem:        gridPrimarySchemaElem(),
+			},
+			"grid_secondaries": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "The list of grid members which are secondary name servers for the zone.",
+				Elem:        gridSecondariesSchemaElem(),
+			},
+			"soa_default_ttl": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "The TTL value (in seconds) of the SOA-record of the zone.",
+			},
+			"soa_expire": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "The time (in seconds) secondary servers keep serving the zone data when the primary server is unreachable.",
+			},
+			"soa_negative_ttl": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "The time (in seconds) negative responses are cached for.",
+			},
+			"soa_refresh": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "The interval (in seconds) secondary servers check the primary server for zone updates.",
+			},
+			"soa_retry": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "The interval (in seconds) secondary servers retry a failed zone update check.",
+			},
+			"allow_transfer": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "The list of clients which are allowed to request zone transfers; the grid-level setting is used if empty.",
+				Elem:        aclItemSchemaElem(),
+			},
+			"allow_update": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "The list of clients which are allowed to send dynamic updates for the zone.",
+				Elem:        aclItemSchemaElem(),
+			},
+			"comment": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "Description of the zone.",
+			},
+			"ext_attrs": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "Extensible attributes of the zone to be added/updated, as a map in JSON format.",
+			},
+		},
+	}
+}
+
+func convertMemberServersToInterface(servers []memberServer, isSecondary bool) []map[string]interface{} {
+	res := make([]map[string]interface{}, 0, len(servers))
+	for _, srv := range servers {
+		item := map[string]interface{}{
+			"name":    srv.Name,
+			"stealth": srv.Stealth,
+		}
+		if isSecondary {
+			item["grid_replicate"] = srv.GridReplicate
+			item["lead"] = srv.Lead
+		}
+		res = append(res, item)
+	}
+
+	return res
+}
+
+func convertInterfaceToMemberServers(servers []interface{}, isSecondary bool) []memberServer {
+	res := make([]memberServer, 0, len(servers))
+	for _, srvInf := range servers {
+		srvMap := srvInf.(map[string]interface{})
+		srv := memberServer{
+			Name:    srvMap["name"].(string),
+			Stealth: srvMap["stealth"].(bool),
+		}
+		if isSecondary {
+			srv.GridReplicate = srvMap["grid_replicate"].(bool)
+			srv.Lead = srvMap["lead"].(bool)
+		}
+		res = append(res, srv)
+	}
+
+	return res
+}
+
+// Sets SOA timers of the zone object, if at least one of them is defined.
+// The timers override grid-level values only all together,
+// thus the values which are not defined are taken from the resource's state.
+func setZoneAuthSoaTimers(d *schema.ResourceData, obj *zoneAuth, onlyChanged bool) error {
+	needUpdate := false
+	for _, fieldName := range zoneAuthSoaTimerFields {
+		if onlyChanged {
+			if d.HasChange(fieldName) {
+				needUpdate = true
+			}
+		} else if _, found := d.GetOk(fieldName); found {
+			needUpdate = true
+		}
+	}
+	if !needUpdate {
+		return nil
+	}
+
+	timers := make([]*uint32, len(zoneAuthSoaTimerFields))
+	for i, fieldName := range zoneAuthSoaTimerFields {
+		tempVal := d.Get(fieldName).(int)
+		if tempVal < 0 {
+			return fmt.Errorf("'%s' value must be 0 or higher", fieldName)
+		}
+		val := uint32(tempVal)
+		timers[i] = &val
+	}
+
+	useGridZoneTimer := true
+	obj.UseGridZoneTimer = &useGridZoneTimer
+	obj.SoaDefaultTtl = timers[0]
+	obj.SoaExpire = timers[1]
+	obj.SoaNegativeTtl = timers[2]
+	obj.SoaRefresh = timers[3]
+	obj.SoaRetry = timers[4]
+
+	return nil
+}
+
+func resourceZoneAuthCreate(d *schema.ResourceData, m interface{}) error {
+	fqdn := d.Get("fqdn").(string)
+	if fqdn == "" {
+		return fmt.Errorf("'fqdn' must not be empty")
+	}
+
+	dnsView := d.Get("view").(string)
+	zoneFormat := d.Get("zone_format").(string)
+	switch zoneFormat {
+	case "FORWARD", "IPV4", "IPV6":
+	default:
+		return fmt.Errorf("'zone_format' must be one of 'FORWARD', 'IPV4' or 'IPV6'")
+	}
+
+	nsGroup := d.Get("ns_group").(string)
+	gridPrimary := convertInterfaceToMemberServers(d.Get("grid_primary").([]interface{}), false)
+	gridSecondaries := convertInterfaceToMemberServers(d.Get("grid_secondaries").([]interface{}), true)
+	if nsGroup != "" && (len(gridPrimary) > 0 || len(gridSecondaries) > 0) {
+		return fmt.Errorf("'ns_group' must not be set together with 'grid_primary' or 'grid_secondaries'")
+	}
+
+	connector := m.(ibclient.IBConnector)
+
+	allowTransfer, err := convertInterfaceToACLItems(
+		connector, "allow_transfer", d.Get("allow_transfer").([]interface{}))
+	if err != nil {
+		return err
+	}
+	allowUpdate, err := convertInterfaceToACLItems(
+		connector, "allow_update", d.Get("allow_update").([]interface{}))
+	if err != nil {
+		return err
+	}
+
+	comment := d.Get("comment").(string)
+
+	extAttrJSON := d.Get("ext_attrs").(string)
+	extAttrs := make(map[string]interface{})
+	if extAttrJSON != "" {
+		if err := json.Unmarshal([]byte(extAttrJSON), &extAttrs); err != nil {
+			return fmt.Errorf("cannot process 'ext_attrs' field: %w", err)
+		}
+	}
+
+	zone := newZoneAuth(zoneAuth{
+		Fqdn:             fqdn,
+		View:             dnsView,
+		ZoneFormat:       zoneFormat,
+		GridPrimary:      gridPrimary,
+		GridSecondaries:  gridSecondaries,
+		AllowTransfer:    allowTransfer,
+		UseAllowTransfer: len(allowTransfer) > 0,
+		AllowUpdate:      allowUpdate,
+		Comment:          comment,
+		Ea:               extAttrs,
+	})
+	if nsGroup != "" {
+		zone.NsGroup = &nsGroup
+	}
+	if err := setZoneAuthSoaTimers(d, zone, false); err != nil {
+		return err
+	}
+
+	ref, err := connector.CreateObject(zone)
+	if err != nil {
+		return fmt.Errorf("creation of the zone '%s' under DNS view '%s' failed: %w", fqdn, dnsView, err)
+	}
+	d.SetId(ref)
+
+	return resourceZoneAuthRead(d, m)
+}
+
+func resourceZoneAuthRead(d *schema.ResourceData, m interface{}) error {
+	connector := m.(ibclient.IBConnector)
+
+	obj := newZoneAuth(zoneAuth{})
+	if err := connector.GetObject(obj, d.Id(), ibclient.NewQueryParams(false, nil), obj); err != nil {
+		return fmt.Errorf("failed getting the zone: %w", err)
+	}
+
+	if obj.Ea != nil && len(obj.Ea) > 0 {
+		// TODO: temporary scaffold, need to rework marshalling/unmarshalling of EAs
+		//       (avoiding additional layer of keys ("value" key)
+		eaMap := (map[string]interface{})(obj.Ea)
+		ea, err := json.Marshal(eaMap)
+		if err != nil {
+			return err
+		}
+		if err = d.Set("ext_attrs", string(ea)); err != nil {
+			return err
+		}
+	}
+
+	if err := d.Set("fqdn", obj.Fqdn); err != nil {
+		return err
+	}
+	if err := d.Set("view", obj.View); err != nil {
+		return err
+	}
+	if err := d.Set("zone_format", obj.ZoneFormat); err != nil {
+		return err
+	}
+	nsGroup := ""
+	if obj.NsGroup != nil {
+		nsGroup = *obj.NsGroup
+	}
+	if err := d.Set("ns_group", nsGroup); err != nil {
+		return err
+	}
+	// The name servers of the zone which is served by an NS group are defined by the group,
+	// so they are not tracked.
+	if nsGroup == "" {
+		if err := d.Set("grid_primary", convertMemberServersToInterface(obj.GridPrimary, false)); err != nil {
+			return err
+		}
+		if err := d.Set("grid_secondaries", convertMemberServersToInterface(obj.GridSecondaries, true)); err != nil {
+			return err
+		}
+	}
+	allowTransfer := obj.AllowTransfer
+	if !obj.UseAllowTransfer {
+		allowTransfer = nil
+	}
+	if err := d.Set("allow_transfer", convertACLItemsToInterface(allowTransfer)); err != nil {
+		return err
+	}
+	if err := d.Set("allow_update", convertACLItemsToInterface(obj.AllowUpdate)); err != nil {
+		return err
+	}
+
+	timers := []*uint32{obj.SoaDefaultTtl, obj.SoaExpire, obj.SoaNegativeTtl, obj.SoaRefresh, obj.SoaRetry}
+	for i, fieldName := range zoneAuthSoaTimerFields {
+		if timers[i] == nil {
+			continue
+		}
+		if err := d.Set(fieldName, int(*timers[i])); err != nil {
+			return err
+		}
+	}
+
+	if err := d.Set("comment", obj.Comment); err != nil {
+		return err
+	}
+
+	d.SetId(obj.Ref)
+
+	return nil
+}
+
+func resourceZoneAuthUpdate(d *schema.ResourceData, m interface{}) error {
+	var updateSuccessful bool
+	defer func() {
+		// Reverting the state back, in case of a failure,
+		// otherwise Terraform will keep the values, which leaded to the failure,
+		// in the state file.
+		if !updateSuccessful {
+			prevFQDN, _ := d.GetChange("fqdn")
+			prevView, _ := d.GetChange("view")
+			prevZoneFormat, _ := d.GetChange("zone_format")
+			prevNsGroup, _ := d.GetChange("ns_group")
+			prevGridPrimary, _ := d.GetChange("grid_primary")
+			prevGridSecondaries, _ := d.GetChange("grid_secondaries")
+			prevAllowTransfer, _ := d.GetChange("allow_transfer")
+			prevAllowUpdate, _ := d.GetChange("allow_update")
+			prevComment, _ := d.GetChange("comment")
+			prevEa, _ := d.GetChange("ext_attrs")
+
+			_ = d.Set("fqdn", prevFQDN.(string))
+			_ = d.Set("view", prevView.(string))
+			_ = d.Set("zone_format", prevZoneFormat.(string))
+			_ = d.Set("ns_group", prevNsGroup.(string))
+			_ = d.Set("grid_primary", prevGridPrimary.([]interface{}))
+			_ = d.Set("grid_secondaries", prevGridSecondaries.([]interface{}))
+			_ = d.Set("allow_transfer", prevAllowTransfer.([]interface{}))
+			_ = d.Set("allow_update", prevAllowUpdate.([]interface{}))
+			_ = d.Set("comment", prevComment.(string))
+			_ = d.Set("ext_attrs", prevEa.(string))
+
+			for _, fieldName := range zoneAuthSoaTimerFields {
+				prevTimer, _ := d.GetChange(fieldName)
+				_ = d.Set(fieldName, prevTimer.(int))
+			}
+		}
+	}()
+
+	if d.HasChange("fqdn") {
+		return fmt.Errorf("changing the value of 'fqdn' field is not allowed")
+	}
+	if d.HasChange("view") {
+		return fmt.Errorf("changing the value of 'view' field is not allowed")
+	}
+	if d.HasChange("zone_format") {
+		return fmt.Errorf("changing the value of 'zone_format' field is not allowed")
+	}
+
+	nsGroup := d.Get("ns_group").(string)
+	gridPrimary := convertInterfaceToMemberServers(d.Get("grid_primary").([]interface{}), false)
+	gridSecondaries := convertInterfaceToMemberServers(d.Get("grid_secondaries").([]interface{}), true)
+	if nsGroup != "" && (len(gridPrimary) > 0 || len(gridSecondaries) > 0) {
+		return fmt.Errorf("'ns_group' must not be set together with 'grid_primary' or 'grid_secondaries'")
+	}
+
+	connector := m.(ibclient.IBConnector)
+
+	allowTransfer, err := convertInterfaceToACLItems(
+		connector, "allow_transfer", d.Get("allow_transfer").([]interface{}))
+	if err != nil {
+		return err
+	}
+	allowUpdate, err := convertInterfaceToACLItems(
+		connector, "allow_update", d.Get("allow_update").([]interface{}))
+	if err != nil {
+		return err
+	}
+
+	comment := d.Get("comment").(string)
+
+	extAttrJSON := d.Get("ext_attrs").(string)
+	extAttrs := make(map[string]interface{})
+	if extAttrJSON != "" {
+		if err := json.Unmarshal([]byte(extAttrJSON), &extAttrs); err != nil {
+			return fmt.Errorf("cannot process 'ext_attrs' field: %w", err)
+		}
+	}
+
+	zone := newZoneAuth(zoneAuth{
+		GridPrimary:      gridPrimary,
+		GridSecondaries:  gridSecondaries,
+		AllowTransfer:    allowTransfer,
+		UseAllowTransfer: len(allowTransfer) > 0,
+		AllowUpdate:      allowUpdate,
+		Comment:          comment,
+		Ea:               extAttrs,
+	})
+	// An empty NS group is sent only to unassign the previous one.
+	if nsGroup != "" || d.HasChange("ns_group") {
+		zone.NsGroup = &nsGroup
+	}
+	if err := setZoneAuthSoaTimers(d, zone, true); err != nil {
+		return err
+	}
+
+	ref, err := connector.UpdateObject(zone, d.Id())
+	if err != nil {
+		return fmt.Errorf("error updating the zone: %w", err)
+	}
+	updateSuccessful = true
+	d.SetId(ref)
+
+	return resourceZoneAuthRead(d, m)
+}
+
+func resourceZoneAuthDelete(d *schema.ResourceData, m interface{}) error {
+	connector := m.(ibclient.IBConnector)
+
+	if _, err := connector.DeleteObject(d.Id()); err != nil {
+		return fmt.Errorf("deletion of the zone failed: %w", err)
+	}
+	d.SetId("")
+
+	return nil
+}
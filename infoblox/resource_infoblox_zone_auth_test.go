@@ -0,0 +1,208 @@
+package infoblox
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+)
+
+func testAccCheckZoneAuthDestroy(s *terraform.State) error {
+	meta := testAccProvider.Meta()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "infoblox_zone_auth" {
+			continue
+		}
+		connector := meta.(ibclient.IBConnector)
+		zone := newZoneAuth(zoneAuth{})
+		err := connector.GetObject(zone, rs.Primary.ID, ibclient.NewQueryParams(false, nil), zone)
+		if err == nil {
+			return fmt.Errorf("zone still exists")
+		}
+	}
+	return nil
+}
+
+func testAccZoneAuthCompare(t *testing.T, resPath string, expectedZone *zoneAuth) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		res, found := s.RootModule().Resources[resPath]
+		if !found {
+			return fmt.Errorf("not found: %s", resPath)
+		}
+		if res.Primary.ID == "" {
+			return fmt.Errorf("ID is not set")
+		}
+		meta := testAccProvider.Meta()
+		connector := meta.(ibclient.IBConnector)
+
+		zone := newZoneAuth(zoneAuth{})
+		if err := connector.GetObject(zone, res.Primary.ID, ibclient.NewQueryParams(false, nil), zone); err != nil {
+			return fmt.Errorf("zone not found: %s", err)
+		}
+
+		if zone.Fqdn != expectedZone.Fqdn {
+			return fmt.Errorf(
+				"'fqdn' does not match: got '%s', expected '%s'",
+				zone.Fqdn, expectedZone.Fqdn)
+		}
+		if zone.View != expectedZone.View {
+			return fmt.Errorf(
+				"'view' does not match: got '%s', expected '%s'",
+				zone.View, expectedZone.View)
+		}
+		if zone.ZoneFormat != expectedZone.ZoneFormat {
+			return fmt.Errorf(
+				"'zone_format' does not match: got '%s', expected '%s'",
+				zone.ZoneFormat, expectedZone.ZoneFormat)
+		}
+		if len(zone.GridPrimary) != len(expectedZone.GridPrimary) {
+			return fmt.Errorf(
+				"the number of primary servers does not match: got '%d', expected '%d'",
+				len(zone.GridPrimary), len(expectedZone.GridPrimary))
+		}
+		for i, srv := range expectedZone.GridPrimary {
+			if zone.GridPrimary[i].Name != srv.Name {
+				return fmt.Errorf(
+					"primary server's name does not match: got '%s', expected '%s'",
+					zone.GridPrimary[i].Name, srv.Name)
+			}
+		}
+		if expectedZone.SoaDefaultTtl != nil {
+			if zone.SoaDefaultTtl == nil || *zone.SoaDefaultTtl != *expectedZone.SoaDefaultTtl {
+				return fmt.Errorf("'soa_default_ttl' does not match the expected value '%d'",
+					*expectedZone.SoaDefaultTtl)
+			}
+		}
+		if zone.Comment != expectedZone.Comment {
+			return fmt.Errorf(
+				"'comment' does not match: got '%s', expected '%s'",
+				zone.Comment, expectedZone.Comment)
+		}
+		return validateEAs(zone.Ea, expectedZone.Ea)
+	}
+}
+
+func TestAccResourceZoneAuth(t *testing.T) {
+	soaDefaultTtl := uint32(3600)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckZoneAuthDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "infoblox_zone_auth" "foo"{
+						fqdn = "zone-auth-1.test.com"
+					}`,
+				Check: resource.ComposeTestCheckFunc(
+					testAccZoneAuthCompare(t, "infoblox_zone_auth.foo", &zoneAuth{
+						Fqdn:       "zone-auth-1.test.com",
+						View:       "default",
+						ZoneFormat: "FORWARD",
+					}),
+				),
+			},
+			{
+				Config: `
+					resource "infoblox_zone_auth" "foo"{
+						fqdn = "zone-auth-1.test.com"
+						comment = "test comment 1"
+						grid_primary {
+							name = "infoblox.localdomain"
+						}
+						soa_default_ttl = 3600
+						soa_expire = 2419200
+						soa_negative_ttl = 900
+						soa_refresh = 10800
+						soa_retry = 3600
+						ext_attrs = jsonencode({
+							"Location" = "Los Angeles"
+							"Site" = "HQ"
+						})
+					}`,
+				Check: resource.ComposeTestCheckFunc(
+					testAccZoneAuthCompare(t, "infoblox_zone_auth.foo", &zoneAuth{
+						Fqdn:          "zone-auth-1.test.com",
+						View:          "default",
+						ZoneFormat:    "FORWARD",
+						GridPrimary:   []memberServer{{Name: "infoblox.localdomain"}},
+						SoaDefaultTtl: &soaDefaultTtl,
+						Comment:       "test comment 1",
+						Ea: ibclient.EA{
+							"Location": "Los Angeles",
+							"Site":     "HQ",
+						},
+					}),
+				),
+			},
+			{
+				Config: `
+					resource "infoblox_zone_auth" "rev4"{
+						fqdn = "10.11.0.0/16"
+						view = "nondefault_view"
+						zone_format = "IPV4"
+						comment = "IPv4 reverse zone"
+					}
+
+					resource "infoblox_zone_auth" "rev6"{
+						fqdn = "2002:1f93::/64"
+						zone_format = "IPV6"
+						comment = "IPv6 reverse zone"
+					}`,
+				Check: resource.ComposeTestCheckFunc(
+					testAccZoneAuthCompare(t, "infoblox_zone_auth.rev4", &zoneAuth{
+						Fqdn:       "10.11.0.0/16",
+						View:       "nondefault_view",
+						ZoneFormat: "IPV4",
+						Comment:    "IPv4 reverse zone",
+					}),
+					testAccZoneAuthCompare(t, "infoblox_zone_auth.rev6", &zoneAuth{
+						Fqdn:       "2002:1f93::/64",
+						View:       "default",
+						ZoneFormat: "IPV6",
+						Comment:    "IPv6 reverse zone",
+					}),
+				),
+			},
+			{
+				// the name servers, defined by the NS group, do not show up as a drift
+				Config: `
+					resource "infoblox_zone_auth" "foo2"{
+						fqdn = "zone-auth-2.test.com"
+						ns_group = "ns-group-1"
+					}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("infoblox_zone_auth.foo2", "ns_group", "ns-group-1"),
+					resource.TestCheckResourceAttr("infoblox_zone_auth.foo2", "grid_primary.#", "0"),
+					resource.TestCheckResourceAttr("infoblox_zone_auth.foo2", "grid_secondaries.#", "0"),
+				),
+			},
+
+			// negative test cases
+			{
+				Config: `
+					resource "infoblox_zone_auth" "foo2"{
+						fqdn = "zone-auth-2.test.com"
+						zone_format = "REVERSE"
+					}`,
+				ExpectError: regexp.MustCompile("'zone_format' must be one of 'FORWARD', 'IPV4' or 'IPV6'"),
+			},
+			{
+				Config: `
+					resource "infoblox_zone_auth" "foo2"{
+						fqdn = "zone-auth-2.test.com"
+						ns_group = "ns-group-1"
+						grid_primary {
+							name = "infoblox.localdomain"
+						}
+					}`,
+				ExpectError: regexp.MustCompile("'ns_group' must not be set together with 'grid_primary' or 'grid_secondaries'"),
+			},
+		},
+	})
+}
@@ -0,0 +1,339 @@
+package infoblox
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+)
+
+var zoneDelegatedRefRegexp = regexp.MustCompile("^zone_delegated/.+")
+
+func resourceZoneDelegated() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceZoneDelegatedCreate,
+		Read:   resourceZoneDelegatedRead,
+		Update: resourceZoneDelegatedUpdate,
+		Delete: resourceZoneDelegatedDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: zoneDelegatedImporter,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"fqdn": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The FQDN of the delegated zone.",
+			},
+			"view": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     defaultDNSView,
+				Description: "DNS view which the zone does exist within.",
+			},
+			"delegate_to": {
+				Type:        schema.TypeList,
+				Required:    true,
+				Description: "The list of name servers which the zone is delegated to.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The FQDN of the name server.",
+						},
+						"address": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The IP address of the name server.",
+						},
+					},
+				},
+			},
+			"delegated_ttl": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     ttlUndef,
+				Description: "TTL value for the NS-records and glue records of the delegation.",
+			},
+			"locked": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "If set, other administrators are not allowed to make conflicting changes to the zone.",
+			},
+			"comment": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "Description of the delegated zone.",
+			},
+			"ext_attrs": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "Extensible attributes of the delegated zone to be added/updated, as a map in JSON format.",
+			},
+		},
+	}
+}
+
+func convertNameServersToInterface(servers []ibclient.NameServer) []map[string]interface{} {
+	res := make([]map[string]interface{}, 0, len(servers))
+	for _, srv := range servers {
+		res = append(res, map[string]interface{}{
+			"name":    srv.Name,
+			"address": srv.Address,
+		})
+	}
+
+	return res
+}
+
+func convertInterfaceToNameServers(servers []interface{}) []ibclient.NameServer {
+	res := make([]ibclient.NameServer, 0, len(servers))
+	for _, srvInf := range servers {
+		srvMap := srvInf.(map[string]interface{})
+		res = append(res, ibclient.NameServer{
+			Name:    srvMap["name"].(string),
+			Address: srvMap["address"].(string),
+		})
+	}
+
+	return res
+}
+
+func resourceZoneDelegatedCreate(d *schema.ResourceData, m interface{}) error {
+	fqdn := d.Get("fqdn").(string)
+	if fqdn == "" {
+		return fmt.Errorf("'fqdn' must not be empty")
+	}
+	dnsView := d.Get("view").(string)
+
+	delegateTo := convertInterfaceToNameServers(d.Get("delegate_to").([]interface{}))
+	if len(delegateTo) == 0 {
+		return fmt.Errorf("at least one name server must be defined in 'delegate_to'")
+	}
+
+	var ttl uint32
+	useTtl := false
+	tempTTL := d.Get("delegated_ttl").(int)
+	if tempTTL >= 0 {
+		useTtl = true
+		ttl = uint32(tempTTL)
+	} else if tempTTL != ttlUndef {
+		return fmt.Errorf("TTL value must be 0 or higher")
+	}
+
+	locked := d.Get("locked").(bool)
+	comment := d.Get("comment").(string)
+
+	extAttrJSON := d.Get("ext_attrs").(string)
+	extAttrs := make(map[string]interface{})
+	if extAttrJSON != "" {
+		if err := json.Unmarshal([]byte(extAttrJSON), &extAttrs); err != nil {
+			return fmt.Errorf("cannot process 'ext_attrs' field: %w", err)
+		}
+	}
+
+	zone := newZoneDelegated(zoneDelegated{
+		Fqdn:            fqdn,
+		View:            dnsView,
+		DelegateTo:      delegateTo,
+		DelegatedTtl:    ttl,
+		UseDelegatedTtl: useTtl,
+		Locked:          locked,
+		Comment:         comment,
+		Ea:              extAttrs,
+	})
+
+	connector := m.(ibclient.IBConnector)
+	ref, err := connector.CreateObject(zone)
+	if err != nil {
+		return fmt.Errorf("creation of the delegated zone '%s' under DNS view '%s' failed: %w", fqdn, dnsView, err)
+	}
+	d.SetId(ref)
+
+	return nil
+}
+
+func resourceZoneDelegatedRead(d *schema.ResourceData, m interface{}) error {
+	connector := m.(ibclient.IBConnector)
+
+	obj := newZoneDelegated(zoneDelegated{})
+	if err := connector.GetObject(obj, d.Id(), ibclient.NewQueryParams(false, nil), obj); err != nil {
+		return fmt.Errorf("failed getting the delegated zone: %w", err)
+	}
+
+	ttl := int(obj.DelegatedTtl)
+	if !obj.UseDelegatedTtl {
+		ttl = ttlUndef
+	}
+	if err := d.Set("delegated_ttl", ttl); err != nil {
+		return err
+	}
+
+	if obj.Ea != nil && len(obj.Ea) > 0 {
+		// TODO: temporary scaffold, need to rework marshalling/unmarshalling of EAs
+		//       (avoiding additional layer of keys ("value" key)
+		eaMap := (map[string]interface{})(obj.Ea)
+		ea, err := json.Marshal(eaMap)
+		if err != nil {
+			return err
+		}
+		if err = d.Set("ext_attrs", string(ea)); err != nil {
+			return err
+		}
+	}
+
+	if err := d.Set("fqdn", obj.Fqdn); err != nil {
+		return err
+	}
+	if err := d.Set("view", obj.View); err != nil {
+		return err
+	}
+	if err := d.Set("delegate_to", convertNameServersToInterface(obj.DelegateTo)); err != nil {
+		return err
+	}
+	if err := d.Set("locked", obj.Locked); err != nil {
+		return err
+	}
+	if err := d.Set("comment", obj.Comment); err != nil {
+		return err
+	}
+
+	d.SetId(obj.Ref)
+
+	return nil
+}
+
+func resourceZoneDelegatedUpdate(d *schema.ResourceData, m interface{}) error {
+	var updateSuccessful bool
+	defer func() {
+		// Reverting the state back, in case of a failure,
+		// otherwise Terraform will keep the values, which leaded to the failure,
+		// in the state file.
+		if !updateSuccessful {
+			prevFQDN, _ := d.GetChange("fqdn")
+			prevView, _ := d.GetChange("view")
+			prevDelegateTo, _ := d.GetChange("delegate_to")
+			prevTTL, _ := d.GetChange("delegated_ttl")
+			prevLocked, _ := d.GetChange("locked")
+			prevComment, _ := d.GetChange("comment")
+			prevEa, _ := d.GetChange("ext_attrs")
+
+			_ = d.Set("fqdn", prevFQDN.(string))
+			_ = d.Set("view", prevView.(string))
+			_ = d.Set("delegate_to", prevDelegateTo.([]interface{}))
+			_ = d.Set("delegated_ttl", prevTTL.(int))
+			_ = d.Set("locked", prevLocked.(bool))
+			_ = d.Set("comment", prevComment.(string))
+			_ = d.Set("ext_attrs", prevEa.(string))
+		}
+	}()
+
+	if d.HasChange("fqdn") {
+		return fmt.Errorf("changing the value of 'fqdn' field is not allowed")
+	}
+	if d.HasChange("view") {
+		return fmt.Errorf("changing the value of 'view' field is not allowed")
+	}
+
+	delegateTo := convertInterfaceToNameServers(d.Get("delegate_to").([]interface{}))
+	if len(delegateTo) == 0 {
+		return fmt.Errorf("at least one name server must be defined in 'delegate_to'")
+	}
+
+	var ttl uint32
+	useTtl := false
+	tempTTL := d.Get("delegated_ttl").(int)
+	if tempTTL >= 0 {
+		useTtl = true
+		ttl = uint32(tempTTL)
+	} else if tempTTL != ttlUndef {
+		return fmt.Errorf("TTL value must be 0 or higher")
+	}
+
+	locked := d.Get("locked").(bool)
+	comment := d.Get("comment").(string)
+
+	extAttrJSON := d.Get("ext_attrs").(string)
+	extAttrs := make(map[string]interface{})
+	if extAttrJSON != "" {
+		if err := json.Unmarshal([]byte(extAttrJSON), &extAttrs); err != nil {
+			return fmt.Errorf("cannot process 'ext_attrs' field: %w", err)
+		}
+	}
+
+	zone := newZoneDelegated(zoneDelegated{
+		DelegateTo:      delegateTo,
+		DelegatedTtl:    ttl,
+		UseDelegatedTtl: useTtl,
+		Locked:          locked,
+		Comment:         comment,
+		Ea:              extAttrs,
+	})
+
+	connector := m.(ibclient.IBConnector)
+	ref, err := connector.UpdateObject(zone, d.Id())
+	if err != nil {
+		return fmt.Errorf("error updating the delegated zone: %w", err)
+	}
+	updateSuccessful = true
+	d.SetId(ref)
+
+	return nil
+}
+
+func resourceZoneDelegatedDelete(d *schema.ResourceData, m interface{}) error {
+	connector := m.(ibclient.IBConnector)
+
+	if _, err := connector.DeleteObject(d.Id()); err != nil {
+		return fmt.Errorf("deletion of the delegated zone failed: %w", err)
+	}
+	d.SetId("")
+
+	return nil
+}
+
+// Besides a NIOS object's reference, the importer accepts an ID
+// in the form of 'fqdn/view' (or just 'fqdn' for the default DNS view).
+func zoneDelegatedImporter(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	id := d.Id()
+	if zoneDelegatedRefRegexp.MatchString(id) {
+		return []*schema.ResourceData{d}, nil
+	}
+
+	fqdn := id
+	dnsView := defaultDNSView
+	if sepIdx := strings.LastIndex(id, "/"); sepIdx > 0 {
+		// A reverse zone's FQDN contains a slash itself, as in '10.0.0.0/24'.
+		if _, _, err := net.ParseCIDR(id); err != nil {
+			fqdn = id[:sepIdx]
+			dnsView = id[sepIdx+1:]
+		}
+	}
+
+	var res []zoneDelegated
+	connector := m.(ibclient.IBConnector)
+	sf := map[string]string{
+		"fqdn": fqdn,
+		"view": dnsView,
+	}
+	err := connector.GetObject(newZoneDelegated(zoneDelegated{}), "", ibclient.NewQueryParams(false, sf), &res)
+	if err != nil {
+		return nil, fmt.Errorf("cannot find the delegated zone '%s' in DNS view '%s': %w", fqdn, dnsView, err)
+	}
+	if len(res) == 0 {
+		return nil, fmt.Errorf("cannot find the delegated zone '%s' in DNS view '%s'", fqdn, dnsView)
+	}
+	d.SetId(res[0].Ref)
+
+	return []*schema.ResourceData{d}, nil
+}
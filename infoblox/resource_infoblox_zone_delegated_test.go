@@ -0,0 +1,179 @@
+package infoblox
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+)
+
+func testAccCheckZoneDelegatedDestroy(s *terraform.State) error {
+	meta := testAccProvider.Meta()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "infoblox_zone_delegated" {
+			continue
+		}
+		connector := meta.(ibclient.IBConnector)
+		zone := newZoneDelegated(zoneDelegated{})
+		err := connector.GetObject(zone, rs.Primary.ID, ibclient.NewQueryParams(false, nil), zone)
+		if err == nil {
+			return fmt.Errorf("delegated zone still exists")
+		}
+	}
+	return nil
+}
+
+func testAccZoneDelegatedCompare(t *testing.T, resPath string, expectedZone *zoneDelegated) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		res, found := s.RootModule().Resources[resPath]
+		if !found {
+			return fmt.Errorf("not found: %s", resPath)
+		}
+		if res.Primary.ID == "" {
+			return fmt.Errorf("ID is not set")
+		}
+		meta := testAccProvider.Meta()
+		connector := meta.(ibclient.IBConnector)
+
+		zone := newZoneDelegated(zoneDelegated{})
+		if err := connector.GetObject(zone, res.Primary.ID, ibclient.NewQueryParams(false, nil), zone); err != nil {
+			return fmt.Errorf("delegated zone not found: %s", err)
+		}
+
+		if zone.Fqdn != expectedZone.Fqdn {
+			return fmt.Errorf(
+				"'fqdn' does not match: got '%s', expected '%s'",
+				zone.Fqdn, expectedZone.Fqdn)
+		}
+		if zone.View != expectedZone.View {
+			return fmt.Errorf(
+				"'view' does not match: got '%s', expected '%s'",
+				zone.View, expectedZone.View)
+		}
+		if len(zone.DelegateTo) != len(expectedZone.DelegateTo) {
+			return fmt.Errorf(
+				"the number of name servers does not match: got '%d', expected '%d'",
+				len(zone.DelegateTo), len(expectedZone.DelegateTo))
+		}
+		for i, srv := range expectedZone.DelegateTo {
+			if zone.DelegateTo[i] != srv {
+				return fmt.Errorf(
+					"name server does not match: got '%+v', expected '%+v'",
+					zone.DelegateTo[i], srv)
+			}
+		}
+		if zone.UseDelegatedTtl != expectedZone.UseDelegatedTtl {
+			return fmt.Errorf(
+				"TTL usage does not match: got '%t', expected '%t'",
+				zone.UseDelegatedTtl, expectedZone.UseDelegatedTtl)
+		}
+		if zone.UseDelegatedTtl {
+			if zone.DelegatedTtl != expectedZone.DelegatedTtl {
+				return fmt.Errorf(
+					"'delegated_ttl' does not match: got '%d', expected '%d'",
+					zone.DelegatedTtl, expectedZone.DelegatedTtl)
+			}
+		}
+		if zone.Locked != expectedZone.Locked {
+			return fmt.Errorf(
+				"'locked' does not match: got '%t', expected '%t'",
+				zone.Locked, expectedZone.Locked)
+		}
+		if zone.Comment != expectedZone.Comment {
+			return fmt.Errorf(
+				"'comment' does not match: got '%s', expected '%s'",
+				zone.Comment, expectedZone.Comment)
+		}
+		return validateEAs(zone.Ea, expectedZone.Ea)
+	}
+}
+
+func TestAccResourceZoneDelegated(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckZoneDelegatedDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "infoblox_zone_delegated" "foo"{
+						fqdn = "team1.test.com"
+						delegate_to {
+							name = "ns1.team1.test.com"
+							address = "10.1.0.1"
+						}
+					}`,
+				Check: resource.ComposeTestCheckFunc(
+					testAccZoneDelegatedCompare(t, "infoblox_zone_delegated.foo", &zoneDelegated{
+						Fqdn: "team1.test.com",
+						View: "default",
+						DelegateTo: []ibclient.NameServer{
+							{Name: "ns1.team1.test.com", Address: "10.1.0.1"},
+						},
+					}),
+				),
+			},
+			{
+				Config: `
+					resource "infoblox_zone_delegated" "foo"{
+						fqdn = "team1.test.com"
+						delegate_to {
+							name = "ns1.team1.test.com"
+							address = "10.1.0.1"
+						}
+						delegate_to {
+							name = "ns2.team1.test.com"
+							address = "10.1.0.2"
+						}
+						delegated_ttl = 3600
+						locked = true
+						comment = "test comment 1"
+						ext_attrs = jsonencode({
+							"Location" = "Los Angeles"
+						})
+					}`,
+				Check: resource.ComposeTestCheckFunc(
+					testAccZoneDelegatedCompare(t, "infoblox_zone_delegated.foo", &zoneDelegated{
+						Fqdn: "team1.test.com",
+						View: "default",
+						DelegateTo: []ibclient.NameServer{
+							{Name: "ns1.team1.test.com", Address: "10.1.0.1"},
+							{Name: "ns2.team1.test.com", Address: "10.1.0.2"},
+						},
+						DelegatedTtl:    3600,
+						UseDelegatedTtl: true,
+						Locked:          true,
+						Comment:         "test comment 1",
+						Ea: ibclient.EA{
+							"Location": "Los Angeles",
+						},
+					}),
+				),
+			},
+			{
+				ResourceName:      "infoblox_zone_delegated.foo",
+				ImportState:       true,
+				ImportStateId:     "team1.test.com/default",
+				ImportStateVerify: true,
+			},
+
+			// negative test cases
+			{
+				Config: `
+					resource "infoblox_zone_delegated" "foo"{
+						fqdn = "team1.test.com"
+						view = "nondefault_view"
+						delegate_to {
+							name = "ns1.team1.test.com"
+							address = "10.1.0.1"
+						}
+					}`,
+				ExpectError: regexp.MustCompile("changing the value of 'view' field is not allowed"),
+			},
+		},
+	})
+}
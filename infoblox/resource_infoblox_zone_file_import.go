@@ -0,0 +1,744 @@
+package infoblox
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+)
+
+// WAPI object types of the records which may be imported from a zone file.
+var zoneFileImportObjectTypes = map[string]string{
+	"A":     "record:a",
+	"AAAA":  "record:aaaa",
+	"CNAME": "record:cname",
+	"DNAME": "record:dname",
+	"PTR":   "record:ptr",
+	"MX":    "record:mx",
+	"SRV":   "record:srv",
+	"TXT":   "record:txt",
+	"CAA":   "record:caa",
+	"NAPTR": "record:naptr",
+}
+
+// The fields (besides 'name' and 'view') which are used to find an existing record,
+// matching a record of the zone file.
+var zoneFileImportSearchFields = map[string][]string{
+	"record:a":     {"ipv4addr"},
+	"record:aaaa":  {"ipv6addr"},
+	"record:cname": {"canonical"},
+	"record:dname": {"target"},
+	"record:ptr":   {"ptrdname"},
+	"record:mx":    {"mail_exchanger", "preference"},
+	"record:srv":   {"target", "port", "priority", "weight"},
+	"record:txt":   {"text"},
+	"record:caa":   {"ca_tag", "ca_value"},
+	"record:naptr": {"order", "preference", "replacement"},
+}
+
+// zoneFileImportRecord is a record of a zone file, converted to the form
+// in which it is created on NIOS side and tracked in the state.
+// An adopted record is the one which has existed before the import;
+// it is not deleted along with the imported ones.
+type zoneFileImportRecord struct {
+	recType    string
+	name       string
+	value      string
+	ttl        int
+	objectType string
+	fields     map[string]interface{}
+	ref        string
+	adopted    bool
+}
+
+func (rec *zoneFileImportRecord) key() string {
+	return fmt.Sprintf("%s %s %s", rec.recType, strings.ToLower(rec.name), rec.value)
+}
+
+// Returns the fields which define TTL of the record.
+func (rec *zoneFileImportRecord) ttlFields() map[string]interface{} {
+	if rec.ttl >= 0 {
+		return map[string]interface{}{"ttl": rec.ttl, "use_ttl": true}
+	}
+	return map[string]interface{}{"use_ttl": false}
+}
+
+// Returns all the fields of the record, to be sent to NIOS.
+func (rec *zoneFileImportRecord) allFields() map[string]interface{} {
+	res := make(map[string]interface{}, len(rec.fields)+2)
+	for k, v := range rec.fields {
+		res[k] = v
+	}
+	for k, v := range rec.ttlFields() {
+		res[k] = v
+	}
+
+	return res
+}
+
+func quoteZoneFileString(s string) string {
+	return `"` + strings.ReplaceAll(strings.ReplaceAll(s, `\`, `\\`), `"`, `\"`) + `"`
+}
+
+func parseZoneFileUint(value, fieldName string, maxValue uint64) (uint32, error) {
+	res, err := strconv.ParseUint(value, 10, 32)
+	if err != nil || res > maxValue {
+		return 0, fmt.Errorf("'%s' must be a number from 0 to %d", fieldName, maxValue)
+	}
+
+	return uint32(res), nil
+}
+
+// Converts a parsed record of a zone file into the form suitable for NIOS.
+func convertZoneFileRecord(rec zoneFileRecord) (*zoneFileImportRecord, error) {
+	objectType, found := zoneFileImportObjectTypes[rec.Type]
+	if !found {
+		return nil, fmt.Errorf("unsupported record type '%s'", rec.Type)
+	}
+
+	expectedNumFields := map[string]int{
+		"A": 1, "AAAA": 1, "CNAME": 1, "DNAME": 1, "PTR": 1,
+		"MX": 2, "SRV": 4, "CAA": 3, "NAPTR": 6,
+	}
+	if num, found := expectedNumFields[rec.Type]; found && len(rec.RData) != num {
+		return nil, fmt.Errorf("%s-record must have exactly %d data field(s)", rec.Type, num)
+	}
+	if len(rec.RData) == 0 {
+		return nil, fmt.Errorf("%s-record must have data", rec.Type)
+	}
+
+	res := &zoneFileImportRecord{
+		recType:    rec.Type,
+		name:       rec.Name,
+		ttl:        ttlUndef,
+		objectType: objectType,
+		fields:     map[string]interface{}{"name": rec.Name},
+	}
+	if rec.TTL != nil {
+		res.ttl = int(*rec.TTL)
+	}
+	qualify := func(name string) string {
+		return qualifyZoneFileName(name, rec.Origin)
+	}
+
+	var err error
+	var values []string
+	switch rec.Type {
+	case "A":
+		ip := net.ParseIP(rec.RData[0])
+		if ip == nil || ip.To4() == nil {
+			return nil, fmt.Errorf("'%s' is not a valid IPv4 address", rec.RData[0])
+		}
+		res.fields["ipv4addr"] = ip.String()
+		values = []string{ip.String()}
+	case "AAAA":
+		ip := net.ParseIP(rec.RData[0])
+		if ip == nil || ip.To4() != nil {
+			return nil, fmt.Errorf("'%s' is not a valid IPv6 address", rec.RData[0])
+		}
+		res.fields["ipv6addr"] = ip.String()
+		values = []string{ip.String()}
+	case "CNAME":
+		res.fields["canonical"] = qualify(rec.RData[0])
+		values = []string{qualify(rec.RData[0])}
+	case "DNAME":
+		res.fields["target"] = qualify(rec.RData[0])
+		values = []string{qualify(rec.RData[0])}
+	case "PTR":
+		res.fields["ptrdname"] = qualify(rec.RData[0])
+		values = []string{qualify(rec.RData[0])}
+	case "MX":
+		var preference uint32
+		if preference, err = parseZoneFileUint(rec.RData[0], "preference", 65535); err != nil {
+			return nil, err
+		}
+		res.fields["preference"] = preference
+		res.fields["mail_exchanger"] = qualify(rec.RData[1])
+		values = []string{rec.RData[0], qualify(rec.RData[1])}
+	case "SRV":
+		for i, fieldName := range []string{"priority", "weight", "port"} {
+			var val uint32
+			if val, err = parseZoneFileUint(rec.RData[i], fieldName, 65535); err != nil {
+				return nil, err
+			}
+			res.fields[fieldName] = val
+		}
+		res.fields["target"] = qualify(rec.RData[3])
+		values = []string{rec.RData[0], rec.RData[1], rec.RData[2], qualify(rec.RData[3])}
+	case "TXT":
+		text := rec.RData[0]
+		if len(rec.RData) > 1 {
+			quoted := make([]string, 0, len(rec.RData))
+			for _, s := range rec.RData {
+				quoted = append(quoted, quoteZoneFileString(s))
+			}
+			text = strings.Join(quoted, " ")
+		}
+		res.fields["text"] = text
+		values = []string{text}
+	case "CAA":
+		var flag uint32
+		if flag, err = parseZoneFileUint(rec.RData[0], "flags", 255); err != nil {
+			return nil, err
+		}
+		res.fields["ca_flag"] = flag
+		res.fields["ca_tag"] = rec.RData[1]
+		res.fields["ca_value"] = rec.RData[2]
+		values = []string{rec.RData[0], rec.RData[1], quoteZoneFileString(rec.RData[2])}
+	case "NAPTR":
+		for i, fieldName := range []string{"order", "preference"} {
+			var val uint32
+			if val, err = parseZoneFileUint(rec.RData[i], fieldName, 65535); err != nil {
+				return nil, err
+			}
+			res.fields[fieldName] = val
+		}
+		replacement := qualify(rec.RData[5])
+		res.fields["flags"] = strings.ToUpper(rec.RData[2])
+		res.fields["services"] = rec.RData[3]
+		res.fields["regexp"] = rec.RData[4]
+		res.fields["replacement"] = replacement
+		values = []string{
+			rec.RData[0], rec.RData[1],
+			quoteZoneFileString(strings.ToUpper(rec.RData[2])),
+			quoteZoneFileString(rec.RData[3]),
+			quoteZoneFileString(rec.RData[4]),
+			replacement,
+		}
+	}
+	res.value = strings.Join(values, " ")
+
+	return res, nil
+}
+
+// Parses the zone file and converts its records. The records which
+// cannot be imported are returned as problems, as well as the lines
+// which cannot be parsed.
+func buildZoneFileImportRecords(content, zone string) ([]*zoneFileImportRecord, []zoneFileProblem) {
+	zone = strings.TrimSuffix(zone, ".")
+	parsed, problems := parseZoneFile(content, zone)
+
+	var res []*zoneFileImportRecord
+	keys := make(map[string]bool)
+	for _, rec := range parsed {
+		problem := func(reason string) {
+			problems = append(problems, zoneFileProblem{
+				Line:   rec.Line,
+				Text:   fmt.Sprintf("%s %s %s", rec.Name, rec.Type, strings.Join(rec.RData, " ")),
+				Reason: reason,
+			})
+		}
+
+		lowerName := strings.ToLower(rec.Name)
+		lowerZone := strings.ToLower(zone)
+		if lowerName != lowerZone && !strings.HasSuffix(lowerName, "."+lowerZone) {
+			problem(fmt.Sprintf("the record does not belong to the zone '%s'", zone))
+			continue
+		}
+
+		switch rec.Type {
+		case "SOA":
+			// The zone's SOA-record is maintained by NIOS.
+			continue
+		case "NS":
+			problem("NS-records are not imported, name servers of the zone are defined" +
+				" by the zone's settings and delegations by 'infoblox_zone_delegated' resource")
+			continue
+		}
+
+		converted, err := convertZoneFileRecord(rec)
+		if err != nil {
+			problem(err.Error())
+			continue
+		}
+		if keys[converted.key()] {
+			problem("duplicate record")
+			continue
+		}
+		keys[converted.key()] = true
+		res = append(res, converted)
+	}
+	sort.SliceStable(problems, func(i, j int) bool {
+		return problems[i].Line < problems[j].Line
+	})
+
+	return res, problems
+}
+
+func zoneFileImportRecordSchemaElem() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The type of the record, ex. 'A', 'MX'.",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The FQDN of the record.",
+			},
+			"value": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The data of the record, in the zone file's format.",
+			},
+			"ttl": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "TTL value of the record; the minimal int32 value means that the record inherits the zone's TTL.",
+			},
+			"id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The reference of the record on NIOS side.",
+			},
+			"adopted": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "The flag which shows that the record has existed before the import; such a record is not deleted along with the imported ones.",
+			},
+		},
+	}
+}
+
+func convertZoneFileImportRecordsToInterface(records []*zoneFileImportRecord) []map[string]interface{} {
+	res := make([]map[string]interface{}, 0, len(records))
+	for _, rec := range records {
+		res = append(res, map[string]interface{}{
+			"type":    rec.recType,
+			"name":    rec.name,
+			"value":   rec.value,
+			"ttl":     rec.ttl,
+			"id":      rec.ref,
+			"adopted": rec.adopted,
+		})
+	}
+
+	return res
+}
+
+func convertInterfaceToZoneFileImportRecords(records []interface{}) []*zoneFileImportRecord {
+	res := make([]*zoneFileImportRecord, 0, len(records))
+	for _, item := range records {
+		rec := item.(map[string]interface{})
+		recType := rec["type"].(string)
+		res = append(res, &zoneFileImportRecord{
+			recType:    recType,
+			name:       rec["name"].(string),
+			value:      rec["value"].(string),
+			ttl:        rec["ttl"].(int),
+			ref:        rec["id"].(string),
+			adopted:    rec["adopted"].(bool),
+			objectType: zoneFileImportObjectTypes[recType],
+		})
+	}
+
+	return res
+}
+
+func convertZoneFileProblemsToDiags(problems []zoneFileProblem) diag.Diagnostics {
+	var diags diag.Diagnostics
+	for _, p := range problems {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("the record at line %d of the zone file is not imported", p.Line),
+			Detail:   p.String(),
+		})
+	}
+
+	return diags
+}
+
+func convertZoneFileProblemsToInterface(problems []zoneFileProblem) []string {
+	res := make([]string, 0, len(problems))
+	for _, p := range problems {
+		res = append(res, p.String())
+	}
+
+	return res
+}
+
+// Looks for an existing record which is the same as the given one,
+// in order to adopt it instead of creating a duplicate.
+func findZoneFileImportRecord(
+	connector ibclient.IBConnector, dnsView string, rec *zoneFileImportRecord) (string, error) {
+
+	sf := map[string]string{
+		"name": rec.name,
+		"view": dnsView,
+	}
+	for _, fieldName := range zoneFileImportSearchFields[rec.objectType] {
+		sf[fieldName] = fmt.Sprint(rec.fields[fieldName])
+	}
+	returnFields := make([]string, 0, len(rec.fields))
+	for fieldName := range rec.fields {
+		returnFields = append(returnFields, fieldName)
+	}
+
+	var res []genericRecord
+	err := connector.GetObject(
+		newGenericRecord(rec.objectType, returnFields, nil), "", ibclient.NewQueryParams(false, sf), &res)
+	if err != nil {
+		if isNotFoundError(err) {
+			return "", nil
+		}
+		return "", err
+	}
+
+	for _, candidate := range res {
+		matches := true
+		for fieldName, value := range rec.fields {
+			if !strings.EqualFold(fmt.Sprint(candidate.Fields[fieldName]), fmt.Sprint(value)) {
+				matches = false
+				break
+			}
+		}
+		if matches {
+			return candidate.Ref, nil
+		}
+	}
+
+	return "", nil
+}
+
+// Brings the records on NIOS side in accordance with the desired ones:
+// deletes the records which are not desired anymore (the adopted ones are just released),
+// updates TTL of the existing ones and creates (or adopts the already existing matching ones) the new ones.
+// Returns the list of the records which are under control after the operation,
+// even in case of an error.
+func reconcileZoneFileImportRecords(
+	connector ibclient.IBConnector,
+	dnsView string,
+	current, desired []*zoneFileImportRecord) ([]*zoneFileImportRecord, error) {
+
+	currentByKey := make(map[string]*zoneFileImportRecord, len(current))
+	for _, rec := range current {
+		currentByKey[rec.key()] = rec
+	}
+	desiredKeys := make(map[string]bool, len(desired))
+	for _, rec := range desired {
+		desiredKeys[rec.key()] = true
+	}
+
+	var res []*zoneFileImportRecord
+	for i, rec := range current {
+		if desiredKeys[rec.key()] || rec.adopted {
+			continue
+		}
+		if _, err := connector.DeleteObject(rec.ref); err != nil && !isNotFoundError(err) {
+			for _, rest := range current[i:] {
+				if !desiredKeys[rest.key()] {
+					res = append(res, rest)
+				}
+			}
+			for _, rest := range desired {
+				if cur, found := currentByKey[rest.key()]; found {
+					res = append(res, cur)
+				}
+			}
+			return res, fmt.Errorf(
+				"deletion of %s-record '%s' failed: %w", rec.recType, rec.name, err)
+		}
+	}
+
+	for i, rec := range desired {
+		var err error
+		cur, found := currentByKey[rec.key()]
+		switch {
+		case found && cur.ttl == rec.ttl:
+			rec.ref = cur.ref
+			rec.adopted = cur.adopted
+		case found:
+			rec.adopted = cur.adopted
+			rec.ref, err = connector.UpdateObject(
+				newGenericRecord(rec.objectType, nil, rec.ttlFields()), cur.ref)
+			if err != nil {
+				rec.ref = cur.ref
+				rec.ttl = cur.ttl
+				err = fmt.Errorf("update of %s-record '%s' failed: %w", rec.recType, rec.name, err)
+			}
+		default:
+			var existingRef string
+			existingRef, err = findZoneFileImportRecord(connector, dnsView, rec)
+			if err != nil {
+				err = fmt.Errorf("search for %s-record '%s' failed: %w", rec.recType, rec.name, err)
+				break
+			}
+			if existingRef != "" {
+				rec.adopted = true
+				rec.ref, err = connector.UpdateObject(
+					newGenericRecord(rec.objectType, nil, rec.allFields()), existingRef)
+				if err != nil {
+					err = fmt.Errorf("update of %s-record '%s' failed: %w", rec.recType, rec.name, err)
+				}
+				break
+			}
+			fields := rec.allFields()
+			fields["view"] = dnsView
+			rec.ref, err = connector.CreateObject(newGenericRecord(rec.objectType, nil, fields))
+			if err != nil {
+				err = fmt.Errorf("creation of %s-record '%s' failed: %w", rec.recType, rec.name, err)
+			}
+		}
+
+		if rec.ref != "" {
+			res = append(res, rec)
+		}
+		if err != nil {
+			for _, rest := range desired[i+1:] {
+				if cur, found := currentByKey[rest.key()]; found {
+					res = append(res, cur)
+				}
+			}
+			return res, err
+		}
+	}
+
+	return res, nil
+}
+
+// Returns true if the records in the state differ from the desired ones.
+func zoneFileImportRecordsDiffer(current, desired []*zoneFileImportRecord) bool {
+	if len(current) != len(desired) {
+		return true
+	}
+	currentTTLs := make(map[string]int, len(current))
+	for _, rec := range current {
+		currentTTLs[rec.key()] = rec.ttl
+	}
+	for _, rec := range desired {
+		ttl, found := currentTTLs[rec.key()]
+		if !found || ttl != rec.ttl {
+			return true
+		}
+	}
+
+	return false
+}
+
+func resourceZoneFileImport() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceZoneFileImportCreate,
+		ReadContext:   resourceZoneFileImportRead,
+		UpdateContext: resourceZoneFileImportUpdate,
+		DeleteContext: resourceZoneFileImportDelete,
+		CustomizeDiff: resourceZoneFileImportCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"zone": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The FQDN of the authoritative zone to import the records into.",
+			},
+			"dns_view": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     defaultDNSView,
+				Description: "The DNS view which the zone belongs to.",
+			},
+			"content": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The content of the zone file, in RFC 1035 format.",
+			},
+			"records": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        zoneFileImportRecordSchemaElem(),
+				Description: "The records which are imported from the zone file.",
+			},
+			"unsupported_records": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The records (and lines) of the zone file which are not imported, with the reasons.",
+			},
+		},
+	}
+}
+
+func getZoneAuthRef(connector ibclient.IBConnector, zone, dnsView string) (string, error) {
+	var res []zoneAuth
+	sf := map[string]string{
+		"fqdn": zone,
+		"view": dnsView,
+	}
+	if err := connector.GetObject(newZoneAuth(zoneAuth{}), "", ibclient.NewQueryParams(false, sf), &res); err != nil {
+		return "", err
+	}
+	if len(res) == 0 {
+		return "", ibclient.NewNotFoundError("zone not found")
+	}
+
+	return res[0].Ref, nil
+}
+
+func resourceZoneFileImportCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	connector := m.(ibclient.IBConnector)
+
+	zone := strings.TrimSuffix(d.Get("zone").(string), ".")
+	dnsView := d.Get("dns_view").(string)
+	zoneRef, err := getZoneAuthRef(connector, zone, dnsView)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf(
+			"failed getting the zone '%s' under DNS view '%s': %w", zone, dnsView, err))
+	}
+
+	desired, problems := buildZoneFileImportRecords(d.Get("content").(string), zone)
+	diags := convertZoneFileProblemsToDiags(problems)
+	if err = d.Set("unsupported_records", convertZoneFileProblemsToInterface(problems)); err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+
+	// The ID is set before the records are created, to keep track
+	// of the records which are created before a failure.
+	d.SetId(zoneRef)
+	records, err := reconcileZoneFileImportRecords(connector, dnsView, nil, desired)
+	if setErr := d.Set("records", convertZoneFileImportRecordsToInterface(records)); setErr != nil {
+		return append(diags, diag.FromErr(setErr)...)
+	}
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+
+	return append(diags, resourceZoneFileImportRead(ctx, d, m)...)
+}
+
+func resourceZoneFileImportRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	connector := m.(ibclient.IBConnector)
+
+	zone := newZoneAuth(zoneAuth{})
+	if err := connector.GetObject(zone, d.Id(), ibclient.NewQueryParams(false, nil), zone); err != nil {
+		if isNotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("failed getting the zone: %w", err))
+	}
+
+	current := convertInterfaceToZoneFileImportRecords(d.Get("records").([]interface{}))
+	records := make([]*zoneFileImportRecord, 0, len(current))
+	for _, rec := range current {
+		var obj genericRecord
+		err := connector.GetObject(
+			newGenericRecord(rec.objectType, []string{"ttl", "use_ttl"}, nil),
+			rec.ref, ibclient.NewQueryParams(false, nil), &obj)
+		if err != nil {
+			// The record which is deleted outside of Terraform will be created again.
+			if isNotFoundError(err) {
+				continue
+			}
+			return diag.FromErr(fmt.Errorf(
+				"failed getting %s-record '%s': %w", rec.recType, rec.name, err))
+		}
+
+		rec.ttl = ttlUndef
+		if useTtl, _ := obj.Fields["use_ttl"].(bool); useTtl {
+			if ttl, ok := obj.Fields["ttl"].(float64); ok {
+				rec.ttl = int(ttl)
+			}
+		}
+		records = append(records, rec)
+	}
+	if err := d.Set("records", convertZoneFileImportRecordsToInterface(records)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceZoneFileImportCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if d.Id() == "" {
+		return nil
+	}
+	if !d.NewValueKnown("content") || d.HasChange("content") {
+		if err := d.SetNewComputed("unsupported_records"); err != nil {
+			return err
+		}
+		return d.SetNewComputed("records")
+	}
+
+	// The records which are changed or deleted outside of Terraform
+	// must be brought back in accordance with the zone file.
+	desired, _ := buildZoneFileImportRecords(d.Get("content").(string), d.Get("zone").(string))
+	current := convertInterfaceToZoneFileImportRecords(d.Get("records").([]interface{}))
+	if zoneFileImportRecordsDiffer(current, desired) {
+		return d.SetNewComputed("records")
+	}
+
+	return nil
+}
+
+func resourceZoneFileImportUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var updateSuccessful bool
+	defer func() {
+		// Reverting the state back, in case of a failure,
+		// otherwise Terraform will keep the values, which leaded to the failure,
+		// in the state file.
+		if !updateSuccessful {
+			prevContent, _ := d.GetChange("content")
+			_ = d.Set("content", prevContent.(string))
+		}
+	}()
+
+	if d.HasChange("zone") {
+		return diag.Errorf("changing the value of 'zone' field is not allowed")
+	}
+	if d.HasChange("dns_view") {
+		return diag.Errorf("changing the value of 'dns_view' field is not allowed")
+	}
+
+	connector := m.(ibclient.IBConnector)
+
+	zone := strings.TrimSuffix(d.Get("zone").(string), ".")
+	dnsView := d.Get("dns_view").(string)
+	desired, problems := buildZoneFileImportRecords(d.Get("content").(string), zone)
+	diags := convertZoneFileProblemsToDiags(problems)
+	if err := d.Set("unsupported_records", convertZoneFileProblemsToInterface(problems)); err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+
+	prevRecords, _ := d.GetChange("records")
+	current := convertInterfaceToZoneFileImportRecords(prevRecords.([]interface{}))
+	records, err := reconcileZoneFileImportRecords(connector, dnsView, current, desired)
+	if setErr := d.Set("records", convertZoneFileImportRecordsToInterface(records)); setErr != nil {
+		return append(diags, diag.FromErr(setErr)...)
+	}
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+
+	updateSuccessful = true
+
+	return append(diags, resourceZoneFileImportRead(ctx, d, m)...)
+}
+
+func resourceZoneFileImportDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	connector := m.(ibclient.IBConnector)
+
+	// The adopted records have existed before the import, thus they are kept.
+	current := convertInterfaceToZoneFileImportRecords(d.Get("records").([]interface{}))
+	for i, rec := range current {
+		if rec.adopted {
+			continue
+		}
+		if _, err := connector.DeleteObject(rec.ref); err != nil && !isNotFoundError(err) {
+			if setErr := d.Set("records", convertZoneFileImportRecordsToInterface(current[i:])); setErr != nil {
+				return diag.FromErr(setErr)
+			}
+			return diag.FromErr(fmt.Errorf(
+				"deletion of %s-record '%s' failed: %w", rec.recType, rec.name, err))
+		}
+	}
+	d.SetId("")
+
+	return nil
+}
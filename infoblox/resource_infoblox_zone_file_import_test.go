@@ -0,0 +1,265 @@
+package infoblox
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+)
+
+func getZoneFileImportRecords(s *terraform.State, resPath string) ([]*zoneFileImportRecord, error) {
+	res, found := s.RootModule().Resources[resPath]
+	if !found {
+		return nil, fmt.Errorf("not found: %s", resPath)
+	}
+
+	num, err := strconv.Atoi(res.Primary.Attributes["records.#"])
+	if err != nil {
+		return nil, err
+	}
+	records := make([]*zoneFileImportRecord, 0, num)
+	for i := 0; i < num; i++ {
+		prefix := fmt.Sprintf("records.%d.", i)
+		ttl, err := strconv.Atoi(res.Primary.Attributes[prefix+"ttl"])
+		if err != nil {
+			return nil, err
+		}
+		recType := res.Primary.Attributes[prefix+"type"]
+		records = append(records, &zoneFileImportRecord{
+			recType:    recType,
+			name:       res.Primary.Attributes[prefix+"name"],
+			value:      res.Primary.Attributes[prefix+"value"],
+			ttl:        ttl,
+			ref:        res.Primary.Attributes[prefix+"id"],
+			adopted:    res.Primary.Attributes[prefix+"adopted"] == "true",
+			objectType: zoneFileImportObjectTypes[recType],
+		})
+	}
+
+	return records, nil
+}
+
+func testAccCheckZoneFileImportDestroy(s *terraform.State) error {
+	meta := testAccProvider.Meta()
+	connector := meta.(ibclient.IBConnector)
+
+	for resPath, rs := range s.RootModule().Resources {
+		if rs.Type != "infoblox_zone_file_import" {
+			continue
+		}
+		records, err := getZoneFileImportRecords(s, resPath)
+		if err != nil {
+			continue
+		}
+		for _, rec := range records {
+			if rec.adopted {
+				continue
+			}
+			var obj genericRecord
+			err := connector.GetObject(
+				newGenericRecord(rec.objectType, nil, nil), rec.ref, ibclient.NewQueryParams(false, nil), &obj)
+			if err == nil {
+				return fmt.Errorf("%s-record '%s' still exists", rec.recType, rec.name)
+			}
+		}
+	}
+	return testAccCheckZoneAuthDestroy(s)
+}
+
+// Checks that the records in the state are the expected ones (in any order)
+// and exist on NIOS side with the expected TTL.
+func testAccZoneFileImportCompare(t *testing.T, resPath string, expectedRecords map[string]int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		records, err := getZoneFileImportRecords(s, resPath)
+		if err != nil {
+			return err
+		}
+		if len(records) != len(expectedRecords) {
+			return fmt.Errorf(
+				"the number of records does not match: got '%d', expected '%d'",
+				len(records), len(expectedRecords))
+		}
+
+		meta := testAccProvider.Meta()
+		connector := meta.(ibclient.IBConnector)
+
+		for _, rec := range records {
+			expectedTTL, found := expectedRecords[rec.key()]
+			if !found {
+				return fmt.Errorf("unexpected record: '%s'", rec.key())
+			}
+			if rec.ttl != expectedTTL {
+				return fmt.Errorf(
+					"TTL of '%s' does not match: got '%d', expected '%d'",
+					rec.key(), rec.ttl, expectedTTL)
+			}
+
+			var obj genericRecord
+			err := connector.GetObject(
+				newGenericRecord(rec.objectType, []string{"name", "ttl", "use_ttl"}, nil),
+				rec.ref, ibclient.NewQueryParams(false, nil), &obj)
+			if err != nil {
+				return fmt.Errorf("record '%s' not found: %s", rec.key(), err)
+			}
+			if obj.Fields["name"] != rec.name {
+				return fmt.Errorf(
+					"'name' does not match: got '%v', expected '%s'",
+					obj.Fields["name"], rec.name)
+			}
+			useTtl, _ := obj.Fields["use_ttl"].(bool)
+			if useTtl != (expectedTTL != ttlUndef) {
+				return fmt.Errorf(
+					"'use_ttl' of '%s' does not match: got '%t'", rec.key(), useTtl)
+			}
+		}
+		return nil
+	}
+}
+
+var testAccZoneFileImportZoneConfig = `
+resource "infoblox_zone_auth" "zone" {
+	fqdn = "zone-file-import.test.com"
+}
+`
+
+func TestAccResourceZoneFileImport(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckZoneFileImportDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccZoneFileImportZoneConfig + `
+					resource "infoblox_zone_file_import" "foo" {
+						zone = infoblox_zone_auth.zone.fqdn
+						content = <<-EOT
+							$ORIGIN zone-file-import.test.com.
+							@       IN SOA ns1 admin 1 3600 600 86400 300
+							www     300 IN A 10.0.0.1
+							        IN AAAA 2001:db8::1
+							alias   CNAME www
+							@       MX 10 mail.test.com.
+							info    TXT "some text"
+							unknown HINFO "PC" "Linux"
+						EOT
+					}`,
+				Check: resource.ComposeTestCheckFunc(
+					testAccZoneFileImportCompare(t, "infoblox_zone_file_import.foo", map[string]int{
+						"A www.zone-file-import.test.com 10.0.0.1":                            300,
+						"AAAA www.zone-file-import.test.com 2001:db8::1":                      ttlUndef,
+						"CNAME alias.zone-file-import.test.com www.zone-file-import.test.com": ttlUndef,
+						"MX zone-file-import.test.com 10 mail.test.com":                       ttlUndef,
+						"TXT info.zone-file-import.test.com some text":                        ttlUndef,
+					}),
+					resource.TestCheckResourceAttr("infoblox_zone_file_import.foo", "unsupported_records.#", "1"),
+				),
+			},
+			{
+				Config: testAccZoneFileImportZoneConfig + `
+					resource "infoblox_zone_file_import" "foo" {
+						zone = infoblox_zone_auth.zone.fqdn
+						content = <<-EOT
+							$TTL 600
+							www     300 IN A 10.0.0.1
+							www     IN A 10.0.0.2
+							alias   CNAME other.test.com.
+							@       MX 10 mail.test.com.
+						EOT
+					}`,
+				Check: resource.ComposeTestCheckFunc(
+					testAccZoneFileImportCompare(t, "infoblox_zone_file_import.foo", map[string]int{
+						"A www.zone-file-import.test.com 10.0.0.1":             300,
+						"A www.zone-file-import.test.com 10.0.0.2":             600,
+						"CNAME alias.zone-file-import.test.com other.test.com": 600,
+						"MX zone-file-import.test.com 10 mail.test.com":        600,
+					}),
+					resource.TestCheckResourceAttr("infoblox_zone_file_import.foo", "unsupported_records.#", "0"),
+				),
+			},
+
+			// negative test cases
+			{
+				Config: testAccZoneFileImportZoneConfig + `
+					resource "infoblox_zone_file_import" "foo" {
+						zone = infoblox_zone_auth.zone.fqdn
+						dns_view = "nondefault_view"
+						content = "www A 10.0.0.1"
+					}`,
+				ExpectError: regexp.MustCompile("changing the value of 'dns_view' field is not allowed"),
+			},
+		},
+	})
+}
+
+func TestAccResourceZoneFileImport_adopted(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckZoneFileImportDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccZoneFileImportZoneConfig + `
+					resource "infoblox_a_record" "existing" {
+						fqdn = "app.zone-file-import.test.com"
+						ip_addr = "10.0.0.5"
+						depends_on = [infoblox_zone_auth.zone]
+					}
+
+					resource "infoblox_zone_file_import" "foo" {
+						zone = infoblox_zone_auth.zone.fqdn
+						content = <<-EOT
+							$ORIGIN zone-file-import.test.com.
+							app     IN A 10.0.0.5
+							www     IN A 10.0.0.1
+						EOT
+						depends_on = [infoblox_a_record.existing]
+					}`,
+				Check: resource.ComposeTestCheckFunc(
+					testAccZoneFileImportCompare(t, "infoblox_zone_file_import.foo", map[string]int{
+						"A app.zone-file-import.test.com 10.0.0.5": ttlUndef,
+						"A www.zone-file-import.test.com 10.0.0.1": ttlUndef,
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(
+						"infoblox_zone_file_import.foo", "records.*", map[string]string{
+							"name":    "app.zone-file-import.test.com",
+							"adopted": "true",
+						}),
+					resource.TestCheckTypeSetElemNestedAttrs(
+						"infoblox_zone_file_import.foo", "records.*", map[string]string{
+							"name":    "www.zone-file-import.test.com",
+							"adopted": "false",
+						}),
+				),
+			},
+			{
+				// The adopted record is released, not deleted.
+				Config: testAccZoneFileImportZoneConfig + `
+					resource "infoblox_a_record" "existing" {
+						fqdn = "app.zone-file-import.test.com"
+						ip_addr = "10.0.0.5"
+						depends_on = [infoblox_zone_auth.zone]
+					}
+
+					resource "infoblox_zone_file_import" "foo" {
+						zone = infoblox_zone_auth.zone.fqdn
+						content = "www.zone-file-import.test.com. IN A 10.0.0.1"
+						depends_on = [infoblox_a_record.existing]
+					}`,
+				Check: resource.ComposeTestCheckFunc(
+					testAccZoneFileImportCompare(t, "infoblox_zone_file_import.foo", map[string]int{
+						"A www.zone-file-import.test.com 10.0.0.1": ttlUndef,
+					}),
+					testAccARecordCompare(t, "infoblox_a_record.existing", &ibclient.RecordA{
+						Name:     "app.zone-file-import.test.com",
+						Ipv4Addr: "10.0.0.5",
+						View:     "default",
+					}, "", ""),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,334 @@
+package infoblox
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+)
+
+func resourceZoneForward() *schema.Resource {
+	return &schema.Resource{
+		Create:   resourceZoneForwardCreate,
+		Read:     resourceZoneForwardRead,
+		Update:   resourceZoneForwardUpdate,
+		Delete:   resourceZoneForwardDelete,
+		Importer: &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			"fqdn": {
+				Type:     schema.TypeString,
+				Required: true,
+				Description: "The name of the zone. For a forward zone this is an FQDN;" +
+					" for a reverse zone this is a network address in CIDR format.",
+			},
+			"view": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     defaultDNSView,
+				Description: "DNS view which the zone does exist within.",
+			},
+			"zone_format": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "FORWARD",
+				Description: "The format of the zone: 'FORWARD', 'IPV4' or 'IPV6'.",
+			},
+			"forward_to": {
+				Type:        schema.TypeList,
+				Required:    true,
+				Description: "The list of name servers which queries for the zone are forwarded to.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The FQDN of the name server.",
+						},
+						"address": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The IP address of the name server.",
+						},
+					},
+				},
+			},
+			"forwarding_servers": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "The list of grid members which forward queries for the zone.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The name of the grid member.",
+						},
+						"forwarders_only": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "If set, the member sends queries to forwarders only, not to other internal or Internet root servers.",
+						},
+						"forward_to": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Description: "The list of name servers which override the zone's 'forward_to' list for the member.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: "The FQDN of the name server.",
+									},
+									"address": {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: "The IP address of the name server.",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"forward_only": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "If set, queries for the zone are forwarded only, without attempting to resolve them when the forwarders do not respond.",
+			},
+			"comment": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "Description of the zone.",
+			},
+			"ext_attrs": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "Extensible attributes of the zone to be added/updated, as a map in JSON format.",
+			},
+		},
+	}
+}
+
+func convertForwardingServersToInterface(servers []forwardingMemberServer) []map[string]interface{} {
+	res := make([]map[string]interface{}, 0, len(servers))
+	for _, srv := range servers {
+		forwardTo := make([]map[string]interface{}, 0)
+		if srv.UseOverrideForwarders {
+			forwardTo = convertNameServersToInterface(srv.ForwardTo)
+		}
+		res = append(res, map[string]interface{}{
+			"name":            srv.Name,
+			"forwarders_only": srv.ForwardersOnly,
+			"forward_to":      forwardTo,
+		})
+	}
+
+	return res
+}
+
+func convertInterfaceToForwardingServers(servers []interface{}) []forwardingMemberServer {
+	res := make([]forwardingMemberServer, 0, len(servers))
+	for _, srvInf := range servers {
+		srvMap := srvInf.(map[string]interface{})
+		forwardTo := convertInterfaceToNameServers(srvMap["forward_to"].([]interface{}))
+		res = append(res, forwardingMemberServer{
+			Name:                  srvMap["name"].(string),
+			ForwardersOnly:        srvMap["forwarders_only"].(bool),
+			UseOverrideForwarders: len(forwardTo) > 0,
+			ForwardTo:             forwardTo,
+		})
+	}
+
+	return res
+}
+
+func resourceZoneForwardCreate(d *schema.ResourceData, m interface{}) error {
+	fqdn := d.Get("fqdn").(string)
+	if fqdn == "" {
+		return fmt.Errorf("'fqdn' must not be empty")
+	}
+
+	dnsView := d.Get("view").(string)
+	zoneFormat := d.Get("zone_format").(string)
+	switch zoneFormat {
+	case "FORWARD", "IPV4", "IPV6":
+	default:
+		return fmt.Errorf("'zone_format' must be one of 'FORWARD', 'IPV4' or 'IPV6'")
+	}
+
+	forwardTo := convertInterfaceToNameServers(d.Get("forward_to").([]interface{}))
+	forwardingServers := convertInterfaceToForwardingServers(d.Get("forwarding_servers").([]interface{}))
+	if len(forwardTo) == 0 {
+		return fmt.Errorf("at least one name server must be defined in 'forward_to'")
+	}
+
+	forwardOnly := d.Get("forward_only").(bool)
+	comment := d.Get("comment").(string)
+
+	extAttrJSON := d.Get("ext_attrs").(string)
+	extAttrs := make(map[string]interface{})
+	if extAttrJSON != "" {
+		if err := json.Unmarshal([]byte(extAttrJSON), &extAttrs); err != nil {
+			return fmt.Errorf("cannot process 'ext_attrs' field: %w", err)
+		}
+	}
+
+	zone := newZoneForward(zoneForward{
+		Fqdn:              fqdn,
+		View:              dnsView,
+		ZoneFormat:        zoneFormat,
+		ForwardTo:         forwardTo,
+		ForwardingServers: forwardingServers,
+		ForwardOnly:       forwardOnly,
+		Comment:           comment,
+		Ea:                extAttrs,
+	})
+
+	connector := m.(ibclient.IBConnector)
+	ref, err := connector.CreateObject(zone)
+	if err != nil {
+		return fmt.Errorf("creation of the forward zone '%s' under DNS view '%s' failed: %w", fqdn, dnsView, err)
+	}
+	d.SetId(ref)
+
+	return nil
+}
+
+func resourceZoneForwardRead(d *schema.ResourceData, m interface{}) error {
+	connector := m.(ibclient.IBConnector)
+
+	obj := newZoneForward(zoneForward{})
+	if err := connector.GetObject(obj, d.Id(), ibclient.NewQueryParams(false, nil), obj); err != nil {
+		return fmt.Errorf("failed getting the forward zone: %w", err)
+	}
+
+	if obj.Ea != nil && len(obj.Ea) > 0 {
+		// TODO: temporary scaffold, need to rework marshalling/unmarshalling of EAs
+		//       (avoiding additional layer of keys ("value" key)
+		eaMap := (map[string]interface{})(obj.Ea)
+		ea, err := json.Marshal(eaMap)
+		if err != nil {
+			return err
+		}
+		if err = d.Set("ext_attrs", string(ea)); err != nil {
+			return err
+		}
+	}
+
+	if err := d.Set("fqdn", obj.Fqdn); err != nil {
+		return err
+	}
+	if err := d.Set("view", obj.View); err != nil {
+		return err
+	}
+	if err := d.Set("zone_format", obj.ZoneFormat); err != nil {
+		return err
+	}
+	if err := d.Set("forward_to", convertNameServersToInterface(obj.ForwardTo)); err != nil {
+		return err
+	}
+	if err := d.Set("forwarding_servers", convertForwardingServersToInterface(obj.ForwardingServers)); err != nil {
+		return err
+	}
+	if err := d.Set("forward_only", obj.ForwardOnly); err != nil {
+		return err
+	}
+	if err := d.Set("comment", obj.Comment); err != nil {
+		return err
+	}
+
+	d.SetId(obj.Ref)
+
+	return nil
+}
+
+func resourceZoneForwardUpdate(d *schema.ResourceData, m interface{}) error {
+	var updateSuccessful bool
+	defer func() {
+		// Reverting the state back, in case of a failure,
+		// otherwise Terraform will keep the values, which leaded to the failure,
+		// in the state file.
+		if !updateSuccessful {
+			prevFQDN, _ := d.GetChange("fqdn")
+			prevView, _ := d.GetChange("view")
+			prevZoneFormat, _ := d.GetChange("zone_format")
+			prevForwardTo, _ := d.GetChange("forward_to")
+			prevForwardingServers, _ := d.GetChange("forwarding_servers")
+			prevForwardOnly, _ := d.GetChange("forward_only")
+			prevComment, _ := d.GetChange("comment")
+			prevEa, _ := d.GetChange("ext_attrs")
+
+			_ = d.Set("fqdn", prevFQDN.(string))
+			_ = d.Set("view", prevView.(string))
+			_ = d.Set("zone_format", prevZoneFormat.(string))
+			_ = d.Set("forward_to", prevForwardTo.([]interface{}))
+			_ = d.Set("forwarding_servers", prevForwardingServers.([]interface{}))
+			_ = d.Set("forward_only", prevForwardOnly.(bool))
+			_ = d.Set("comment", prevComment.(string))
+			_ = d.Set("ext_attrs", prevEa.(string))
+		}
+	}()
+
+	if d.HasChange("fqdn") {
+		return fmt.Errorf("changing the value of 'fqdn' field is not allowed")
+	}
+	if d.HasChange("view") {
+		return fmt.Errorf("changing the value of 'view' field is not allowed")
+	}
+	if d.HasChange("zone_format") {
+		return fmt.Errorf("changing the value of 'zone_format' field is not allowed")
+	}
+
+	forwardTo := convertInterfaceToNameServers(d.Get("forward_to").([]interface{}))
+	forwardingServers := convertInterfaceToForwardingServers(d.Get("forwarding_servers").([]interface{}))
+	if len(forwardTo) == 0 {
+		return fmt.Errorf("at least one name server must be defined in 'forward_to'")
+	}
+
+	forwardOnly := d.Get("forward_only").(bool)
+	comment := d.Get("comment").(string)
+
+	extAttrJSON := d.Get("ext_attrs").(string)
+	extAttrs := make(map[string]interface{})
+	if extAttrJSON != "" {
+		if err := json.Unmarshal([]byte(extAttrJSON), &extAttrs); err != nil {
+			return fmt.Errorf("cannot process 'ext_attrs' field: %w", err)
+		}
+	}
+
+	zone := newZoneForward(zoneForward{
+		ForwardTo:         forwardTo,
+		ForwardingServers: forwardingServers,
+		ForwardOnly:       forwardOnly,
+		Comment:           comment,
+		Ea:                extAttrs,
+	})
+
+	connector := m.(ibclient.IBConnector)
+	ref, err := connector.UpdateObject(zone, d.Id())
+	if err != nil {
+		return fmt.Errorf("error updating the forward zone: %w", err)
+	}
+	updateSuccessful = true
+	d.SetId(ref)
+
+	return nil
+}
+
+func resourceZoneForwardDelete(d *schema.ResourceData, m interface{}) error {
+	connector := m.(ibclient.IBConnector)
+
+	if _, err := connector.DeleteObject(d.Id()); err != nil {
+		return fmt.Errorf("deletion of the forward zone failed: %w", err)
+	}
+	d.SetId("")
+
+	return nil
+}
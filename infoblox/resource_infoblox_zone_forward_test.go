@@ -0,0 +1,181 @@
+package infoblox
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+)
+
+func testAccCheckZoneForwardDestroy(s *terraform.State) error {
+	meta := testAccProvider.Meta()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "infoblox_zone_forward" {
+			continue
+		}
+		connector := meta.(ibclient.IBConnector)
+		zone := newZoneForward(zoneForward{})
+		err := connector.GetObject(zone, rs.Primary.ID, ibclient.NewQueryParams(false, nil), zone)
+		if err == nil {
+			return fmt.Errorf("forward zone still exists")
+		}
+	}
+	return nil
+}
+
+func testAccZoneForwardCompare(t *testing.T, resPath string, expectedZone *zoneForward) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		res, found := s.RootModule().Resources[resPath]
+		if !found {
+			return fmt.Errorf("not found: %s", resPath)
+		}
+		if res.Primary.ID == "" {
+			return fmt.Errorf("ID is not set")
+		}
+		meta := testAccProvider.Meta()
+		connector := meta.(ibclient.IBConnector)
+
+		zone := newZoneForward(zoneForward{})
+		if err := connector.GetObject(zone, res.Primary.ID, ibclient.NewQueryParams(false, nil), zone); err != nil {
+			return fmt.Errorf("forward zone not found: %s", err)
+		}
+
+		if zone.Fqdn != expectedZone.Fqdn {
+			return fmt.Errorf(
+				"'fqdn' does not match: got '%s', expected '%s'",
+				zone.Fqdn, expectedZone.Fqdn)
+		}
+		if zone.View != expectedZone.View {
+			return fmt.Errorf(
+				"'view' does not match: got '%s', expected '%s'",
+				zone.View, expectedZone.View)
+		}
+		if len(zone.ForwardTo) != len(expectedZone.ForwardTo) {
+			return fmt.Errorf(
+				"the number of forwarders does not match: got '%d', expected '%d'",
+				len(zone.ForwardTo), len(expectedZone.ForwardTo))
+		}
+		for i, srv := range expectedZone.ForwardTo {
+			if zone.ForwardTo[i] != srv {
+				return fmt.Errorf(
+					"forwarder does not match: got '%+v', expected '%+v'",
+					zone.ForwardTo[i], srv)
+			}
+		}
+		if len(zone.ForwardingServers) != len(expectedZone.ForwardingServers) {
+			return fmt.Errorf(
+				"the number of forwarding servers does not match: got '%d', expected '%d'",
+				len(zone.ForwardingServers), len(expectedZone.ForwardingServers))
+		}
+		for i, srv := range expectedZone.ForwardingServers {
+			if zone.ForwardingServers[i].Name != srv.Name {
+				return fmt.Errorf(
+					"forwarding server's name does not match: got '%s', expected '%s'",
+					zone.ForwardingServers[i].Name, srv.Name)
+			}
+			if zone.ForwardingServers[i].ForwardersOnly != srv.ForwardersOnly {
+				return fmt.Errorf(
+					"'forwarders_only' does not match: got '%t', expected '%t'",
+					zone.ForwardingServers[i].ForwardersOnly, srv.ForwardersOnly)
+			}
+		}
+		if zone.ForwardOnly != expectedZone.ForwardOnly {
+			return fmt.Errorf(
+				"'forward_only' does not match: got '%t', expected '%t'",
+				zone.ForwardOnly, expectedZone.ForwardOnly)
+		}
+		if zone.Comment != expectedZone.Comment {
+			return fmt.Errorf(
+				"'comment' does not match: got '%s', expected '%s'",
+				zone.Comment, expectedZone.Comment)
+		}
+		return validateEAs(zone.Ea, expectedZone.Ea)
+	}
+}
+
+func TestAccResourceZoneForward(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckZoneForwardDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "infoblox_zone_forward" "foo"{
+						fqdn = "partner1.test.com"
+						forward_to {
+							name = "ns1.partner1.com"
+							address = "10.2.0.1"
+						}
+					}`,
+				Check: resource.ComposeTestCheckFunc(
+					testAccZoneForwardCompare(t, "infoblox_zone_forward.foo", &zoneForward{
+						Fqdn: "partner1.test.com",
+						View: "default",
+						ForwardTo: []ibclient.NameServer{
+							{Name: "ns1.partner1.com", Address: "10.2.0.1"},
+						},
+					}),
+				),
+			},
+			{
+				Config: `
+					resource "infoblox_zone_forward" "foo"{
+						fqdn = "partner1.test.com"
+						forward_to {
+							name = "ns1.partner1.com"
+							address = "10.2.0.1"
+						}
+						forward_to {
+							name = "ns2.partner1.com"
+							address = "10.2.0.2"
+						}
+						forwarding_servers {
+							name = "infoblox.localdomain"
+							forwarders_only = true
+						}
+						forward_only = true
+						comment = "test comment 1"
+						ext_attrs = jsonencode({
+							"Location" = "Los Angeles"
+						})
+					}`,
+				Check: resource.ComposeTestCheckFunc(
+					testAccZoneForwardCompare(t, "infoblox_zone_forward.foo", &zoneForward{
+						Fqdn: "partner1.test.com",
+						View: "default",
+						ForwardTo: []ibclient.NameServer{
+							{Name: "ns1.partner1.com", Address: "10.2.0.1"},
+							{Name: "ns2.partner1.com", Address: "10.2.0.2"},
+						},
+						ForwardingServers: []forwardingMemberServer{
+							{Name: "infoblox.localdomain", ForwardersOnly: true},
+						},
+						ForwardOnly: true,
+						Comment:     "test comment 1",
+						Ea: ibclient.EA{
+							"Location": "Los Angeles",
+						},
+					}),
+				),
+			},
+
+			// negative test cases
+			{
+				Config: `
+					resource "infoblox_zone_forward" "foo"{
+						fqdn = "partner2.test.com"
+						forward_to {
+							name = "ns1.partner1.com"
+							address = "10.2.0.1"
+						}
+					}`,
+				ExpectError: regexp.MustCompile("changing the value of 'fqdn' field is not allowed"),
+			},
+		},
+	})
+}
@@ -0,0 +1,321 @@
+package infoblox
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+)
+
+func resourceZoneRP() *schema.Resource {
+	return &schema.Resource{
+		Create:   resourceZoneRPCreate,
+		Read:     resourceZoneRPRead,
+		Update:   resourceZoneRPUpdate,
+		Delete:   resourceZoneRPDelete,
+		Importer: &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			"fqdn": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the response policy zone.",
+			},
+			"view": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     defaultDNSView,
+				Description: "DNS view which the zone does exist within.",
+			},
+			"rpz_policy": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "GIVEN",
+				Description: "The override policy of the zone: 'GIVEN' (the policy of every rule is applied)," +
+					" 'DISABLED', 'NODATA', 'NXDOMAIN', 'PASSTHRU' or 'SUBSTITUTE'.",
+			},
+			"rpz_severity": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "MAJOR",
+				Description: "The severity of the zone's rule hits: 'CRITICAL', 'MAJOR', 'WARNING' or 'INFORMATIONAL'.",
+			},
+			"substitute_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "The domain name which the answers are substituted with, for the 'SUBSTITUTE' override policy.",
+			},
+			"ns_group": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "The name server group which serves DNS for the zone. Must not be set together with 'grid_primary'.",
+			},
+			"grid_primary": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "The list of grid members which are primary name servers for the zone.",
+				Elem:        gridPrimarySchemaElem(),
+			},
+			"grid_secondaries": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "The list of grid members which are secondary name servers for the zone.",
+				Elem:        gridSecondariesSchemaElem(),
+			},
+			"comment": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "Description of the zone.",
+			},
+			"ext_attrs": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "Extensible attributes of the zone to be added/updated, as a map in JSON format.",
+			},
+		},
+	}
+}
+
+func validateZoneRPPolicy(policy, severity, substituteName string) error {
+	switch policy {
+	case "GIVEN", "DISABLED", "NODATA", "NXDOMAIN", "PASSTHRU":
+		if substituteName != "" {
+			return fmt.Errorf("'substitute_name' may be set only for 'SUBSTITUTE' value of 'rpz_policy'")
+		}
+	case "SUBSTITUTE":
+		if substituteName == "" {
+			return fmt.Errorf("'substitute_name' must not be empty for 'SUBSTITUTE' value of 'rpz_policy'")
+		}
+	default:
+		return fmt.Errorf(
+			"'rpz_policy' must be one of 'GIVEN', 'DISABLED', 'NODATA', 'NXDOMAIN', 'PASSTHRU' or 'SUBSTITUTE'")
+	}
+
+	switch severity {
+	case "CRITICAL", "MAJOR", "WARNING", "INFORMATIONAL":
+	default:
+		return fmt.Errorf("'rpz_severity' must be one of 'CRITICAL', 'MAJOR', 'WARNING' or 'INFORMATIONAL'")
+	}
+
+	return nil
+}
+
+func resourceZoneRPCreate(d *schema.ResourceData, m interface{}) error {
+	fqdn := d.Get("fqdn").(string)
+	if fqdn == "" {
+		return fmt.Errorf("'fqdn' must not be empty")
+	}
+	dnsView := d.Get("view").(string)
+
+	rpzPolicy := d.Get("rpz_policy").(string)
+	rpzSeverity := d.Get("rpz_severity").(string)
+	substituteName := d.Get("substitute_name").(string)
+	if err := validateZoneRPPolicy(rpzPolicy, rpzSeverity, substituteName); err != nil {
+		return err
+	}
+
+	nsGroup := d.Get("ns_group").(string)
+	gridPrimary := convertInterfaceToMemberServers(d.Get("grid_primary").([]interface{}), false)
+	gridSecondaries := convertInterfaceToMemberServers(d.Get("grid_secondaries").([]interface{}), true)
+	if nsGroup != "" && (len(gridPrimary) > 0 || len(gridSecondaries) > 0) {
+		return fmt.Errorf("'ns_group' must not be set together with 'grid_primary' or 'grid_secondaries'")
+	}
+
+	comment := d.Get("comment").(string)
+
+	extAttrJSON := d.Get("ext_attrs").(string)
+	extAttrs := make(map[string]interface{})
+	if extAttrJSON != "" {
+		if err := json.Unmarshal([]byte(extAttrJSON), &extAttrs); err != nil {
+			return fmt.Errorf("cannot process 'ext_attrs' field: %w", err)
+		}
+	}
+
+	zone := newZoneRP(zoneRP{
+		Fqdn:            fqdn,
+		View:            dnsView,
+		RpzPolicy:       rpzPolicy,
+		RpzSeverity:     rpzSeverity,
+		SubstituteName:  substituteName,
+		GridPrimary:     gridPrimary,
+		GridSecondaries: gridSecondaries,
+		Comment:         comment,
+		Ea:              extAttrs,
+	})
+	if nsGroup != "" {
+		zone.NsGroup = &nsGroup
+	}
+
+	connector := m.(ibclient.IBConnector)
+	ref, err := connector.CreateObject(zone)
+	if err != nil {
+		return fmt.Errorf(
+			"creation of the response policy zone '%s' under DNS view '%s' failed: %w", fqdn, dnsView, err)
+	}
+	d.SetId(ref)
+
+	return nil
+}
+
+func resourceZoneRPRead(d *schema.ResourceData, m interface{}) error {
+	connector := m.(ibclient.IBConnector)
+
+	obj := newZoneRP(zoneRP{})
+	if err := connector.GetObject(obj, d.Id(), ibclient.NewQueryParams(false, nil), obj); err != nil {
+		return fmt.Errorf("failed getting the response policy zone: %w", err)
+	}
+
+	if obj.Ea != nil && len(obj.Ea) > 0 {
+		// TODO: temporary scaffold, need to rework marshalling/unmarshalling of EAs
+		//       (avoiding additional layer of keys ("value" key)
+		eaMap := (map[string]interface{})(obj.Ea)
+		ea, err := json.Marshal(eaMap)
+		if err != nil {
+			return err
+		}
+		if err = d.Set("ext_attrs", string(ea)); err != nil {
+			return err
+		}
+	}
+
+	if err := d.Set("fqdn", obj.Fqdn); err != nil {
+		return err
+	}
+	if err := d.Set("view", obj.View); err != nil {
+		return err
+	}
+	if err := d.Set("rpz_policy", obj.RpzPolicy); err != nil {
+		return err
+	}
+	if err := d.Set("rpz_severity", obj.RpzSeverity); err != nil {
+		return err
+	}
+	substituteName := ""
+	if obj.RpzPolicy == "SUBSTITUTE" {
+		substituteName = obj.SubstituteName
+	}
+	if err := d.Set("substitute_name", substituteName); err != nil {
+		return err
+	}
+	nsGroup := ""
+	if obj.NsGroup != nil {
+		nsGroup = *obj.NsGroup
+	}
+	if err := d.Set("ns_group", nsGroup); err != nil {
+		return err
+	}
+	if err := d.Set("grid_primary", convertMemberServersToInterface(obj.GridPrimary, false)); err != nil {
+		return err
+	}
+	if err := d.Set("grid_secondaries", convertMemberServersToInterface(obj.GridSecondaries, true)); err != nil {
+		return err
+	}
+	if err := d.Set("comment", obj.Comment); err != nil {
+		return err
+	}
+
+	d.SetId(obj.Ref)
+
+	return nil
+}
+
+func resourceZoneRPUpdate(d *schema.ResourceData, m interface{}) error {
+	var updateSuccessful bool
+	defer func() {
+		// Reverting the state back, in case of a failure,
+		// otherwise Terraform will keep the values, which leaded to the failure,
+		// in the state file.
+		if !updateSuccessful {
+			prevFQDN, _ := d.GetChange("fqdn")
+			prevView, _ := d.GetChange("view")
+			prevRpzPolicy, _ := d.GetChange("rpz_policy")
+			prevRpzSeverity, _ := d.GetChange("rpz_severity")
+			prevSubstituteName, _ := d.GetChange("substitute_name")
+			prevNsGroup, _ := d.GetChange("ns_group")
+			prevGridPrimary, _ := d.GetChange("grid_primary")
+			prevGridSecondaries, _ := d.GetChange("grid_secondaries")
+			prevComment, _ := d.GetChange("comment")
+			prevEa, _ := d.GetChange("ext_attrs")
+
+			_ = d.Set("fqdn", prevFQDN.(string))
+			_ = d.Set("view", prevView.(string))
+			_ = d.Set("rpz_policy", prevRpzPolicy.(string))
+			_ = d.Set("rpz_severity", prevRpzSeverity.(string))
+			_ = d.Set("substitute_name", prevSubstituteName.(string))
+			_ = d.Set("ns_group", prevNsGroup.(string))
+			_ = d.Set("grid_primary", prevGridPrimary.([]interface{}))
+			_ = d.Set("grid_secondaries", prevGridSecondaries.([]interface{}))
+			_ = d.Set("comment", prevComment.(string))
+			_ = d.Set("ext_attrs", prevEa.(string))
+		}
+	}()
+
+	if d.HasChange("fqdn") {
+		return fmt.Errorf("changing the value of 'fqdn' field is not allowed")
+	}
+	if d.HasChange("view") {
+		return fmt.Errorf("changing the value of 'view' field is not allowed")
+	}
+
+	rpzPolicy := d.Get("rpz_policy").(string)
+	rpzSeverity := d.Get("rpz_severity").(string)
+	substituteName := d.Get("substitute_name").(string)
+	if err := validateZoneRPPolicy(rpzPolicy, rpzSeverity, substituteName); err != nil {
+		return err
+	}
+
+	nsGroup := d.Get("ns_group").(string)
+	gridPrimary := convertInterfaceToMemberServers(d.Get("grid_primary").([]interface{}), false)
+	gridSecondaries := convertInterfaceToMemberServers(d.Get("grid_secondaries").([]interface{}), true)
+	if nsGroup != "" && (len(gridPrimary) > 0 || len(gridSecondaries) > 0) {
+		return fmt.Errorf("'ns_group' must not be set together with 'grid_primary' or 'grid_secondaries'")
+	}
+
+	comment := d.Get("comment").(string)
+
+	extAttrJSON := d.Get("ext_attrs").(string)
+	extAttrs := make(map[string]interface{})
+	if extAttrJSON != "" {
+		if err := json.Unmarshal([]byte(extAttrJSON), &extAttrs); err != nil {
+			return fmt.Errorf("cannot process 'ext_attrs' field: %w", err)
+		}
+	}
+
+	zone := newZoneRP(zoneRP{
+		RpzPolicy:       rpzPolicy,
+		RpzSeverity:     rpzSeverity,
+		SubstituteName:  substituteName,
+		NsGroup:         &nsGroup,
+		GridPrimary:     gridPrimary,
+		GridSecondaries: gridSecondaries,
+		Comment:         comment,
+		Ea:              extAttrs,
+	})
+
+	connector := m.(ibclient.IBConnector)
+	ref, err := connector.UpdateObject(zone, d.Id())
+	if err != nil {
+		return fmt.Errorf("error updating the response policy zone: %w", err)
+	}
+	updateSuccessful = true
+	d.SetId(ref)
+
+	return nil
+}
+
+func resourceZoneRPDelete(d *schema.ResourceData, m interface{}) error {
+	connector := m.(ibclient.IBConnector)
+
+	if _, err := connector.DeleteObject(d.Id()); err != nil {
+		return fmt.Errorf("deletion of the response policy zone failed: %w", err)
+	}
+	d.SetId("")
+
+	return nil
+}
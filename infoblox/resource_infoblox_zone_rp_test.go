@@ -0,0 +1,164 @@
+package infoblox
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+)
+
+func testAccCheckZoneRPDestroy(s *terraform.State) error {
+	meta := testAccProvider.Meta()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "infoblox_zone_rp" {
+			continue
+		}
+		connector := meta.(ibclient.IBConnector)
+		zone := newZoneRP(zoneRP{})
+		err := connector.GetObject(zone, rs.Primary.ID, ibclient.NewQueryParams(false, nil), zone)
+		if err == nil {
+			return fmt.Errorf("response policy zone still exists")
+		}
+	}
+	return nil
+}
+
+func testAccZoneRPCompare(t *testing.T, resPath string, expectedZone *zoneRP) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		res, found := s.RootModule().Resources[resPath]
+		if !found {
+			return fmt.Errorf("not found: %s", resPath)
+		}
+		if res.Primary.ID == "" {
+			return fmt.Errorf("ID is not set")
+		}
+		meta := testAccProvider.Meta()
+		connector := meta.(ibclient.IBConnector)
+
+		zone := newZoneRP(zoneRP{})
+		if err := connector.GetObject(zone, res.Primary.ID, ibclient.NewQueryParams(false, nil), zone); err != nil {
+			return fmt.Errorf("response policy zone not found: %s", err)
+		}
+
+		if zone.Fqdn != expectedZone.Fqdn {
+			return fmt.Errorf(
+				"'fqdn' does not match: got '%s', expected '%s'",
+				zone.Fqdn, expectedZone.Fqdn)
+		}
+		if zone.View != expectedZone.View {
+			return fmt.Errorf(
+				"'view' does not match: got '%s', expected '%s'",
+				zone.View, expectedZone.View)
+		}
+		if zone.RpzPolicy != expectedZone.RpzPolicy {
+			return fmt.Errorf(
+				"'rpz_policy' does not match: got '%s', expected '%s'",
+				zone.RpzPolicy, expectedZone.RpzPolicy)
+		}
+		if zone.RpzSeverity != expectedZone.RpzSeverity {
+			return fmt.Errorf(
+				"'rpz_severity' does not match: got '%s', expected '%s'",
+				zone.RpzSeverity, expectedZone.RpzSeverity)
+		}
+		if expectedZone.RpzPolicy == "SUBSTITUTE" && zone.SubstituteName != expectedZone.SubstituteName {
+			return fmt.Errorf(
+				"'substitute_name' does not match: got '%s', expected '%s'",
+				zone.SubstituteName, expectedZone.SubstituteName)
+		}
+		if len(zone.GridPrimary) != len(expectedZone.GridPrimary) {
+			return fmt.Errorf(
+				"the number of primary servers does not match: got '%d', expected '%d'",
+				len(zone.GridPrimary), len(expectedZone.GridPrimary))
+		}
+		for i, srv := range expectedZone.GridPrimary {
+			if zone.GridPrimary[i].Name != srv.Name {
+				return fmt.Errorf(
+					"primary server's name does not match: got '%s', expected '%s'",
+					zone.GridPrimary[i].Name, srv.Name)
+			}
+		}
+		if zone.Comment != expectedZone.Comment {
+			return fmt.Errorf(
+				"'comment' does not match: got '%s', expected '%s'",
+				zone.Comment, expectedZone.Comment)
+		}
+		return validateEAs(zone.Ea, expectedZone.Ea)
+	}
+}
+
+func TestAccResourceZoneRP(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckZoneRPDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "infoblox_zone_rp" "foo"{
+						fqdn = "rpz.test.com"
+					}`,
+				Check: resource.ComposeTestCheckFunc(
+					testAccZoneRPCompare(t, "infoblox_zone_rp.foo", &zoneRP{
+						Fqdn:        "rpz.test.com",
+						View:        "default",
+						RpzPolicy:   "GIVEN",
+						RpzSeverity: "MAJOR",
+					}),
+				),
+			},
+			{
+				Config: `
+					resource "infoblox_zone_rp" "foo"{
+						fqdn = "rpz.test.com"
+						rpz_policy = "SUBSTITUTE"
+						rpz_severity = "CRITICAL"
+						substitute_name = "walled-garden.test.com"
+						grid_primary {
+							name = "infoblox.localdomain"
+						}
+						comment = "test comment 1"
+						ext_attrs = jsonencode({
+							"Location" = "Los Angeles"
+						})
+					}`,
+				Check: resource.ComposeTestCheckFunc(
+					testAccZoneRPCompare(t, "infoblox_zone_rp.foo", &zoneRP{
+						Fqdn:           "rpz.test.com",
+						View:           "default",
+						RpzPolicy:      "SUBSTITUTE",
+						RpzSeverity:    "CRITICAL",
+						SubstituteName: "walled-garden.test.com",
+						GridPrimary: []memberServer{
+							{Name: "infoblox.localdomain"},
+						},
+						Comment: "test comment 1",
+						Ea: ibclient.EA{
+							"Location": "Los Angeles",
+						},
+					}),
+				),
+			},
+
+			// negative test cases
+			{
+				Config: `
+					resource "infoblox_zone_rp" "foo"{
+						fqdn = "rpz.test.com"
+						rpz_policy = "SUBSTITUTE"
+					}`,
+				ExpectError: regexp.MustCompile("'substitute_name' must not be empty"),
+			},
+			{
+				Config: `
+					resource "infoblox_zone_rp" "foo"{
+						fqdn = "rpz2.test.com"
+					}`,
+				ExpectError: regexp.MustCompile("changing the value of 'fqdn' field is not allowed"),
+			},
+		},
+	})
+}
@@ -0,0 +1,284 @@
+package infoblox
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+)
+
+func resourceZoneStub() *schema.Resource {
+	return &schema.Resource{
+		Create:   resourceZoneStubCreate,
+		Read:     resourceZoneStubRead,
+		Update:   resourceZoneStubUpdate,
+		Delete:   resourceZoneStubDelete,
+		Importer: &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			"fqdn": {
+				Type:     schema.TypeString,
+				Required: true,
+				Description: "The name of the zone. For a forward zone this is an FQDN;" +
+					" for a reverse zone this is a network address in CIDR format.",
+			},
+			"view": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     defaultDNSView,
+				Description: "DNS view which the zone does exist within.",
+			},
+			"zone_format": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "FORWARD",
+				Description: "The format of the zone: 'FORWARD', 'IPV4' or 'IPV6'.",
+			},
+			"stub_from": {
+				Type:        schema.TypeList,
+				Required:    true,
+				Description: "The list of primary name servers (masters) which the zone's data is obtained from.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The FQDN of the name server.",
+						},
+						"address": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The IP address of the name server.",
+						},
+					},
+				},
+			},
+			"stub_members": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "The list of grid members which serve the stub zone.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The name of the grid member.",
+						},
+					},
+				},
+			},
+			"comment": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "Description of the zone.",
+			},
+			"ext_attrs": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "Extensible attributes of the zone to be added/updated, as a map in JSON format.",
+			},
+		},
+	}
+}
+
+func convertStubMembersToInterface(members []memberServer) []map[string]interface{} {
+	res := make([]map[string]interface{}, 0, len(members))
+	for _, member := range members {
+		res = append(res, map[string]interface{}{
+			"name": member.Name,
+		})
+	}
+
+	return res
+}
+
+func convertInterfaceToStubMembers(members []interface{}) []memberServer {
+	res := make([]memberServer, 0, len(members))
+	for _, memberInf := range members {
+		memberMap := memberInf.(map[string]interface{})
+		res = append(res, memberServer{
+			Name: memberMap["name"].(string),
+		})
+	}
+
+	return res
+}
+
+func resourceZoneStubCreate(d *schema.ResourceData, m interface{}) error {
+	fqdn := d.Get("fqdn").(string)
+	if fqdn == "" {
+		return fmt.Errorf("'fqdn' must not be empty")
+	}
+
+	dnsView := d.Get("view").(string)
+	zoneFormat := d.Get("zone_format").(string)
+	switch zoneFormat {
+	case "FORWARD", "IPV4", "IPV6":
+	default:
+		return fmt.Errorf("'zone_format' must be one of 'FORWARD', 'IPV4' or 'IPV6'")
+	}
+
+	stubFrom := convertInterfaceToNameServers(d.Get("stub_from").([]interface{}))
+	if len(stubFrom) == 0 {
+		return fmt.Errorf("at least one name server must be defined in 'stub_from'")
+	}
+	stubMembers := convertInterfaceToStubMembers(d.Get("stub_members").([]interface{}))
+
+	comment := d.Get("comment").(string)
+
+	extAttrJSON := d.Get("ext_attrs").(string)
+	extAttrs := make(map[string]interface{})
+	if extAttrJSON != "" {
+		if err := json.Unmarshal([]byte(extAttrJSON), &extAttrs); err != nil {
+			return fmt.Errorf("cannot process 'ext_attrs' field: %w", err)
+		}
+	}
+
+	zone := newZoneStub(zoneStub{
+		Fqdn:        fqdn,
+		View:        dnsView,
+		ZoneFormat:  zoneFormat,
+		StubFrom:    stubFrom,
+		StubMembers: stubMembers,
+		Comment:     comment,
+		Ea:          extAttrs,
+	})
+
+	connector := m.(ibclient.IBConnector)
+	ref, err := connector.CreateObject(zone)
+	if err != nil {
+		return fmt.Errorf("creation of the stub zone '%s' under DNS view '%s' failed: %w", fqdn, dnsView, err)
+	}
+	d.SetId(ref)
+
+	return nil
+}
+
+func resourceZoneStubRead(d *schema.ResourceData, m interface{}) error {
+	connector := m.(ibclient.IBConnector)
+
+	obj := newZoneStub(zoneStub{})
+	if err := connector.GetObject(obj, d.Id(), ibclient.NewQueryParams(false, nil), obj); err != nil {
+		return fmt.Errorf("failed getting the stub zone: %w", err)
+	}
+
+	if obj.Ea != nil && len(obj.Ea) > 0 {
+		// TODO: temporary scaffold, need to rework marshalling/unmarshalling of EAs
+		//       (avoiding additional layer of keys ("value" key)
+		eaMap := (map[string]interface{})(obj.Ea)
+		ea, err := json.Marshal(eaMap)
+		if err != nil {
+			return err
+		}
+		if err = d.Set("ext_attrs", string(ea)); err != nil {
+			return err
+		}
+	}
+
+	if err := d.Set("fqdn", obj.Fqdn); err != nil {
+		return err
+	}
+	if err := d.Set("view", obj.View); err != nil {
+		return err
+	}
+	if err := d.Set("zone_format", obj.ZoneFormat); err != nil {
+		return err
+	}
+	if err := d.Set("stub_from", convertNameServersToInterface(obj.StubFrom)); err != nil {
+		return err
+	}
+	if err := d.Set("stub_members", convertStubMembersToInterface(obj.StubMembers)); err != nil {
+		return err
+	}
+	if err := d.Set("comment", obj.Comment); err != nil {
+		return err
+	}
+
+	d.SetId(obj.Ref)
+
+	return nil
+}
+
+func resourceZoneStubUpdate(d *schema.ResourceData, m interface{}) error {
+	var updateSuccessful bool
+	defer func() {
+		// Reverting the state back, in case of a failure,
+		// otherwise Terraform will keep the values, which leaded to the failure,
+		// in the state file.
+		if !updateSuccessful {
+			prevFQDN, _ := d.GetChange("fqdn")
+			prevView, _ := d.GetChange("view")
+			prevZoneFormat, _ := d.GetChange("zone_format")
+			prevStubFrom, _ := d.GetChange("stub_from")
+			prevStubMembers, _ := d.GetChange("stub_members")
+			prevComment, _ := d.GetChange("comment")
+			prevEa, _ := d.GetChange("ext_attrs")
+
+			_ = d.Set("fqdn", prevFQDN.(string))
+			_ = d.Set("view", prevView.(string))
+			_ = d.Set("zone_format", prevZoneFormat.(string))
+			_ = d.Set("stub_from", prevStubFrom.([]interface{}))
+			_ = d.Set("stub_members", prevStubMembers.([]interface{}))
+			_ = d.Set("comment", prevComment.(string))
+			_ = d.Set("ext_attrs", prevEa.(string))
+		}
+	}()
+
+	if d.HasChange("fqdn") {
+		return fmt.Errorf("changing the value of 'fqdn' field is not allowed")
+	}
+	if d.HasChange("view") {
+		return fmt.Errorf("changing the value of 'view' field is not allowed")
+	}
+	if d.HasChange("zone_format") {
+		return fmt.Errorf("changing the value of 'zone_format' field is not allowed")
+	}
+
+	stubFrom := convertInterfaceToNameServers(d.Get("stub_from").([]interface{}))
+	if len(stubFrom) == 0 {
+		return fmt.Errorf("at least one name server must be defined in 'stub_from'")
+	}
+	stubMembers := convertInterfaceToStubMembers(d.Get("stub_members").([]interface{}))
+
+	comment := d.Get("comment").(string)
+
+	extAttrJSON := d.Get("ext_attrs").(string)
+	extAttrs := make(map[string]interface{})
+	if extAttrJSON != "" {
+		if err := json.Unmarshal([]byte(extAttrJSON), &extAttrs); err != nil {
+			return fmt.Errorf("cannot process 'ext_attrs' field: %w", err)
+		}
+	}
+
+	zone := newZoneStub(zoneStub{
+		StubFrom:    stubFrom,
+		StubMembers: stubMembers,
+		Comment:     comment,
+		Ea:          extAttrs,
+	})
+
+	connector := m.(ibclient.IBConnector)
+	ref, err := connector.UpdateObject(zone, d.Id())
+	if err != nil {
+		return fmt.Errorf("error updating the stub zone: %w", err)
+	}
+	updateSuccessful = true
+	d.SetId(ref)
+
+	return nil
+}
+
+func resourceZoneStubDelete(d *schema.ResourceData, m interface{}) error {
+	connector := m.(ibclient.IBConnector)
+
+	if _, err := connector.DeleteObject(d.Id()); err != nil {
+		return fmt.Errorf("deletion of the stub zone failed: %w", err)
+	}
+	d.SetId("")
+
+	return nil
+}
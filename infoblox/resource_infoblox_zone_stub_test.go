@@ -0,0 +1,175 @@
+package infoblox
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+)
+
+func testAccCheckZoneStubDestroy(s *terraform.State) error {
+	meta := testAccProvider.Meta()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "infoblox_zone_stub" {
+			continue
+		}
+		connector := meta.(ibclient.IBConnector)
+		zone := newZoneStub(zoneStub{})
+		err := connector.GetObject(zone, rs.Primary.ID, ibclient.NewQueryParams(false, nil), zone)
+		if err == nil {
+			return fmt.Errorf("stub zone still exists")
+		}
+	}
+	return nil
+}
+
+func testAccZoneStubCompare(t *testing.T, resPath string, expectedZone *zoneStub) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		res, found := s.RootModule().Resources[resPath]
+		if !found {
+			return fmt.Errorf("not found: %s", resPath)
+		}
+		if res.Primary.ID == "" {
+			return fmt.Errorf("ID is not set")
+		}
+		meta := testAccProvider.Meta()
+		connector := meta.(ibclient.IBConnector)
+
+		zone := newZoneStub(zoneStub{})
+		if err := connector.GetObject(zone, res.Primary.ID, ibclient.NewQueryParams(false, nil), zone); err != nil {
+			return fmt.Errorf("stub zone not found: %s", err)
+		}
+
+		if zone.Fqdn != expectedZone.Fqdn {
+			return fmt.Errorf(
+				"'fqdn' does not match: got '%s', expected '%s'",
+				zone.Fqdn, expectedZone.Fqdn)
+		}
+		if zone.View != expectedZone.View {
+			return fmt.Errorf(
+				"'view' does not match: got '%s', expected '%s'",
+				zone.View, expectedZone.View)
+		}
+		if len(zone.StubFrom) != len(expectedZone.StubFrom) {
+			return fmt.Errorf(
+				"the number of master servers does not match: got '%d', expected '%d'",
+				len(zone.StubFrom), len(expectedZone.StubFrom))
+		}
+		for i, srv := range expectedZone.StubFrom {
+			if zone.StubFrom[i] != srv {
+				return fmt.Errorf(
+					"master server does not match: got '%+v', expected '%+v'",
+					zone.StubFrom[i], srv)
+			}
+		}
+		if len(zone.StubMembers) != len(expectedZone.StubMembers) {
+			return fmt.Errorf(
+				"the number of stub members does not match: got '%d', expected '%d'",
+				len(zone.StubMembers), len(expectedZone.StubMembers))
+		}
+		for i, member := range expectedZone.StubMembers {
+			if zone.StubMembers[i].Name != member.Name {
+				return fmt.Errorf(
+					"stub member's name does not match: got '%s', expected '%s'",
+					zone.StubMembers[i].Name, member.Name)
+			}
+		}
+		if zone.Comment != expectedZone.Comment {
+			return fmt.Errorf(
+				"'comment' does not match: got '%s', expected '%s'",
+				zone.Comment, expectedZone.Comment)
+		}
+		return validateEAs(zone.Ea, expectedZone.Ea)
+	}
+}
+
+func TestAccResourceZoneStub(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckZoneStubDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "infoblox_zone_stub" "foo"{
+						fqdn = "ad.test.com"
+						stub_from {
+							name = "dc1.ad.test.com"
+							address = "10.3.0.1"
+						}
+						stub_members {
+							name = "infoblox.localdomain"
+						}
+					}`,
+				Check: resource.ComposeTestCheckFunc(
+					testAccZoneStubCompare(t, "infoblox_zone_stub.foo", &zoneStub{
+						Fqdn: "ad.test.com",
+						View: "default",
+						StubFrom: []ibclient.NameServer{
+							{Name: "dc1.ad.test.com", Address: "10.3.0.1"},
+						},
+						StubMembers: []memberServer{
+							{Name: "infoblox.localdomain"},
+						},
+					}),
+				),
+			},
+			{
+				Config: `
+					resource "infoblox_zone_stub" "foo"{
+						fqdn = "ad.test.com"
+						stub_from {
+							name = "dc1.ad.test.com"
+							address = "10.3.0.1"
+						}
+						stub_from {
+							name = "dc2.ad.test.com"
+							address = "10.3.0.2"
+						}
+						stub_members {
+							name = "infoblox.localdomain"
+						}
+						comment = "test comment 1"
+						ext_attrs = jsonencode({
+							"Location" = "Los Angeles"
+						})
+					}`,
+				Check: resource.ComposeTestCheckFunc(
+					testAccZoneStubCompare(t, "infoblox_zone_stub.foo", &zoneStub{
+						Fqdn: "ad.test.com",
+						View: "default",
+						StubFrom: []ibclient.NameServer{
+							{Name: "dc1.ad.test.com", Address: "10.3.0.1"},
+							{Name: "dc2.ad.test.com", Address: "10.3.0.2"},
+						},
+						StubMembers: []memberServer{
+							{Name: "infoblox.localdomain"},
+						},
+						Comment: "test comment 1",
+						Ea: ibclient.EA{
+							"Location": "Los Angeles",
+						},
+					}),
+				),
+			},
+
+			// negative test cases
+			{
+				Config: `
+					resource "infoblox_zone_stub" "foo"{
+						fqdn = "ad.test.com"
+						zone_format = "IPV4"
+						stub_from {
+							name = "dc1.ad.test.com"
+							address = "10.3.0.1"
+						}
+					}`,
+				ExpectError: regexp.MustCompile("changing the value of 'zone_format' field is not allowed"),
+			},
+		},
+	})
+}
@@ -0,0 +1,24 @@
+package infoblox
+
+import "net"
+
+// Checks if the IP address is of the given version.
+func isIPAddrOfVersion(ip net.IP, isIPv6 bool) bool {
+	if ip == nil {
+		return false
+	}
+	if isIPv6 {
+		return ip.To4() == nil
+	}
+	return ip.To4() != nil
+}
+
+// Compares the addresses as IP addresses, if both are valid ones, otherwise as strings.
+func sameIPAddrs(a, b string) bool {
+	ipA := net.ParseIP(a)
+	ipB := net.ParseIP(b)
+	if ipA == nil || ipB == nil {
+		return a == b
+	}
+	return ipA.Equal(ipB)
+}
@@ -0,0 +1,367 @@
+package infoblox
+
+import (
+	"bufio"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// A minimal parser of RFC 1035 zone files (master files), sufficient
+// for importing the records of a single zone: it supports $ORIGIN and $TTL
+// directives, comments, multi-line records in parentheses, quoted strings,
+// relative names and omitted owner names, TTLs and classes.
+
+// zoneFileRecord is a resource record parsed from a zone file.
+type zoneFileRecord struct {
+	// The line of the zone file the record starts on.
+	Line int
+	// The owner name of the record, fully qualified, without the trailing dot.
+	Name string
+	// The record's type in upper case, ex. 'A', 'MX'.
+	Type string
+	// The record's TTL, nil if neither the record nor $TTL directive define it.
+	TTL *uint32
+	// The fields of the record's data, as they are in the zone file,
+	// except that the quotes are removed from quoted strings.
+	RData []string
+	// The origin in effect for the record, used to qualify relative names in RData.
+	Origin string
+}
+
+// zoneFileProblem describes a part of a zone file which was not parsed or imported.
+type zoneFileProblem struct {
+	Line   int
+	Text   string
+	Reason string
+}
+
+func (p zoneFileProblem) String() string {
+	return fmt.Sprintf("line %d: %s: %s", p.Line, p.Reason, p.Text)
+}
+
+type zoneFileToken struct {
+	value  string
+	quoted bool
+}
+
+// Returns the name qualified with the origin, without the trailing dot.
+// '@' stands for the origin itself.
+func qualifyZoneFileName(name, origin string) string {
+	switch {
+	case name == "@":
+		return origin
+	case name == ".":
+		return "."
+	case strings.HasSuffix(name, "."):
+		return strings.TrimSuffix(name, ".")
+	case origin == "":
+		return name
+	}
+	return name + "." + origin
+}
+
+// Parses a TTL value: a number of seconds or a BIND-style
+// duration like '1h30m' (units: s, m, h, d, w).
+func parseZoneFileTTL(s string) (uint32, error) {
+	if s == "" {
+		return 0, fmt.Errorf("empty TTL value")
+	}
+	if val, err := strconv.ParseUint(s, 10, 32); err == nil {
+		return uint32(val), nil
+	}
+
+	var total, current uint64
+	hasDigits := false
+	for _, ch := range strings.ToLower(s) {
+		if ch >= '0' && ch <= '9' {
+			current = current*10 + uint64(ch-'0')
+			hasDigits = true
+			continue
+		}
+		if !hasDigits {
+			return 0, fmt.Errorf("invalid TTL value '%s'", s)
+		}
+		switch ch {
+		case 's':
+		case 'm':
+			current *= 60
+		case 'h':
+			current *= 3600
+		case 'd':
+			current *= 86400
+		case 'w':
+			current *= 604800
+		default:
+			return 0, fmt.Errorf("invalid TTL value '%s'", s)
+		}
+		total += current
+		current = 0
+		hasDigits = false
+	}
+	if hasDigits {
+		total += current
+	}
+	if total > 0xFFFFFFFF {
+		return 0, fmt.Errorf("TTL value '%s' is too big", s)
+	}
+
+	return uint32(total), nil
+}
+
+func isZoneFileClass(s string) bool {
+	switch strings.ToUpper(s) {
+	case "IN", "CH", "HS", "CS":
+		return true
+	}
+	return false
+}
+
+func isZoneFileTTL(s string) bool {
+	if s == "" || s[0] < '0' || s[0] > '9' {
+		return false
+	}
+	_, err := parseZoneFileTTL(s)
+	return err == nil
+}
+
+// Splits a logical line into tokens. Returns an error for unbalanced quotes.
+func tokenizeZoneFileLine(line string) ([]zoneFileToken, error) {
+	var (
+		res     []zoneFileToken
+		current strings.Builder
+		inToken bool
+		quoted  bool
+		escaped bool
+	)
+	for _, ch := range line {
+		switch {
+		case escaped:
+			current.WriteRune(ch)
+			escaped = false
+		case ch == '\\':
+			escaped = true
+			if !quoted {
+				inToken = true
+			}
+		case quoted && ch == '"':
+			res = append(res, zoneFileToken{value: current.String(), quoted: true})
+			current.Reset()
+			quoted = false
+		case quoted:
+			current.WriteRune(ch)
+		case ch == '"':
+			if inToken {
+				res = append(res, zoneFileToken{value: current.String()})
+				current.Reset()
+				inToken = false
+			}
+			quoted = true
+		case unicode.IsSpace(ch):
+			if inToken {
+				res = append(res, zoneFileToken{value: current.String()})
+				current.Reset()
+				inToken = false
+			}
+		default:
+			current.WriteRune(ch)
+			inToken = true
+		}
+	}
+	if quoted {
+		return nil, fmt.Errorf("unbalanced quotes")
+	}
+	if inToken {
+		res = append(res, zoneFileToken{value: current.String()})
+	}
+
+	return res, nil
+}
+
+type zoneFileLogicalLine struct {
+	number int
+	text   string
+	// True if the line starts with a whitespace, meaning
+	// the owner name is the same as for the previous record.
+	ownerOmitted bool
+}
+
+// Removes comments and joins the lines which are inside parentheses.
+func splitZoneFileLines(content string) ([]zoneFileLogicalLine, []zoneFileProblem) {
+	var (
+		res      []zoneFileLogicalLine
+		problems []zoneFileProblem
+		current  *zoneFileLogicalLine
+		depth    int
+	)
+
+	scanner := bufio.NewScanner(strings.NewReader(content))
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	lineNum := 0
+	for scanner.Scan() {
+		lineNum++
+		raw := scanner.Text()
+
+		var text strings.Builder
+		quoted := false
+		escaped := false
+		for _, ch := range raw {
+			if escaped {
+				text.WriteRune(ch)
+				escaped = false
+				continue
+			}
+			switch {
+			case ch == '\\':
+				escaped = true
+				text.WriteRune(ch)
+				continue
+			case ch == '"':
+				quoted = !quoted
+			case ch == ';' && !quoted:
+				goto endOfLine
+			case ch == '(' && !quoted:
+				depth++
+				text.WriteRune(' ')
+				continue
+			case ch == ')' && !quoted:
+				depth--
+				text.WriteRune(' ')
+				continue
+			}
+			text.WriteRune(ch)
+		}
+	endOfLine:
+
+		if current == nil {
+			if strings.TrimSpace(text.String()) == "" && depth == 0 {
+				continue
+			}
+			current = &zoneFileLogicalLine{
+				number:       lineNum,
+				ownerOmitted: len(raw) > 0 && (raw[0] == ' ' || raw[0] == '\t'),
+			}
+		}
+		current.text += " " + text.String()
+
+		if depth < 0 {
+			problems = append(problems, zoneFileProblem{
+				Line: current.number, Text: strings.TrimSpace(current.text), Reason: "unbalanced parentheses"})
+			current = nil
+			depth = 0
+			continue
+		}
+		if depth == 0 {
+			res = append(res, *current)
+			current = nil
+		}
+	}
+	if current != nil {
+		problems = append(problems, zoneFileProblem{
+			Line: current.number, Text: strings.TrimSpace(current.text), Reason: "unbalanced parentheses"})
+	}
+
+	return res, problems
+}
+
+// Parses the content of a zone file. The origin is the name of the zone
+// which is used until $ORIGIN directive changes it.
+// The lines which cannot be parsed are returned as problems.
+func parseZoneFile(content, origin string) ([]zoneFileRecord, []zoneFileProblem) {
+	origin = strings.TrimSuffix(origin, ".")
+
+	lines, problems := splitZoneFileLines(content)
+
+	var (
+		res        []zoneFileRecord
+		defaultTTL *uint32
+		lastOwner  string
+	)
+	for _, line := range lines {
+		text := strings.TrimSpace(line.text)
+		tokens, err := tokenizeZoneFileLine(text)
+		if err != nil {
+			problems = append(problems, zoneFileProblem{Line: line.number, Text: text, Reason: err.Error()})
+			continue
+		}
+		if len(tokens) == 0 {
+			continue
+		}
+
+		if !line.ownerOmitted && !tokens[0].quoted && strings.HasPrefix(tokens[0].value, "$") {
+			directive := strings.ToUpper(tokens[0].value)
+			switch {
+			case directive == "$ORIGIN" && len(tokens) == 2:
+				origin = qualifyZoneFileName(tokens[1].value, origin)
+			case directive == "$TTL" && len(tokens) == 2:
+				ttl, err := parseZoneFileTTL(tokens[1].value)
+				if err != nil {
+					problems = append(problems, zoneFileProblem{Line: line.number, Text: text, Reason: err.Error()})
+					continue
+				}
+				defaultTTL = &ttl
+			default:
+				problems = append(problems, zoneFileProblem{
+					Line: line.number, Text: text, Reason: "unsupported directive"})
+			}
+			continue
+		}
+
+		var owner string
+		if line.ownerOmitted {
+			if lastOwner == "" {
+				problems = append(problems, zoneFileProblem{
+					Line: line.number, Text: text, Reason: "the owner name is not defined"})
+				continue
+			}
+			owner = lastOwner
+		} else {
+			owner = qualifyZoneFileName(tokens[0].value, origin)
+			tokens = tokens[1:]
+		}
+		lastOwner = owner
+
+		ttl := defaultTTL
+		for len(tokens) > 0 && !tokens[0].quoted {
+			if isZoneFileClass(tokens[0].value) {
+				if strings.ToUpper(tokens[0].value) != "IN" {
+					break
+				}
+				tokens = tokens[1:]
+				continue
+			}
+			if isZoneFileTTL(tokens[0].value) {
+				val, _ := parseZoneFileTTL(tokens[0].value)
+				ttl = &val
+				tokens = tokens[1:]
+				continue
+			}
+			break
+		}
+		if len(tokens) > 0 && isZoneFileClass(tokens[0].value) {
+			problems = append(problems, zoneFileProblem{
+				Line: line.number, Text: text, Reason: "only records of class IN are supported"})
+			continue
+		}
+		if len(tokens) == 0 {
+			problems = append(problems, zoneFileProblem{
+				Line: line.number, Text: text, Reason: "the record's type is not defined"})
+			continue
+		}
+
+		rec := zoneFileRecord{
+			Line:   line.number,
+			Name:   owner,
+			Type:   strings.ToUpper(tokens[0].value),
+			TTL:    ttl,
+			Origin: origin,
+		}
+		for _, t := range tokens[1:] {
+			rec.RData = append(rec.RData, t.value)
+		}
+		res = append(res, rec)
+	}
+
+	return res, problems
+}
@@ -0,0 +1,130 @@
+package infoblox
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseZoneFile(t *testing.T) {
+	content := `
+$ORIGIN example.com.
+$TTL 1h
+@   IN  SOA ns1.example.com. admin.example.com. (
+            2021010101 ; serial
+            3600       ; refresh
+            600        ; retry
+            86400      ; expire
+            300 )      ; minimum
+    IN  NS  ns1
+www     300 IN A 10.0.0.1
+        IN  AAAA 2001:db8::1
+mail    IN  300 MX 10 mx1.example.net.
+txt     TXT "hello; world" "second \"part\""
+$ORIGIN sub.example.com.
+host    CNAME www.example.com.
+$INCLUDE other.zone
+bad     CH  A 10.0.0.2
+`
+	records, problems := parseZoneFile(content, "example.com")
+
+	hour := uint32(3600)
+	fiveMin := uint32(300)
+	expected := []zoneFileRecord{
+		{Line: 4, Name: "example.com", Type: "SOA", TTL: &hour, Origin: "example.com",
+			RData: []string{"ns1.example.com.", "admin.example.com.", "2021010101", "3600", "600", "86400", "300"}},
+		{Line: 10, Name: "example.com", Type: "NS", TTL: &hour, Origin: "example.com",
+			RData: []string{"ns1"}},
+		{Line: 11, Name: "www.example.com", Type: "A", TTL: &fiveMin, Origin: "example.com",
+			RData: []string{"10.0.0.1"}},
+		{Line: 12, Name: "www.example.com", Type: "AAAA", TTL: &hour, Origin: "example.com",
+			RData: []string{"2001:db8::1"}},
+		{Line: 13, Name: "mail.example.com", Type: "MX", TTL: &fiveMin, Origin: "example.com",
+			RData: []string{"10", "mx1.example.net."}},
+		{Line: 14, Name: "txt.example.com", Type: "TXT", TTL: &hour, Origin: "example.com",
+			RData: []string{"hello; world", `second "part"`}},
+		{Line: 16, Name: "host.sub.example.com", Type: "CNAME", TTL: &hour, Origin: "sub.example.com",
+			RData: []string{"www.example.com."}},
+	}
+	if !reflect.DeepEqual(records, expected) {
+		t.Fatalf("records do not match:\ngot      %+v\nexpected %+v", records, expected)
+	}
+
+	if len(problems) != 2 {
+		t.Fatalf("expected 2 problems, got %d: %+v", len(problems), problems)
+	}
+	if problems[0].Line != 17 || problems[0].Reason != "unsupported directive" {
+		t.Errorf("unexpected problem: %+v", problems[0])
+	}
+	if problems[1].Line != 18 || problems[1].Reason != "only records of class IN are supported" {
+		t.Errorf("unexpected problem: %+v", problems[1])
+	}
+}
+
+func TestParseZoneFileTTL(t *testing.T) {
+	cases := map[string]uint32{
+		"0":     0,
+		"3600":  3600,
+		"1h30m": 5400,
+		"1W":    604800,
+		"2d1s":  172801,
+	}
+	for value, expected := range cases {
+		got, err := parseZoneFileTTL(value)
+		if err != nil {
+			t.Errorf("'%s': unexpected error: %s", value, err)
+			continue
+		}
+		if got != expected {
+			t.Errorf("'%s': got %d, expected %d", value, got, expected)
+		}
+	}
+
+	for _, value := range []string{"", "h", "1x", "5000000000"} {
+		if _, err := parseZoneFileTTL(value); err == nil {
+			t.Errorf("'%s': error expected", value)
+		}
+	}
+}
+
+func TestBuildZoneFileImportRecords(t *testing.T) {
+	content := `
+$TTL 600
+@       IN SOA ns1 admin 1 3600 600 86400 300
+@       IN NS  ns1
+www        A   10.0.0.1
+www        A   10.0.0.1
+srv        SRV 1 2 80 www
+caa        CAA 0 issue "ca.example.net"
+other.net. A   10.0.0.2
+spf        TXT "v=spf1 -all"
+unknown    HINFO "PC" "Linux"
+`
+	records, problems := buildZoneFileImportRecords(content, "example.com.")
+
+	var keys []string
+	for _, rec := range records {
+		keys = append(keys, rec.key())
+		if rec.ttl != 600 {
+			t.Errorf("%s: unexpected TTL %d", rec.key(), rec.ttl)
+		}
+	}
+	expectedKeys := []string{
+		"A www.example.com 10.0.0.1",
+		"SRV srv.example.com 1 2 80 www.example.com",
+		`CAA caa.example.com 0 issue "ca.example.net"`,
+		"TXT spf.example.com v=spf1 -all",
+	}
+	if !reflect.DeepEqual(keys, expectedKeys) {
+		t.Fatalf("records do not match:\ngot      %q\nexpected %q", keys, expectedKeys)
+	}
+
+	expectedLines := []int{4, 6, 9, 11}
+	if len(problems) != len(expectedLines) {
+		t.Fatalf("expected %d problems, got %d: %+v", len(expectedLines), len(problems), problems)
+	}
+	for i, line := range expectedLines {
+		if problems[i].Line != line {
+			t.Errorf("unexpected problem: %+v", problems[i])
+		}
+	}
+}